@@ -202,6 +202,9 @@ type RegenApp struct {
 	// the module manager
 	mm *module.Manager
 
+	// the server module manager
+	smm *servermodule.Manager
+
 	// simulation manager
 	sm *module.SimulationManager
 }
@@ -368,6 +371,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	if err != nil {
 		panic(err)
 	}
+	app.smm = newModuleManager
 	/* New Module Wiring END */
 
 	app.mm = module.NewManager(
@@ -416,6 +420,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
+	newModuleManager.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
 
@@ -496,11 +501,15 @@ func (app *RegenApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *RegenApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if err := app.smm.RunMigrations(ctx); err != nil {
+		panic(err)
+	}
 	return app.mm.BeginBlock(ctx, req)
 }
 
 // EndBlocker application updates every end block
 func (app *RegenApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	app.smm.EndBlock(ctx)
 	return app.mm.EndBlock(ctx, req)
 }
 
@@ -508,7 +517,11 @@ func (app *RegenApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.
 func (app *RegenApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	res := app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	if err := app.smm.InitGenesis(ctx, genesisState); err != nil {
+		panic(err)
+	}
+	return res
 }
 
 // LoadHeight loads a particular height
@@ -32,6 +32,13 @@ func (app *RegenApp) ExportAppStateAndValidators(
 	}
 
 	genState := app.mm.ExportGenesis(ctx, app.appCodec)
+	newModulesGenState, err := app.smm.ExportGenesis(ctx)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	for name, data := range newModulesGenState {
+		genState[name] = data
+	}
 	appState, err := json.MarshalIndent(genState, "", "  ")
 	if err != nil {
 		return servertypes.ExportedApp{}, err
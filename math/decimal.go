@@ -0,0 +1,98 @@
+package math
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cockroachdb/apd/v2"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Decimal is an arbitrary precision decimal. Its JSON, amino and binary encodings
+// all use the canonical decimal string, see CanonicalDecimalString.
+type Decimal struct {
+	apd.Decimal
+}
+
+// NewDecimalFromString parses a finite decimal or returns an error.
+func NewDecimalFromString(x string) (Decimal, error) {
+	res, _, err := apd.NewFromString(x)
+	if err != nil || res.Form != apd.Finite {
+		return Decimal{}, errors.Wrap(errors.ErrInvalidRequest, fmt.Sprintf("expected a finite decimal, got %s", x))
+	}
+	return Decimal{Decimal: *res}, nil
+}
+
+// CanonicalDecimalString prints x as a floating point string without trailing zeros
+// and without a sign for zero, so that equal values are always printed the same way.
+func CanonicalDecimalString(x *apd.Decimal) string {
+	var reduced apd.Decimal
+	reduced.Reduce(x)
+	if reduced.IsZero() {
+		return "0"
+	}
+	return reduced.Text('f')
+}
+
+// String returns the canonical decimal string.
+func (d Decimal) String() string {
+	return CanonicalDecimalString(&d.Decimal)
+}
+
+// MarshalJSON encodes d as a quoted canonical decimal string.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes a quoted decimal string.
+func (d *Decimal) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return err
+	}
+	return d.unmarshalString(s)
+}
+
+// MarshalAmino encodes d as the canonical decimal string.
+func (d Decimal) MarshalAmino() (string, error) {
+	return d.String(), nil
+}
+
+// UnmarshalAmino decodes a decimal string.
+func (d *Decimal) UnmarshalAmino(s string) error {
+	return d.unmarshalString(s)
+}
+
+// Marshal encodes d as the bytes of the canonical decimal string.
+func (d Decimal) Marshal() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// MarshalTo writes the canonical decimal string to data.
+func (d Decimal) MarshalTo(data []byte) (int, error) {
+	s := d.String()
+	if len(data) < len(s) {
+		return 0, errors.Wrap(errors.ErrInvalidRequest, "buffer too small")
+	}
+	return copy(data, s), nil
+}
+
+// Unmarshal decodes the bytes of a decimal string.
+func (d *Decimal) Unmarshal(data []byte) error {
+	return d.unmarshalString(string(data))
+}
+
+// Size returns the length of the binary encoding of d.
+func (d Decimal) Size() int {
+	return len(d.String())
+}
+
+func (d *Decimal) unmarshalString(s string) error {
+	res, err := NewDecimalFromString(s)
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
+}
@@ -0,0 +1,72 @@
+package math
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalRoundTrip(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"0", "0"},
+		{"0.000", "0"},
+		{"-0", "0"},
+		{"-1.5", "-1.5"},
+		{"-1.50", "-1.5"},
+		{"100", "100"},
+		{"1.000000000000000000000000000000000000001", "1.000000000000000000000000000000000000001"},
+		{"0.0000000000000000000000000000000000012345", "0.0000000000000000000000000000000000012345"},
+		{"12345678901234567890.123456789", "12345678901234567890.123456789"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.x, func(t *testing.T) {
+			d, err := NewDecimalFromString(tt.x)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, d.String())
+
+			// JSON
+			bz, err := json.Marshal(d)
+			require.NoError(t, err)
+			require.Equal(t, `"`+tt.want+`"`, string(bz))
+			var fromJSON Decimal
+			require.NoError(t, json.Unmarshal(bz, &fromJSON))
+			require.Equal(t, tt.want, fromJSON.String())
+
+			// amino
+			s, err := d.MarshalAmino()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, s)
+			var fromAmino Decimal
+			require.NoError(t, fromAmino.UnmarshalAmino(s))
+			require.Equal(t, tt.want, fromAmino.String())
+
+			// binary
+			bz, err = d.Marshal()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(bz))
+			require.Equal(t, len(bz), d.Size())
+			buf := make([]byte, d.Size())
+			n, err := d.MarshalTo(buf)
+			require.NoError(t, err)
+			require.Equal(t, bz, buf[:n])
+			var fromBinary Decimal
+			require.NoError(t, fromBinary.Unmarshal(bz))
+			require.Equal(t, tt.want, fromBinary.String())
+			require.Equal(t, 0, fromBinary.Cmp(&d.Decimal))
+		})
+	}
+}
+
+func TestDecimalInvalid(t *testing.T) {
+	for _, x := range []string{"", "abc", "NaN", "Infinity", "1.2.3"} {
+		_, err := NewDecimalFromString(x)
+		require.Error(t, err, x)
+	}
+	var d Decimal
+	require.Error(t, json.Unmarshal([]byte(`1.5`), &d), "unquoted number")
+	require.Error(t, d.Unmarshal([]byte("NaN")))
+}
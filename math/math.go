@@ -3,6 +3,7 @@ package math
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/cockroachdb/apd/v2"
 
@@ -110,3 +111,134 @@ func SafeSub(res, x, y *apd.Decimal) error {
 
 	return nil
 }
+
+// Mul multiplies x and y and stores the result in res with arbitrary precision or returns an error.
+func Mul(res, x, y *apd.Decimal) error {
+	_, err := exactContext.Mul(res, x, y)
+	if err != nil {
+		return errors.Wrap(err, "decimal multiplication error")
+	}
+	return nil
+}
+
+// quoContext is used for divisions which can't be represented exactly, e.g. 1/3.
+var quoContext = apd.Context{
+	Precision:   34,
+	MaxExponent: apd.MaxExponent,
+	MinExponent: apd.MinExponent,
+	Traps:       apd.DefaultTraps,
+}
+
+// Quo divides x by y and stores the result in res rounded to 34 significant digits or returns an error.
+func Quo(res, x, y *apd.Decimal) error {
+	_, err := quoContext.Quo(res, x, y)
+	if err != nil {
+		return errors.Wrap(err, "decimal quotient error")
+	}
+	return nil
+}
+
+// Floor rounds x down to decimalPlaces and stores the result in res or returns an error.
+func Floor(res, x *apd.Decimal, decimalPlaces uint32) error {
+	return round(res, x, decimalPlaces, apd.RoundFloor)
+}
+
+// Ceil rounds x up to decimalPlaces and stores the result in res or returns an error.
+func Ceil(res, x *apd.Decimal, decimalPlaces uint32) error {
+	return round(res, x, decimalPlaces, apd.RoundCeiling)
+}
+
+// Round rounds x to decimalPlaces with the rounding mode of the other operations of
+// this package, i.e. half up, and stores the result in res or returns an error.
+func Round(res, x *apd.Decimal, decimalPlaces uint32) error {
+	return round(res, x, decimalPlaces, quoContext.Rounding)
+}
+
+func round(res, x *apd.Decimal, decimalPlaces uint32, rounding string) error {
+	ctx := quoContext
+	ctx.Rounding = rounding
+	if _, err := ctx.Quantize(res, x, -int32(decimalPlaces)); err != nil {
+		return errors.Wrap(err, "decimal rounding error")
+	}
+	return nil
+}
+
+// SplitLargestRemainder splits x into parts proportional to the given non-negative
+// shares, each rounded down to decimalPlaces. The remainder left by rounding is
+// handed out in units of the smallest decimal place to the parts with the largest
+// rounding remainders, ties going to the earlier part. The parts therefore always
+// sum up exactly to x, which must not have more than decimalPlaces decimal places.
+func SplitLargestRemainder(x *apd.Decimal, shares []*apd.Decimal, decimalPlaces uint32) ([]*apd.Decimal, error) {
+	if err := requireMaxDecimals(x, decimalPlaces); err != nil {
+		return nil, err
+	}
+	totalShares := apd.New(0, 0)
+	for _, share := range shares {
+		if share.Sign() < 0 {
+			return nil, errors.Wrap(errors.ErrInvalidRequest, fmt.Sprintf("expected non-negative shares, got %s", share))
+		}
+		if err := Add(totalShares, totalShares, share); err != nil {
+			return nil, err
+		}
+	}
+	if totalShares.IsZero() {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "expected a positive sum of shares")
+	}
+
+	floorContext := quoContext
+	floorContext.Rounding = apd.RoundDown
+	exponent := -int32(decimalPlaces)
+
+	parts := make([]*apd.Decimal, len(shares))
+	remainders := make([]*apd.Decimal, len(shares))
+	distributed := apd.New(0, 0)
+	for i, share := range shares {
+		exact := apd.New(0, 0)
+		if err := Mul(exact, x, share); err != nil {
+			return nil, err
+		}
+		if err := Quo(exact, exact, totalShares); err != nil {
+			return nil, err
+		}
+		parts[i] = apd.New(0, 0)
+		if _, err := floorContext.Quantize(parts[i], exact, exponent); err != nil {
+			return nil, errors.Wrap(err, "decimal rounding error")
+		}
+		remainders[i] = apd.New(0, 0)
+		if _, err := quoContext.Sub(remainders[i], exact, parts[i]); err != nil {
+			return nil, errors.Wrap(err, "decimal subtraction error")
+		}
+		if err := Add(distributed, distributed, parts[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	leftover := apd.New(0, 0)
+	if err := SafeSub(leftover, x, distributed); err != nil {
+		return nil, err
+	}
+	unit := apd.New(1, exponent)
+	units := apd.New(0, 0)
+	if err := Mul(units, leftover, apd.New(1, int32(decimalPlaces))); err != nil {
+		return nil, err
+	}
+	n, err := units.Int64()
+	if err != nil {
+		return nil, errors.Wrap(err, "leftover units")
+	}
+
+	order := make([]int, len(shares))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	for i := int64(0); i < n; i++ {
+		part := parts[order[i%int64(len(order))]]
+		if err := Add(part, part, unit); err != nil {
+			return nil, err
+		}
+	}
+	return parts, nil
+}
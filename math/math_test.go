@@ -31,3 +31,132 @@ func Test_CountDecPlaces(t *testing.T) {
 		})
 	}
 }
+
+func TestQuo(t *testing.T) {
+	tests := []struct {
+		x, y    string
+		want    string
+		wantErr bool
+	}{
+		{"1", "4", "0.25", false},
+		{"45", "100", "0.45", false},
+		{"1", "3", "0.3333333333333333333333333333333333", false},
+		{"0", "3", "0", false},
+		{"1", "0", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.x+"/"+tt.y, func(t *testing.T) {
+			x, _, err := apd.NewFromString(tt.x)
+			require.NoError(t, err)
+			y, _, err := apd.NewFromString(tt.y)
+			require.NoError(t, err)
+			res := apd.New(0, 0)
+			err = Quo(res, x, y)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, DecimalString(res))
+		})
+	}
+}
+
+func TestMul(t *testing.T) {
+	tests := []struct {
+		x, y string
+		want string
+	}{
+		{"2", "3", "6"},
+		{"0.5", "0.5", "0.25"},
+		{"0.0001", "10000", "1.0000"},
+		{"0", "3", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.x+"*"+tt.y, func(t *testing.T) {
+			x, _, err := apd.NewFromString(tt.x)
+			require.NoError(t, err)
+			y, _, err := apd.NewFromString(tt.y)
+			require.NoError(t, err)
+			res := apd.New(0, 0)
+			require.NoError(t, Mul(res, x, y))
+			require.Equal(t, tt.want, DecimalString(res))
+		})
+	}
+}
+
+func TestFloorCeilAndRound(t *testing.T) {
+	tests := []struct {
+		x             string
+		decimalPlaces uint32
+		wantFloor     string
+		wantCeil      string
+		wantRound     string
+	}{
+		{"4.5", 0, "4", "5", "5"},
+		{"6", 0, "6", "6", "6"},
+		{"1.25", 1, "1.2", "1.3", "1.3"},
+		{"1.24", 1, "1.2", "1.3", "1.2"},
+		{"2.555", 2, "2.55", "2.56", "2.56"},
+		{"-1.5", 0, "-2", "-1", "-2"},
+		{"0", 2, "0.00", "0.00", "0.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.x, func(t *testing.T) {
+			x, _, err := apd.NewFromString(tt.x)
+			require.NoError(t, err)
+			res := apd.New(0, 0)
+			require.NoError(t, Floor(res, x, tt.decimalPlaces))
+			require.Equal(t, tt.wantFloor, DecimalString(res))
+			require.NoError(t, Ceil(res, x, tt.decimalPlaces))
+			require.Equal(t, tt.wantCeil, DecimalString(res))
+			require.NoError(t, Round(res, x, tt.decimalPlaces))
+			require.Equal(t, tt.wantRound, DecimalString(res))
+		})
+	}
+}
+
+func TestSplitLargestRemainder(t *testing.T) {
+	tests := []struct {
+		name          string
+		x             string
+		shares        []string
+		decimalPlaces uint32
+		want          []string
+		wantErr       bool
+	}{
+		{"thirds", "1", []string{"1", "1", "1"}, 18, []string{"0.333333333333333334", "0.333333333333333333", "0.333333333333333333"}, false},
+		{"thirds of 2", "2", []string{"1", "1", "1"}, 2, []string{"0.67", "0.67", "0.66"}, false},
+		{"largest remainder", "1", []string{"0.2", "0.35", "0.45"}, 1, []string{"0.2", "0.4", "0.4"}, false},
+		{"exact 70/30", "10", []string{"0.7", "0.3"}, 0, []string{"7", "3"}, false},
+		{"zero share", "1", []string{"1", "0"}, 2, []string{"1.00", "0.00"}, false},
+		{"too many decimal places", "0.001", []string{"1"}, 2, nil, true},
+		{"negative share", "1", []string{"-1", "2"}, 2, nil, true},
+		{"no shares", "1", []string{"0"}, 2, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, _, err := apd.NewFromString(tt.x)
+			require.NoError(t, err)
+			shares := make([]*apd.Decimal, len(tt.shares))
+			for i, share := range tt.shares {
+				shares[i], _, err = apd.NewFromString(share)
+				require.NoError(t, err)
+			}
+			parts, err := SplitLargestRemainder(x, shares, tt.decimalPlaces)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			sum := apd.New(0, 0)
+			got := make([]string, len(parts))
+			for i, part := range parts {
+				got[i] = DecimalString(part)
+				require.NoError(t, Add(sum, sum, part))
+			}
+			require.Equal(t, tt.want, got)
+			require.Equal(t, 0, sum.Cmp(x))
+		})
+	}
+}
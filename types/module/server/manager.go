@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 
@@ -19,6 +20,18 @@ type Manager struct {
 	keys             map[string]ModuleKey
 	router           *router
 	requiredServices map[reflect.Type]bool
+
+	registerInvariantsHandlers []RegisterInvariantsHandler
+	endBlockers                []EndBlocker
+	migrationHandlers          []MigrationHandler
+	genesisHandlers            []genesisHandlers
+}
+
+// genesisHandlers are the genesis handlers of a module.
+type genesisHandlers struct {
+	moduleName    string
+	initGenesis   InitGenesisHandler
+	exportGenesis ExportGenesisHandler
 }
 
 // NewManager creates a new Manager
@@ -93,7 +106,8 @@ func (mm *Manager) RegisterModules(modules []module.Module) error {
 			key:              key,
 			cdc:              mm.cdc,
 			requiredServices: map[reflect.Type]bool{},
-			router:           mm.baseApp.Router(), // TODO: remove once #225 addressed
+			router:           mm.baseApp.Router(),          // TODO: remove once #225 addressed
+			queryRouter:      mm.baseApp.GRPCQueryRouter(), // TODO: remove once #225 addressed
 		}
 
 		serverMod.RegisterServices(cfg)
@@ -101,11 +115,87 @@ func (mm *Manager) RegisterModules(modules []module.Module) error {
 		for typ := range cfg.requiredServices {
 			mm.requiredServices[typ] = true
 		}
+
+		if cfg.registerInvariantsHandler != nil {
+			mm.registerInvariantsHandlers = append(mm.registerInvariantsHandlers, cfg.registerInvariantsHandler)
+		}
+
+		if cfg.endBlocker != nil {
+			mm.endBlockers = append(mm.endBlockers, cfg.endBlocker)
+		}
+
+		if cfg.migrationHandler != nil {
+			mm.migrationHandlers = append(mm.migrationHandlers, cfg.migrationHandler)
+		}
+
+		if cfg.initGenesis != nil {
+			mm.genesisHandlers = append(mm.genesisHandlers, genesisHandlers{
+				moduleName:    name,
+				initGenesis:   cfg.initGenesis,
+				exportGenesis: cfg.exportGenesis,
+			})
+		}
+	}
+
+	return nil
+}
+
+// RegisterInvariants registers the invariants of all modules with the InvariantRegistry,
+// in the order in which the modules were registered.
+func (mm *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
+	for _, handler := range mm.registerInvariantsHandlers {
+		handler(ir)
+	}
+}
+
+// EndBlock runs the end blockers of all modules, in the order in which the
+// modules were registered.
+func (mm *Manager) EndBlock(ctx sdk.Context) {
+	for _, endBlocker := range mm.endBlockers {
+		endBlocker(ctx)
+	}
+}
+
+// RunMigrations runs the migration handlers of all modules, in the order in which
+// the modules were registered.
+func (mm *Manager) RunMigrations(ctx sdk.Context) error {
+	for _, handler := range mm.migrationHandlers {
+		if err := handler(ctx); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
+// InitGenesis imports the genesis state of all modules with genesis handlers, in the
+// order in which the modules were registered. Modules without an entry in genesisData
+// are skipped.
+func (mm *Manager) InitGenesis(ctx sdk.Context, genesisData map[string]json.RawMessage) error {
+	for _, h := range mm.genesisHandlers {
+		data, ok := genesisData[h.moduleName]
+		if !ok {
+			continue
+		}
+		if err := h.initGenesis(ctx, data); err != nil {
+			return fmt.Errorf("init genesis of module %s: %w", h.moduleName, err)
+		}
+	}
 	return nil
 }
 
+// ExportGenesis exports the genesis state of all modules with genesis handlers.
+func (mm *Manager) ExportGenesis(ctx sdk.Context) (map[string]json.RawMessage, error) {
+	genesisData := make(map[string]json.RawMessage, len(mm.genesisHandlers))
+	for _, h := range mm.genesisHandlers {
+		data, err := h.exportGenesis(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("export genesis of module %s: %w", h.moduleName, err)
+		}
+		genesisData[h.moduleName] = data
+	}
+	return genesisData, nil
+}
+
 // AuthorizationMiddleware is a function that allows for more complex authorization than the default authorization scheme,
 // such as delegated permissions. It will be called only if the default authorization fails.
 type AuthorizationMiddleware func(ctx sdk.Context, methodName string, req sdk.MsgRequest, signer sdk.AccAddress) bool
@@ -135,6 +225,13 @@ type configurator struct {
 	cdc              codec.Marshaler
 	requiredServices map[reflect.Type]bool
 	router           sdk.Router
+	queryRouter      *baseapp.GRPCQueryRouter
+
+	registerInvariantsHandler RegisterInvariantsHandler
+	endBlocker                EndBlocker
+	migrationHandler          MigrationHandler
+	initGenesis               InitGenesisHandler
+	exportGenesis             ExportGenesisHandler
 }
 
 var _ Configurator = &configurator{}
@@ -161,6 +258,29 @@ func (c *configurator) Router() sdk.Router {
 	return c.router
 }
 
+// QueryRouter is temporarily added here to use in the group module.
+// TODO: remove once #225 addressed
+func (c *configurator) QueryRouter() *baseapp.GRPCQueryRouter {
+	return c.queryRouter
+}
+
+func (c *configurator) RegisterInvariantsHandler(handler RegisterInvariantsHandler) {
+	c.registerInvariantsHandler = handler
+}
+
+func (c *configurator) RegisterEndBlocker(handler EndBlocker) {
+	c.endBlocker = handler
+}
+
+func (c *configurator) RegisterMigrationHandler(handler MigrationHandler) {
+	c.migrationHandler = handler
+}
+
+func (c *configurator) RegisterGenesisHandlers(initGenesis InitGenesisHandler, exportGenesis ExportGenesisHandler) {
+	c.initGenesis = initGenesis
+	c.exportGenesis = exportGenesis
+}
+
 func (c *configurator) RequireServer(serverInterface interface{}) {
 	c.requiredServices[reflect.TypeOf(serverInterface)] = true
 }
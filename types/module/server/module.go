@@ -1,6 +1,9 @@
 package server
 
 import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
@@ -24,4 +27,42 @@ type Configurator interface {
 	// Router() is temporarily added here to use in the group module.
 	// TODO: remove once #225 addressed
 	Router() sdk.Router
+
+	// QueryRouter() is temporarily added here to use in the group module.
+	// TODO: remove once #225 addressed
+	QueryRouter() *baseapp.GRPCQueryRouter
+
+	// RegisterInvariantsHandler registers a handler which registers the module's invariants
+	// when Manager.RegisterInvariants is called.
+	RegisterInvariantsHandler(handler RegisterInvariantsHandler)
+
+	// RegisterEndBlocker registers a handler which is run when Manager.EndBlock
+	// is called at the end of every block.
+	RegisterEndBlocker(handler EndBlocker)
+
+	// RegisterMigrationHandler registers a handler which migrates the module's
+	// store when Manager.RunMigrations is called.
+	RegisterMigrationHandler(handler MigrationHandler)
+
+	// RegisterGenesisHandlers registers the handlers which import and export the
+	// module's genesis state when Manager.InitGenesis and Manager.ExportGenesis
+	// are called.
+	RegisterGenesisHandlers(initGenesis InitGenesisHandler, exportGenesis ExportGenesisHandler)
 }
+
+// RegisterInvariantsHandler registers invariants with an InvariantRegistry.
+type RegisterInvariantsHandler func(ir sdk.InvariantRegistry)
+
+// EndBlocker runs module logic at the end of a block.
+type EndBlocker func(ctx sdk.Context)
+
+// MigrationHandler migrates the module's store to the version expected by the
+// running code. It is run before every block and must do nothing once the store
+// is up to date.
+type MigrationHandler func(ctx sdk.Context) error
+
+// InitGenesisHandler imports the module's genesis state into its store.
+type InitGenesisHandler func(ctx sdk.Context, data json.RawMessage) error
+
+// ExportGenesisHandler exports the module's genesis state from its store.
+type ExportGenesisHandler func(ctx sdk.Context) (json.RawMessage, error)
@@ -1,16 +1,36 @@
 package group
 
 import (
+	"sort"
+
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
+// DecisionPolicyInterfaceName is the name the DecisionPolicy interface is registered
+// with in the interface registry.
+const DecisionPolicyInterfaceName = "regen.group.v1alpha1.DecisionPolicy"
+
 func RegisterTypes(registry cdctypes.InterfaceRegistry) {
 	msgservice.RegisterMsgServiceDesc(registry, &Msg_ServiceDesc)
 
 	registry.RegisterInterface(
-		"regen.group.v1alpha1.DecisionPolicy",
+		DecisionPolicyInterfaceName,
 		(*DecisionPolicy)(nil),
 		&ThresholdDecisionPolicy{},
+		&PercentageDecisionPolicy{},
+		&PluralityDecisionPolicy{},
+		&BicameralDecisionPolicy{},
+		&ConvictionDecisionPolicy{},
 	)
 }
+
+// RegisteredDecisionPolicies returns the sorted type URLs of the decision policies
+// registered in the interface registry, including the ones registered by apps in
+// addition to the policies of this module, e.g. to offer policy type choices in a CLI.
+// The type URLs are the ones expected by the module's AllowedDecisionPolicyTypes setting.
+func RegisteredDecisionPolicies(registry cdctypes.InterfaceRegistry) []string {
+	typeURLs := registry.ListImplementations(DecisionPolicyInterfaceName)
+	sort.Strings(typeURLs)
+	return typeURLs
+}
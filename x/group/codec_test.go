@@ -0,0 +1,32 @@
+package group
+
+import (
+	"testing"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisteredDecisionPolicies(t *testing.T) {
+	registry := cdctypes.NewInterfaceRegistry()
+	assert.Empty(t, RegisteredDecisionPolicies(registry))
+
+	registry.RegisterInterface(DecisionPolicyInterfaceName, (*DecisionPolicy)(nil),
+		&ThresholdDecisionPolicy{},
+		&PercentageDecisionPolicy{},
+	)
+	assert.Equal(t, []string{
+		"/regen.group.v1alpha1.PercentageDecisionPolicy",
+		"/regen.group.v1alpha1.ThresholdDecisionPolicy",
+	}, RegisteredDecisionPolicies(registry))
+
+	registry = cdctypes.NewInterfaceRegistry()
+	RegisterTypes(registry)
+	assert.Equal(t, []string{
+		"/regen.group.v1alpha1.BicameralDecisionPolicy",
+		"/regen.group.v1alpha1.ConvictionDecisionPolicy",
+		"/regen.group.v1alpha1.PercentageDecisionPolicy",
+		"/regen.group.v1alpha1.PluralityDecisionPolicy",
+		"/regen.group.v1alpha1.ThresholdDecisionPolicy",
+	}, RegisteredDecisionPolicies(registry))
+}
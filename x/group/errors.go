@@ -5,12 +5,19 @@ import (
 )
 
 var (
-	ErrEmpty        = sdkerrors.Register(ModuleName, 202, "value is empty")
-	ErrDuplicate    = sdkerrors.Register(ModuleName, 203, "duplicate value")
-	ErrMaxLimit     = sdkerrors.Register(ModuleName, 204, "limit exceeded")
-	ErrType         = sdkerrors.Register(ModuleName, 205, "invalid type")
-	ErrInvalid      = sdkerrors.Register(ModuleName, 206, "invalid value")
-	ErrUnauthorized = sdkerrors.Register(ModuleName, 207, "unauthorized")
-	ErrModified     = sdkerrors.Register(ModuleName, 208, "modified")
-	ErrExpired      = sdkerrors.Register(ModuleName, 209, "expired")
+	ErrEmpty              = sdkerrors.Register(ModuleName, 202, "value is empty")
+	ErrDuplicate          = sdkerrors.Register(ModuleName, 203, "duplicate value")
+	ErrMaxLimit           = sdkerrors.Register(ModuleName, 204, "limit exceeded")
+	ErrType               = sdkerrors.Register(ModuleName, 205, "invalid type")
+	ErrInvalid            = sdkerrors.Register(ModuleName, 206, "invalid value")
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 207, "unauthorized")
+	ErrModified           = sdkerrors.Register(ModuleName, 208, "modified")
+	ErrExpired            = sdkerrors.Register(ModuleName, 209, "expired")
+	ErrInvalidThreshold   = sdkerrors.Register(ModuleName, 210, "invalid threshold")
+	ErrTimeoutOutOfBounds = sdkerrors.Register(ModuleName, 211, "timeout out of bounds")
+	ErrNotGroupMember     = sdkerrors.Register(ModuleName, 212, "not a group member")
+	ErrProposalFinal      = sdkerrors.Register(ModuleName, 213, "proposal is final")
+	ErrMalformedWeight    = sdkerrors.Register(ModuleName, 214, "malformed weight")
+	ErrNegativeWeight     = sdkerrors.Register(ModuleName, 215, "negative weight")
+	ErrZeroWeight         = sdkerrors.Register(ModuleName, 216, "zero weight")
 )
@@ -5,7 +5,9 @@ package group
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -206,31 +208,195 @@ func (m *EventUpdateGroupAccount) GetGroupAccount() string {
 	return ""
 }
 
+// EventAdminOverride is an event emitted when a group account admin overrides
+// the vote on a proposal.
+type EventAdminOverride struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// admin is the account address of the admin who performed the override.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// action is the override action applied to the proposal.
+	Action OverrideAction `protobuf:"varint,3,opt,name=action,proto3,enum=regen.group.v1alpha1.OverrideAction" json:"action,omitempty"`
+	// timestamp is the block time at which the override happened.
+	Timestamp types.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp"`
+}
+
+func (m *EventAdminOverride) Reset()         { *m = EventAdminOverride{} }
+func (m *EventAdminOverride) String() string { return proto.CompactTextString(m) }
+func (*EventAdminOverride) ProtoMessage()    {}
+func (*EventAdminOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3545d78da3f76a06, []int{4}
+}
+func (m *EventAdminOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminOverride.Merge(m, src)
+}
+func (m *EventAdminOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminOverride proto.InternalMessageInfo
+
+func (m *EventAdminOverride) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventAdminOverride) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *EventAdminOverride) GetAction() OverrideAction {
+	if m != nil {
+		return m.Action
+	}
+	return OverrideAction_OVERRIDE_ACTION_UNSPECIFIED
+}
+
+func (m *EventAdminOverride) GetTimestamp() types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return types.Timestamp{}
+}
+
+// EventProposalFinalized is an event emitted when the result of a proposal whose
+// voting period ended is computed at the end of a block.
+type EventProposalFinalized struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// status is the status of the proposal after finalization.
+	Status Proposal_Status `protobuf:"varint,2,opt,name=status,proto3,enum=regen.group.v1alpha1.Proposal_Status" json:"status,omitempty"`
+	// result is the final result of the proposal.
+	Result Proposal_Result `protobuf:"varint,3,opt,name=result,proto3,enum=regen.group.v1alpha1.Proposal_Result" json:"result,omitempty"`
+	// result_reason is the human-readable reason for the result.
+	ResultReason string `protobuf:"bytes,4,opt,name=result_reason,json=resultReason,proto3" json:"result_reason,omitempty"`
+}
+
+func (m *EventProposalFinalized) Reset()         { *m = EventProposalFinalized{} }
+func (m *EventProposalFinalized) String() string { return proto.CompactTextString(m) }
+func (*EventProposalFinalized) ProtoMessage()    {}
+func (*EventProposalFinalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3545d78da3f76a06, []int{5}
+}
+func (m *EventProposalFinalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalFinalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalFinalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalFinalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalFinalized.Merge(m, src)
+}
+func (m *EventProposalFinalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalFinalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalFinalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalFinalized proto.InternalMessageInfo
+
+func (m *EventProposalFinalized) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventProposalFinalized) GetStatus() Proposal_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatusInvalid
+}
+
+func (m *EventProposalFinalized) GetResult() Proposal_Result {
+	if m != nil {
+		return m.Result
+	}
+	return ProposalResultInvalid
+}
+
+func (m *EventProposalFinalized) GetResultReason() string {
+	if m != nil {
+		return m.ResultReason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "regen.group.v1alpha1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "regen.group.v1alpha1.EventUpdateGroup")
 	proto.RegisterType((*EventCreateGroupAccount)(nil), "regen.group.v1alpha1.EventCreateGroupAccount")
 	proto.RegisterType((*EventUpdateGroupAccount)(nil), "regen.group.v1alpha1.EventUpdateGroupAccount")
+	proto.RegisterType((*EventAdminOverride)(nil), "regen.group.v1alpha1.EventAdminOverride")
+	proto.RegisterType((*EventProposalFinalized)(nil), "regen.group.v1alpha1.EventProposalFinalized")
 }
 
 func init() { proto.RegisterFile("regen/group/v1alpha1/events.proto", fileDescriptor_3545d78da3f76a06) }
 
 var fileDescriptor_3545d78da3f76a06 = []byte{
-	// 215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x4a, 0x4d, 0x4f,
-	0xcd, 0xd3, 0x4f, 0x2f, 0xca, 0x2f, 0x2d, 0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34,
-	0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x01,
-	0x2b, 0xd1, 0x03, 0x2b, 0xd1, 0x83, 0x29, 0x51, 0xd2, 0xe5, 0x12, 0x70, 0x05, 0xa9, 0x72, 0x2e,
-	0x4a, 0x4d, 0x2c, 0x49, 0x75, 0x07, 0x49, 0x0a, 0x49, 0x72, 0x71, 0x80, 0x55, 0xc5, 0x67, 0xa6,
-	0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xb1, 0x83, 0xf9, 0x9e, 0x29, 0x70, 0xe5, 0xa1, 0x05,
-	0x29, 0xc4, 0x28, 0xb7, 0xe3, 0x12, 0x47, 0x37, 0xdd, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0x44,
-	0x48, 0x99, 0x8b, 0x17, 0xa2, 0x2b, 0x11, 0x22, 0x00, 0xd5, 0xca, 0x93, 0x8e, 0xa4, 0x08, 0xae,
-	0x1f, 0xc9, 0x3a, 0x52, 0xf4, 0x3b, 0xb9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x38, 0x60,
-	0x74, 0xf3, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xa1, 0xbc, 0x9c, 0xd4, 0x94, 0xf4, 0xd4, 0x22,
-	0xfd, 0x0a, 0x48, 0x90, 0x26, 0xb1, 0x81, 0xc3, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x77,
-	0x58, 0x55, 0x7e, 0x68, 0x01, 0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xc0, 0x6b, 0x56, 0x0a, 0xf5, 0xc6, 0x84, 0xa2, 0x0a, 0x42, 0x0f, 0x69, 0x19, 0x20, 0xf5,
+	0x52, 0x47, 0x2b, 0x57, 0x98, 0xd4, 0x21, 0x98, 0x76, 0x02, 0x19, 0xb8, 0x70, 0x99, 0xdc, 0xe6,
+	0xe1, 0x45, 0x24, 0xb1, 0xe5, 0x38, 0xe5, 0xcf, 0xa7, 0xe0, 0x63, 0x8d, 0xdb, 0x8e, 0x1c, 0x10,
+	0x42, 0xed, 0x17, 0x41, 0x79, 0x76, 0xca, 0x84, 0x2a, 0x51, 0x6e, 0x7e, 0x4f, 0xbf, 0xdf, 0xfb,
+	0x93, 0x17, 0x7a, 0xdf, 0x80, 0x84, 0x22, 0x96, 0x46, 0x55, 0x3a, 0x5e, 0x1c, 0x8a, 0x4c, 0x9f,
+	0x8b, 0xc3, 0x18, 0x16, 0x50, 0xd8, 0x92, 0x69, 0xa3, 0xac, 0x0a, 0x7a, 0x88, 0x30, 0x44, 0x58,
+	0x83, 0xf4, 0x7b, 0x52, 0x49, 0x85, 0x40, 0x5c, 0xbf, 0x1c, 0xdb, 0x1f, 0x48, 0xa5, 0x64, 0x06,
+	0x31, 0x46, 0xb3, 0xea, 0x7d, 0x6c, 0xd3, 0x1c, 0x4a, 0x2b, 0x72, 0xed, 0x81, 0xe1, 0xc6, 0x7e,
+	0xf6, 0xb3, 0x06, 0xdf, 0xee, 0x60, 0x4c, 0x6f, 0x3f, 0xaf, 0xdb, 0x3f, 0x33, 0x20, 0x2c, 0x9c,
+	0xd4, 0x60, 0x70, 0x8f, 0xde, 0x44, 0xe3, 0x2c, 0x4d, 0x42, 0x32, 0x24, 0xa3, 0x2e, 0xbf, 0x81,
+	0xf1, 0x69, 0xb2, 0xc6, 0xdf, 0xea, 0x64, 0x1b, 0xfc, 0x88, 0xde, 0xfd, 0xbb, 0xfa, 0x74, 0x3e,
+	0x57, 0x55, 0x61, 0x83, 0x07, 0xf4, 0x96, 0xb3, 0x84, 0x4b, 0x78, 0x75, 0x4f, 0x5e, 0x81, 0xd6,
+	0xfe, 0x95, 0x76, 0xff, 0xe5, 0x7f, 0x23, 0x34, 0xc0, 0x02, 0xd3, 0x24, 0x4f, 0x8b, 0x97, 0x0b,
+	0x30, 0x26, 0x4d, 0x20, 0x18, 0xd0, 0x5d, 0x6d, 0x94, 0x56, 0xa5, 0xc8, 0x9a, 0xa1, 0xdb, 0x9c,
+	0x36, 0xa9, 0xd3, 0x24, 0xe8, 0xd1, 0xeb, 0xa2, 0x36, 0xc2, 0x6b, 0x58, 0xd4, 0x05, 0xc1, 0x13,
+	0xda, 0x11, 0x73, 0x9b, 0xaa, 0x22, 0xdc, 0x19, 0x92, 0xd1, 0xfe, 0xe4, 0x21, 0xdb, 0x74, 0x2b,
+	0xd6, 0xb4, 0x99, 0x22, 0xcb, 0xbd, 0x13, 0x1c, 0xd1, 0xee, 0xfa, 0x3c, 0x61, 0x7b, 0x48, 0x46,
+	0xbb, 0x93, 0x3e, 0x73, 0x07, 0x64, 0xcd, 0x01, 0xd9, 0x9b, 0x86, 0x38, 0x6e, 0x5f, 0xfc, 0x1c,
+	0xb4, 0xf8, 0x1f, 0xe5, 0xe0, 0x07, 0xa1, 0x77, 0x70, 0x97, 0x57, 0x7e, 0xce, 0x17, 0x69, 0x21,
+	0xb2, 0xf4, 0x0b, 0x24, 0xff, 0xde, 0xe7, 0x29, 0xed, 0x94, 0x56, 0xd8, 0xaa, 0xc4, 0x85, 0xf6,
+	0x27, 0x8f, 0x36, 0x4f, 0xde, 0x54, 0x66, 0xaf, 0x11, 0xe6, 0x5e, 0xaa, 0x75, 0x03, 0x65, 0x95,
+	0xd9, 0x70, 0x67, 0x2b, 0x9d, 0x23, 0xcc, 0xbd, 0x54, 0x9f, 0xca, 0xbd, 0xce, 0x0c, 0x88, 0x52,
+	0x15, 0xb8, 0x7d, 0x97, 0xef, 0xb9, 0x24, 0xc7, 0xdc, 0xf1, 0xc9, 0xc5, 0x32, 0x22, 0x97, 0xcb,
+	0x88, 0xfc, 0x5a, 0x46, 0xe4, 0xeb, 0x2a, 0x6a, 0x5d, 0xae, 0xa2, 0xd6, 0xf7, 0x55, 0xd4, 0x7a,
+	0x37, 0x96, 0xa9, 0x3d, 0xaf, 0x66, 0x6c, 0xae, 0xf2, 0x18, 0xfb, 0x8e, 0x0b, 0xb0, 0x1f, 0x95,
+	0xf9, 0xe0, 0xa3, 0x0c, 0x12, 0x09, 0x26, 0xfe, 0xe4, 0x7e, 0xf3, 0x59, 0x07, 0x3f, 0xe6, 0xe3,
+	0xdf, 0x03, 0x00, 0x6a, 0x10, 0x43, 0xaf, 0x6c, 0x03, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -353,6 +519,101 @@ func (m *EventUpdateGroupAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAdminOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Action != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProposalFinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalFinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResultReason) > 0 {
+		i -= len(m.ResultReason)
+		copy(dAtA[i:], m.ResultReason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ResultReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -416,6 +677,49 @@ func (m *EventUpdateGroupAccount) Size() (n int) {
 	return n
 }
 
+func (m *EventAdminOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovEvents(uint64(m.Action))
+	}
+	l = m.Timestamp.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventProposalFinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.Result != 0 {
+		n += 1 + sovEvents(uint64(m.Result))
+	}
+	l = len(m.ResultReason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -762,6 +1066,304 @@ func (m *EventUpdateGroupAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventAdminOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= OverrideAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProposalFinalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalFinalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Proposal_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= Proposal_Result(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
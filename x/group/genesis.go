@@ -1,5 +1,13 @@
 package group
 
+import (
+	"github.com/cockroachdb/apd/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/math"
+)
+
 // NewGenesisState creates a new genesis state with default values.
 func NewGenesisState() *GenesisState {
 	return &GenesisState{}
@@ -8,3 +16,56 @@ func NewGenesisState() *GenesisState {
 func (s GenesisState) Validate() error {
 	return nil
 }
+
+// MemberEntry is a single structured member row, as read from a CSV-like
+// member list used to bootstrap a group at genesis.
+type MemberEntry struct {
+	Address string
+	Weight  string
+	Comment string
+}
+
+// BuildGroupMembers validates the given member entries and builds the
+// GroupMember records for the group together with the group's total weight.
+// Each entry must have a valid address and a positive weight, and addresses
+// must not repeat. Errors reference the 1-based row of the offending entry.
+// The entry comment is stored as the member metadata.
+func BuildGroupMembers(groupID ID, entries []MemberEntry) ([]GroupMember, string, error) {
+	if groupID.Empty() {
+		return nil, "", sdkerrors.Wrap(ErrEmpty, "group")
+	}
+
+	members := make([]GroupMember, len(entries))
+	rows := make(map[string]int, len(entries))
+	totalWeight := apd.New(0, 0)
+	for i, entry := range entries {
+		row := i + 1
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return nil, "", sdkerrors.Wrapf(err, "row %d: address", row)
+		}
+		if prev, exists := rows[entry.Address]; exists {
+			return nil, "", sdkerrors.Wrapf(ErrDuplicate, "row %d: address %s already in row %d", row, entry.Address, prev)
+		}
+		rows[entry.Address] = row
+
+		// Members of a group must have a positive weight.
+		weight, err := ParseWeight(entry.Weight)
+		if err != nil {
+			return nil, "", sdkerrors.Wrapf(err, "row %d: weight", row)
+		}
+		if err := math.Add(totalWeight, totalWeight, &weight.Decimal); err != nil {
+			return nil, "", sdkerrors.Wrapf(err, "row %d: weight", row)
+		}
+
+		members[i] = GroupMember{
+			GroupId: groupID,
+			Member: &Member{
+				Address:  entry.Address,
+				Weight:   math.DecimalString(&weight.Decimal),
+				Metadata: []byte(entry.Comment),
+			},
+		}
+	}
+
+	return members, math.DecimalString(totalWeight), nil
+}
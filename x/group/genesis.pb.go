@@ -26,6 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // TODO: #214
 // GenesisState defines the group module's genesis state.
 type GenesisState struct {
+	// next_proposal_id is the ID the next proposal will be created with. It is restored on import
+	// so that the IDs of exported proposals, including deleted and archived ones, are never reused.
+	// Zero leaves the proposal ID sequence at its start.
+	NextProposalId ProposalID `protobuf:"varint,1,opt,name=next_proposal_id,json=nextProposalId,proto3,casttype=ProposalID" json:"next_proposal_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -61,6 +65,13 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetNextProposalId() ProposalID {
+	if m != nil {
+		return m.NextProposalId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "regen.group.v1alpha1.GenesisState")
 }
@@ -70,18 +81,20 @@ func init() {
 }
 
 var fileDescriptor_6ccc5d002e96a4ab = []byte{
-	// 163 bytes of a gzipped FileDescriptorProto
+	// 204 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x4a, 0x4d, 0x4f,
 	0xcd, 0xd3, 0x4f, 0x2f, 0xca, 0x2f, 0x2d, 0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34,
 	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
 	0x01, 0xab, 0xd1, 0x03, 0xab, 0xd1, 0x83, 0xa9, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0x95, 0xf8, 0xb8, 0x78, 0xdc, 0x21, 0x9a, 0x83, 0x4b, 0x12, 0x4b,
-	0x52, 0x9d, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d,
-	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6c, 0x81, 0x6e, 0x5e, 0x6a, 0x49,
-	0x79, 0x7e, 0x51, 0x36, 0x94, 0x97, 0x93, 0x9a, 0x92, 0x9e, 0x5a, 0xa4, 0x5f, 0x01, 0x71, 0x5b,
-	0x12, 0x1b, 0xd8, 0x7c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0xd0, 0x61, 0x97, 0xb1,
-	0x00, 0x00, 0x00,
+	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0x95, 0x3c, 0xb8, 0x78, 0xdc, 0x21, 0x9a, 0x83, 0x4b, 0x12, 0x4b,
+	0x52, 0x85, 0x2c, 0xb8, 0x04, 0xf2, 0x52, 0x2b, 0x4a, 0xe2, 0x0b, 0x8a, 0xf2, 0x0b, 0xf2, 0x8b,
+	0x13, 0x73, 0xe2, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x9c, 0xf8, 0x7e, 0xdd, 0x93,
+	0xe7, 0x0a, 0x80, 0x0a, 0x7b, 0xba, 0x04, 0xf1, 0x81, 0xd4, 0xc1, 0xf9, 0x29, 0x4e, 0xee, 0x27,
+	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
+	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59, 0x92, 0x51, 0x9a,
+	0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x76, 0x9a, 0x6e, 0x5e, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0x36,
+	0x94, 0x97, 0x93, 0x9a, 0x92, 0x9e, 0x5a, 0xa4, 0x5f, 0x01, 0xf1, 0x55, 0x12, 0x1b, 0xd8, 0x65,
+	0xc6, 0x80, 0x01, 0x00, 0x5a, 0x93, 0xdc, 0x23, 0xeb, 0x00, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -104,6 +117,11 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -124,6 +142,9 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.NextProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextProposalId))
+	}
 	return n
 }
 
@@ -162,6 +183,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalId", wireType)
+			}
+			m.NextProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalId |= ProposalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
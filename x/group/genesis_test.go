@@ -0,0 +1,110 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildGroupMembers(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+
+	specs := map[string]struct {
+		groupID        ID
+		entries        []MemberEntry
+		expMembers     []GroupMember
+		expTotalWeight string
+		expErr         bool
+		expErrMsg      string
+	}{
+		"clean list": {
+			groupID: 1,
+			entries: []MemberEntry{
+				{Address: addr1.String(), Weight: "1", Comment: "first"},
+				{Address: addr2.String(), Weight: "2.5"},
+				{Address: addr3.String(), Weight: "0.5", Comment: "third"},
+			},
+			expMembers: []GroupMember{
+				{GroupId: 1, Member: &Member{Address: addr1.String(), Weight: "1", Metadata: []byte("first")}},
+				{GroupId: 1, Member: &Member{Address: addr2.String(), Weight: "2.5", Metadata: []byte{}}},
+				{GroupId: 1, Member: &Member{Address: addr3.String(), Weight: "0.5", Metadata: []byte("third")}},
+			},
+			expTotalWeight: "4.0",
+		},
+		"empty list": {
+			groupID:        1,
+			expMembers:     []GroupMember{},
+			expTotalWeight: "0",
+		},
+		"duplicate address": {
+			groupID: 1,
+			entries: []MemberEntry{
+				{Address: addr1.String(), Weight: "1"},
+				{Address: addr2.String(), Weight: "1"},
+				{Address: addr1.String(), Weight: "2"},
+			},
+			expErr:    true,
+			expErrMsg: "row 3: address " + addr1.String() + " already in row 1",
+		},
+		"invalid address": {
+			groupID: 1,
+			entries: []MemberEntry{
+				{Address: addr1.String(), Weight: "1"},
+				{Address: "invalid", Weight: "1"},
+			},
+			expErr:    true,
+			expErrMsg: "row 2: address",
+		},
+		"zero weight": {
+			groupID: 1,
+			entries: []MemberEntry{
+				{Address: addr1.String(), Weight: "0"},
+			},
+			expErr:    true,
+			expErrMsg: "row 1: weight",
+		},
+		"negative weight": {
+			groupID: 1,
+			entries: []MemberEntry{
+				{Address: addr1.String(), Weight: "1"},
+				{Address: addr2.String(), Weight: "-1"},
+			},
+			expErr:    true,
+			expErrMsg: "row 2: weight",
+		},
+		"invalid weight": {
+			groupID: 1,
+			entries: []MemberEntry{
+				{Address: addr1.String(), Weight: "abc"},
+			},
+			expErr:    true,
+			expErrMsg: "row 1: weight",
+		},
+		"empty group id": {
+			entries: []MemberEntry{
+				{Address: addr1.String(), Weight: "1"},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			members, totalWeight, err := BuildGroupMembers(spec.groupID, spec.entries)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), spec.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expMembers, members)
+			assert.Equal(t, spec.expTotalWeight, totalWeight)
+			for _, m := range members {
+				require.NoError(t, m.ValidateBasic())
+			}
+		})
+	}
+}
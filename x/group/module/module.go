@@ -3,6 +3,7 @@ package module
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	climodule "github.com/regen-network/regen-ledger/types/module/client/cli"
@@ -18,7 +19,73 @@ import (
 	"github.com/spf13/cobra"
 )
 
-type Module struct{}
+type Module struct {
+	// GroupValidator is an optional hook for the host app to reject group
+	// creation and member additions.
+	GroupValidator group.GroupValidator
+
+	// AllowedDecisionPolicyTypes optionally restricts the decision policies group accounts
+	// can use to the given type URLs, e.g. "/regen.group.v1alpha1.ThresholdDecisionPolicy".
+	// All decision policy types are allowed if empty.
+	AllowedDecisionPolicyTypes []string
+
+	// MinMembersForProposals optionally sets the minimum number of members a group
+	// must have before proposals can be submitted. There is no minimum if 0.
+	MinMembersForProposals uint64
+
+	// WeightPrecision optionally sets the maximum number of decimal places of member
+	// weights, after applying role multipliers. Weights are not limited if 0.
+	// Decimal arithmetic on weights is exact, so sums of weights never exceed this
+	// precision. Changing it on a running chain is consensus breaking and existing
+	// weights with more decimal places need to be migrated.
+	WeightPrecision uint32
+
+	// AllowAdminProposers optionally allows the admin of a group to submit proposals
+	// to the group's accounts without being a group member. By default only group
+	// members can submit proposals.
+	AllowAdminProposers bool
+
+	// MaxOpenProposals optionally sets the maximum number of submitted proposals a
+	// group can have at a time. Proposals whose voting period ended don't count
+	// toward it. There is no maximum if 0.
+	MaxOpenProposals uint64
+
+	// TimeoutGranularity optionally requires decision policy timeouts to be a multiple
+	// of the given duration, e.g. time.Second to reject sub-second timeouts which are
+	// finer than the precision of block times. Timeouts are not restricted if 0.
+	TimeoutGranularity time.Duration
+
+	// ProposalEditingWindow optionally allows proposers to amend a proposal within the
+	// given duration after its submission, as long as it wasn't voted on and its voting
+	// start time, if any, wasn't reached. Proposals can't be amended if 0.
+	ProposalEditingWindow time.Duration
+
+	// FastTrackWindow and FastTrackPercentage optionally allow fast-track proposals,
+	// e.g. for emergencies. A fast-track proposal times out after FastTrackWindow,
+	// which should be shorter than the usual decision policy timeouts, and is only
+	// accepted with a share of yes votes of the total weight of FastTrackPercentage,
+	// which should be higher than the usual thresholds. Fast-track proposals are
+	// rejected if FastTrackWindow is 0.
+	FastTrackWindow     time.Duration
+	FastTrackPercentage string
+
+	// MaxExecutionRetries optionally retries the execution of accepted proposals that
+	// failed, e.g. on insufficient funds, at the end of up to the given number of
+	// later blocks. Proposals that still fail are then marked as permanently failed.
+	// Failed executions are only retried manually if 0.
+	MaxExecutionRetries uint64
+
+	// ArchiveProposals optionally moves proposals that are done, with their final
+	// result and tally, out of the proposal table into an archive at the end of each
+	// block, keeping the proposal table small. Archived proposals are queried with
+	// the ArchivedProposal query, their individual votes are deleted.
+	ArchiveProposals bool
+
+	// RequireGroupName optionally requires every group to have a human-readable
+	// name, stored as the group metadata. Groups with empty or blank metadata are
+	// then rejected on creation, import and metadata updates. Names aren't required by default.
+	RequireGroupName bool
+}
 
 var _ module.AppModuleBasic = Module{}
 var _ servermodule.Module = Module{}
@@ -34,7 +101,21 @@ func (a Module) RegisterInterfaces(registry types.InterfaceRegistry) {
 }
 
 func (a Module) RegisterServices(configurator servermodule.Configurator) {
-	server.RegisterServices(configurator)
+	server.RegisterServices(configurator, server.Config{
+		GroupValidator:             a.GroupValidator,
+		AllowedDecisionPolicyTypes: a.AllowedDecisionPolicyTypes,
+		MinMembersForProposals:     a.MinMembersForProposals,
+		WeightPrecision:            a.WeightPrecision,
+		AllowAdminProposers:        a.AllowAdminProposers,
+		MaxOpenProposals:           a.MaxOpenProposals,
+		TimeoutGranularity:         a.TimeoutGranularity,
+		ProposalEditingWindow:      a.ProposalEditingWindow,
+		FastTrackWindow:            a.FastTrackWindow,
+		FastTrackPercentage:        a.FastTrackPercentage,
+		MaxExecutionRetries:        a.MaxExecutionRetries,
+		ArchiveProposals:           a.ArchiveProposals,
+		RequireGroupName:           a.RequireGroupName,
+	})
 }
 
 func (a Module) DefaultGenesis(marshaler codec.JSONMarshaler) json.RawMessage {
@@ -1,13 +1,14 @@
 package group
 
 import (
+	"crypto/sha256"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	proto "github.com/gogo/protobuf/proto"
-	"github.com/regen-network/regen-ledger/math"
+	gogotypes "github.com/gogo/protobuf/types"
 )
 
 var _ sdk.MsgRequest = &MsgCreateGroupRequest{}
@@ -33,11 +34,44 @@ func (m MsgCreateGroupRequest) ValidateBasic() error {
 	}
 	for i := range m.Members {
 		member := m.Members[i]
-		if _, err := math.ParsePositiveDecimal(member.Weight); err != nil {
+		if _, err := ParseWeight(member.Weight); err != nil {
 			return sdkerrors.Wrap(err, "member weight")
 		}
 	}
-	return nil
+	roleMultipliers := RoleMultipliers(m.RoleMultipliers)
+	if err := roleMultipliers.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "role multipliers")
+	}
+	if err := roleMultipliers.AssertRoles(m.Members); err != nil {
+		return sdkerrors.Wrap(err, "members")
+	}
+	if m.WeightDecay != nil {
+		if err := m.WeightDecay.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "weight decay")
+		}
+	}
+	if err := validateMetadataURI(m.MetadataUri, m.MetadataHash); err != nil {
+		return err
+	}
+	if m.Seats != 0 {
+		if uint64(len(m.Members)) > m.Seats {
+			return sdkerrors.Wrapf(ErrMaxLimit, "%d members for %d seats", len(m.Members), m.Seats)
+		}
+		if err := validateCouncil(m.RoleMultipliers, m.WeightDecay); err != nil {
+			return err
+		}
+		for _, member := range m.Members {
+			if err := assertSeatWeight(member); err != nil {
+				return sdkerrors.Wrap(err, "members")
+			}
+		}
+	}
+	if m.ProposalSchema != nil {
+		if err := m.ProposalSchema.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "proposal schema")
+		}
+	}
+	return validateNormalizedWeights(m.NormalizedWeights, m.NormalizedTotal, m.RoleMultipliers, m.WeightDecay, m.Seats)
 }
 
 func (m Member) ValidateBasic() error {
@@ -45,9 +79,15 @@ func (m Member) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrap(err, "address")
 	}
-	if _, err := math.ParseNonNegativeDecimal(m.Weight); err != nil {
+	// A zero weight is valid here as it removes a member in a membership update.
+	if _, err := ParseWeight(m.Weight); err != nil && !ErrZeroWeight.Is(err) {
 		return sdkerrors.Wrap(err, "weight")
 	}
+	if m.ExpiresAt != nil {
+		if _, err := gogotypes.TimestampFromProto(m.ExpiresAt); err != nil {
+			return sdkerrors.Wrap(err, "expires at")
+		}
+	}
 
 	return nil
 }
@@ -117,6 +157,96 @@ func (m *MsgUpdateGroupMetadataRequest) GetGroupID() ID {
 	return m.GroupId
 }
 
+var _ sdk.MsgRequest = &MsgUpdateGroupProposalSchemaRequest{}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupProposalSchemaRequest.
+func (m MsgUpdateGroupProposalSchemaRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUpdateGroupProposalSchemaRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if m.ProposalSchema != nil {
+		if err := m.ProposalSchema.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "proposal schema")
+		}
+	}
+	return nil
+}
+
+func (m *MsgUpdateGroupProposalSchemaRequest) GetGroupID() ID {
+	return m.GroupId
+}
+
+var _ sdk.MsgRequest = &MsgRepairTotalWeightRequest{}
+
+// GetSigners returns the expected signers for a MsgRepairTotalWeightRequest.
+func (m MsgRepairTotalWeightRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgRepairTotalWeightRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	return nil
+}
+
+func (m *MsgRepairTotalWeightRequest) GetGroupID() ID {
+	return m.GroupId
+}
+
+var _ sdk.MsgRequest = &MsgCloneGroupRequest{}
+
+// GetSigners returns the expected signers for a MsgCloneGroupRequest.
+func (m MsgCloneGroupRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgCloneGroupRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	_, err = sdk.AccAddressFromBech32(m.NewAdmin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+	return nil
+}
+
+func (m *MsgCloneGroupRequest) GetGroupID() ID {
+	return m.GroupId
+}
+
 var _ sdk.MsgRequest = &MsgUpdateGroupMembersRequest{}
 
 // GetSigners returns the expected signers for a MsgUpdateGroupMembersRequest.
@@ -152,6 +282,147 @@ func (m *MsgUpdateGroupMembersRequest) GetGroupID() ID {
 	return m.GroupId
 }
 
+var _ sdk.MsgRequest = &MsgInviteMemberRequest{}
+
+// GetSigners returns the expected signers for a MsgInviteMemberRequest.
+func (m MsgInviteMemberRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgInviteMemberRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if err := m.Member.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "member")
+	}
+	if _, err := ParseWeight(m.Member.Weight); err != nil {
+		return sdkerrors.Wrap(err, "member weight")
+	}
+	return nil
+}
+
+func (m *MsgInviteMemberRequest) GetGroupID() ID {
+	return m.GroupId
+}
+
+var _ sdk.MsgRequest = &MsgAcceptInvitationRequest{}
+
+// GetSigners returns the expected signers for a MsgAcceptInvitationRequest.
+func (m MsgAcceptInvitationRequest) GetSigners() []sdk.AccAddress {
+	invitee, err := sdk.AccAddressFromBech32(m.Invitee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{invitee}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgAcceptInvitationRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Invitee)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invitee")
+	}
+	return nil
+}
+
+var _ sdk.MsgRequest = &MsgDeclineInvitationRequest{}
+
+// GetSigners returns the expected signers for a MsgDeclineInvitationRequest.
+func (m MsgDeclineInvitationRequest) GetSigners() []sdk.AccAddress {
+	invitee, err := sdk.AccAddressFromBech32(m.Invitee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{invitee}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgDeclineInvitationRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Invitee)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invitee")
+	}
+	return nil
+}
+
+var _ sdk.MsgRequest = &MsgAssignSeatRequest{}
+
+// GetSigners returns the expected signers for a MsgAssignSeatRequest.
+func (m MsgAssignSeatRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgAssignSeatRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	_, err = sdk.AccAddressFromBech32(m.Member)
+	if err != nil {
+		return sdkerrors.Wrap(err, "member")
+	}
+	return nil
+}
+
+func (m *MsgAssignSeatRequest) GetGroupID() ID {
+	return m.GroupId
+}
+
+var _ sdk.MsgRequest = &MsgVacateSeatRequest{}
+
+// GetSigners returns the expected signers for a MsgVacateSeatRequest.
+func (m MsgVacateSeatRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgVacateSeatRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	_, err = sdk.AccAddressFromBech32(m.Member)
+	if err != nil {
+		return sdkerrors.Wrap(err, "member")
+	}
+	return nil
+}
+
+func (m *MsgVacateSeatRequest) GetGroupID() ID {
+	return m.GroupId
+}
+
 var _ sdk.MsgRequest = &MsgCreateGroupAccountRequest{}
 
 // GetSigners returns the expected signers for a MsgCreateGroupAccountRequest.
@@ -181,7 +452,7 @@ func (m MsgCreateGroupAccountRequest) ValidateBasic() error {
 	if err := policy.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "decision policy")
 	}
-	return nil
+	return validateSpendLimit(m.SpendLimit, m.SpendPeriod)
 }
 
 var _ sdk.MsgRequest = &MsgUpdateGroupAccountAdminRequest{}
@@ -262,6 +533,19 @@ func (m *MsgUpdateGroupAccountDecisionPolicyRequest) GetDecisionPolicy() Decisio
 	return decisionPolicy
 }
 
+func (m *MsgUpdateGroupAccountDecisionPolicyRequest) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return fmt.Errorf("can't proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgUpdateGroupAccountDecisionPolicyRequest) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var decisionPolicy DecisionPolicy
@@ -350,6 +634,33 @@ func (m MsgCreateGroupAccountRequest) UnpackInterfaces(unpacker types.AnyUnpacke
 	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
 }
 
+var _ sdk.MsgRequest = &MsgReassignGroupAccountRequest{}
+
+// GetSigners returns the expected signers for a MsgReassignGroupAccountRequest.
+func (m MsgReassignGroupAccountRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgReassignGroupAccountRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	_, err = sdk.AccAddressFromBech32(m.GroupAccount)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+	if m.NewGroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "new group")
+	}
+	return nil
+}
+
 var _ sdk.MsgRequest = &MsgCreateProposalRequest{}
 
 // GetSigners returns the expected signers for a MsgCreateProposalRequest.
@@ -367,7 +678,7 @@ func (m MsgCreateProposalRequest) GetSigners() []sdk.AccAddress {
 
 // ValidateBasic does a sanity check on the provided data
 func (m MsgCreateProposalRequest) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(m.GroupAccount)
+	groupAccount, err := sdk.AccAddressFromBech32(m.GroupAccount)
 	if err != nil {
 		return sdkerrors.Wrap(err, "group account")
 	}
@@ -386,6 +697,37 @@ func (m MsgCreateProposalRequest) ValidateBasic() error {
 	if err := AccAddresses(addrs).ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "proposers")
 	}
+	if err := validateMetadataURI(m.MetadataUri, m.MetadataHash); err != nil {
+		return err
+	}
+
+	if m.OptionSet != nil {
+		if err := m.OptionSet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "option set")
+		}
+		if len(m.Msgs) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "msgs are not supported with an option set")
+		}
+		if m.FastTrack {
+			return sdkerrors.Wrap(ErrInvalid, "fast track is not supported with an option set")
+		}
+	}
+	if m.DecisionPolicy != nil {
+		policy := m.GetDecisionPolicy()
+		if policy == nil {
+			return sdkerrors.Wrap(ErrInvalid, "decision policy")
+		}
+		if err := policy.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "decision policy")
+		}
+		if m.FastTrack {
+			return sdkerrors.Wrap(ErrInvalid, "fast track is not supported with a decision policy")
+		}
+	}
+
+	if err := assertProposalMsgsLimits(m.Msgs); err != nil {
+		return err
+	}
 
 	for i, any := range m.Msgs {
 		msg, ok := any.GetCachedValue().(sdk.Msg)
@@ -395,6 +737,9 @@ func (m MsgCreateProposalRequest) ValidateBasic() error {
 		if err := msg.ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "msg %d", i)
 		}
+		if err := assertMsgAuthorized(msg, groupAccount); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
 	}
 	return nil
 }
@@ -427,6 +772,34 @@ func (m MsgCreateProposalRequest) GetMsgs() []sdk.Msg {
 	return msgs
 }
 
+// GetDecisionPolicy returns the decision policy overriding the decision policy of
+// the group account, or nil if none is set.
+func (m MsgCreateProposalRequest) GetDecisionPolicy() DecisionPolicy {
+	if m.DecisionPolicy == nil {
+		return nil
+	}
+	decisionPolicy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// SetDecisionPolicy sets the decision policy overriding the decision policy of the
+// group account.
+func (m *MsgCreateProposalRequest) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return fmt.Errorf("can't proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgCreateProposalRequest) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	for _, m := range m.Msgs {
@@ -436,6 +809,127 @@ func (m MsgCreateProposalRequest) UnpackInterfaces(unpacker types.AnyUnpacker) e
 		}
 	}
 
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
+}
+
+var _ sdk.MsgRequest = &MsgCreateMembershipProposalRequest{}
+
+// GetSigners returns the expected signers for a MsgCreateMembershipProposalRequest.
+func (m MsgCreateMembershipProposalRequest) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(m.Proposers))
+	for i, proposer := range m.Proposers {
+		addr, err := sdk.AccAddressFromBech32(proposer)
+		if err != nil {
+			panic(err)
+		}
+		addrs[i] = addr
+	}
+	return addrs
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgCreateMembershipProposalRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.GroupAccount)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+
+	if len(m.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+	addrs := make([]sdk.AccAddress, len(m.Proposers))
+	for i, proposer := range m.Proposers {
+		addr, err := sdk.AccAddressFromBech32(proposer)
+		if err != nil {
+			return sdkerrors.Wrap(err, "proposers")
+		}
+		addrs[i] = addr
+	}
+	if err := AccAddresses(addrs).ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "proposers")
+	}
+
+	if len(m.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+	if err := Members(m.MemberUpdates).ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "members")
+	}
+	return nil
+}
+
+var _ sdk.MsgRequest = &MsgAmendProposalRequest{}
+var _ types.UnpackInterfacesMessage = MsgAmendProposalRequest{}
+
+// GetSigners returns the expected signers for a MsgAmendProposalRequest.
+func (m MsgAmendProposalRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Proposer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgAmendProposalRequest) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Proposer)
+	if err != nil {
+		return sdkerrors.Wrap(err, "proposer")
+	}
+	if err := assertProposalMsgsLimits(m.Msgs); err != nil {
+		return err
+	}
+	for i, any := range m.Msgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnpackAny, "cannot unpack Any into sdk.Msg %T", any)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+	return nil
+}
+
+// SetMsgs packs msgs into Any's
+func (m *MsgAmendProposalRequest) SetMsgs(msgs []sdk.Msg) error {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anys[i], err = types.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+	}
+	m.Msgs = anys
+	return nil
+}
+
+// GetMsgs unpacks m.Msgs Any's into sdk.Msg's
+func (m MsgAmendProposalRequest) GetMsgs() []sdk.Msg {
+	msgs := make([]sdk.Msg, len(m.Msgs))
+	for i, any := range m.Msgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil
+		}
+		msgs[i] = msg
+	}
+	return msgs
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgAmendProposalRequest) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, m := range m.Msgs {
+		err := types.UnpackInterfaces(m, unpacker)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -465,6 +959,106 @@ func (m MsgVoteRequest) ValidateBasic() error {
 	if _, ok := Choice_name[int32(m.Choice)]; !ok {
 		return sdkerrors.Wrap(ErrInvalid, "choice")
 	}
+	if m.Option != 0 && m.Choice != Choice_CHOICE_OPTION {
+		return sdkerrors.Wrap(ErrInvalid, "option requires option choice")
+	}
+	if len(m.Nonce) > MaxVoteNonceLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "nonce")
+	}
+	return nil
+}
+
+var _ sdk.MsgRequest = &MsgChangeVoteRequest{}
+
+// GetSigners returns the expected signers for a MsgChangeVoteRequest.
+func (m MsgChangeVoteRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgChangeVoteRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Voter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	if m.Choice == Choice_CHOICE_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "choice")
+	}
+	if _, ok := Choice_name[int32(m.Choice)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "choice")
+	}
+	if m.Option != 0 && m.Choice != Choice_CHOICE_OPTION {
+		return sdkerrors.Wrap(ErrInvalid, "option requires option choice")
+	}
+	return nil
+}
+
+var _ sdk.MsgRequest = &MsgCommitVoteRequest{}
+
+// GetSigners returns the expected signers for a MsgCommitVoteRequest.
+func (m MsgCommitVoteRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgCommitVoteRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Voter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	if len(m.CommitHash) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "commit hash")
+	}
+	if len(m.CommitHash) != sha256.Size {
+		return sdkerrors.Wrap(ErrInvalid, "commit hash")
+	}
+	return nil
+}
+
+var _ sdk.MsgRequest = &MsgRevealVoteRequest{}
+
+// GetSigners returns the expected signers for a MsgRevealVoteRequest.
+func (m MsgRevealVoteRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgRevealVoteRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Voter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	if m.Choice == Choice_CHOICE_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "choice")
+	}
+	if _, ok := Choice_name[int32(m.Choice)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "choice")
+	}
+	// The commitment doesn't cover an option index.
+	if m.Choice == Choice_CHOICE_OPTION {
+		return sdkerrors.Wrap(ErrInvalid, "option choice not supported for committed votes")
+	}
 	return nil
 }
 
@@ -490,3 +1084,78 @@ func (m MsgExecRequest) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.MsgRequest = &MsgAdminOverrideRequest{}
+
+// GetSigners returns the expected signers for a MsgAdminOverrideRequest.
+func (m MsgAdminOverrideRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgAdminOverrideRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	if m.Action == OverrideAction_OVERRIDE_ACTION_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "action")
+	}
+	if _, ok := OverrideAction_name[int32(m.Action)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "action")
+	}
+	return nil
+}
+
+var _ sdk.MsgRequest = &MsgPauseProposalRequest{}
+
+// GetSigners returns the expected signers for a MsgPauseProposalRequest.
+func (m MsgPauseProposalRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgPauseProposalRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	return nil
+}
+
+var _ sdk.MsgRequest = &MsgResumeProposalRequest{}
+
+// GetSigners returns the expected signers for a MsgResumeProposalRequest.
+func (m MsgResumeProposalRequest) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgResumeProposalRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	return nil
+}
@@ -1,8 +1,10 @@
 package group
 
 import (
+	"crypto/sha256"
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/types"
@@ -38,6 +40,171 @@ func TestMsgCreateGroupValidation(t *testing.T) {
 				},
 			},
 		},
+		"all good with member roles": {
+			src: MsgCreateGroupRequest{
+				Admin: myAddr.String(),
+				Members: []Member{
+					{Address: myAddr.String(), Weight: "1", Role: "core"},
+					{Address: myOtherAddr.String(), Weight: "2"},
+				},
+				RoleMultipliers: []RoleMultiplier{{Role: "core", Multiplier: "2"}},
+			},
+		},
+		"all good with weight decay": {
+			src: MsgCreateGroupRequest{
+				Admin:       myAddr.String(),
+				WeightDecay: &WeightDecay{Rate: "0.5", Period: proto.Duration{Seconds: 3600}},
+			},
+		},
+		"zero weight decay rate not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:       myAddr.String(),
+				WeightDecay: &WeightDecay{Rate: "0", Period: proto.Duration{Seconds: 3600}},
+			},
+			expErr: true,
+		},
+		"zero weight decay period not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:       myAddr.String(),
+				WeightDecay: &WeightDecay{Rate: "1"},
+			},
+			expErr: true,
+		},
+		"all good with metadata uri and hash": {
+			src: MsgCreateGroupRequest{
+				Admin:        myAddr.String(),
+				MetadataUri:  "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+				MetadataHash: make([]byte, sha256.Size),
+			},
+		},
+		"malformed metadata uri not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:        myAddr.String(),
+				MetadataUri:  "not a uri",
+				MetadataHash: make([]byte, sha256.Size),
+			},
+			expErr: true,
+		},
+		"metadata hash with wrong length not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:        myAddr.String(),
+				MetadataUri:  "https://example.com/group.json",
+				MetadataHash: make([]byte, 20),
+			},
+			expErr: true,
+		},
+		"metadata uri without hash not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:       myAddr.String(),
+				MetadataUri: "https://example.com/group.json",
+			},
+			expErr: true,
+		},
+		"all good with council seats": {
+			src: MsgCreateGroupRequest{
+				Admin: myAddr.String(),
+				Members: []Member{
+					{Address: myAddr.String(), Weight: "1"},
+					{Address: myOtherAddr.String(), Weight: "1.0"},
+				},
+				Seats: 2,
+			},
+		},
+		"more members than council seats not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin: myAddr.String(),
+				Members: []Member{
+					{Address: myAddr.String(), Weight: "1"},
+					{Address: myOtherAddr.String(), Weight: "1"},
+				},
+				Seats: 1,
+			},
+			expErr: true,
+		},
+		"weighted council seat not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:   myAddr.String(),
+				Members: []Member{{Address: myAddr.String(), Weight: "2"}},
+				Seats:   2,
+			},
+			expErr: true,
+		},
+		"council with role multipliers not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:           myAddr.String(),
+				Members:         []Member{{Address: myAddr.String(), Weight: "1"}},
+				RoleMultipliers: []RoleMultiplier{{Role: "core", Multiplier: "2"}},
+				Seats:           2,
+			},
+			expErr: true,
+		},
+		"all good with normalized weights": {
+			src: MsgCreateGroupRequest{
+				Admin:             myAddr.String(),
+				Members:           []Member{{Address: myAddr.String(), Weight: "1"}},
+				NormalizedWeights: true,
+				NormalizedTotal:   "100",
+			},
+		},
+		"normalized weights without total not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:             myAddr.String(),
+				Members:           []Member{{Address: myAddr.String(), Weight: "1"}},
+				NormalizedWeights: true,
+			},
+			expErr: true,
+		},
+		"normalized total without normalized weights not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:           myAddr.String(),
+				Members:         []Member{{Address: myAddr.String(), Weight: "1"}},
+				NormalizedTotal: "100",
+			},
+			expErr: true,
+		},
+		"normalized weights with council seats not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:             myAddr.String(),
+				Members:           []Member{{Address: myAddr.String(), Weight: "1"}},
+				Seats:             2,
+				NormalizedWeights: true,
+				NormalizedTotal:   "1",
+			},
+			expErr: true,
+		},
+		"metadata hash without uri not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:        myAddr.String(),
+				MetadataHash: make([]byte, sha256.Size),
+			},
+			expErr: true,
+		},
+		"unknown member role not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin: myAddr.String(),
+				Members: []Member{
+					{Address: myAddr.String(), Weight: "1", Role: "core"},
+				},
+			},
+			expErr: true,
+		},
+		"duplicate roles not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin: myAddr.String(),
+				RoleMultipliers: []RoleMultiplier{
+					{Role: "core", Multiplier: "2"},
+					{Role: "core", Multiplier: "3"},
+				},
+			},
+			expErr: true,
+		},
+		"zero role multiplier not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:           myAddr.String(),
+				RoleMultipliers: []RoleMultiplier{{Role: "core", Multiplier: "0"}},
+			},
+			expErr: true,
+		},
 		"admin required": {
 			src:    MsgCreateGroupRequest{},
 			expErr: true,
@@ -81,6 +248,19 @@ func TestMsgCreateGroupValidation(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"valid member expiry": {
+			src: MsgCreateGroupRequest{
+				Admin:   myAddr.String(),
+				Members: []Member{{Address: myAddr.String(), Weight: "1", ExpiresAt: &proto.Timestamp{Seconds: 1000}}},
+			},
+		},
+		"invalid member expiry not allowed": {
+			src: MsgCreateGroupRequest{
+				Admin:   myAddr.String(),
+				Members: []Member{{Address: myAddr.String(), Weight: "1", ExpiresAt: &proto.Timestamp{Nanos: -1}}},
+			},
+			expErr: true,
+		},
 		"member address required": {
 			src: MsgCreateGroupRequest{
 				Admin: myAddr.String(),
@@ -121,11 +301,13 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 	_, _, myAddr := testdata.KeyTestPubAddr()
 
 	specs := map[string]struct {
-		admin     sdk.AccAddress
-		group     ID
-		threshold string
-		timeout   proto.Duration
-		expErr    bool
+		admin       sdk.AccAddress
+		group       ID
+		threshold   string
+		timeout     proto.Duration
+		spendLimit  sdk.Coins
+		spendPeriod *proto.Duration
+		expErr      bool
 	}{
 		"all good with minimum fields set": {
 			admin:     myAddr,
@@ -190,6 +372,40 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 			timeout:   proto.Duration{Seconds: 1},
 			expErr:    true,
 		},
+		"all good with spend limit": {
+			admin:       myAddr,
+			group:       1,
+			threshold:   "1",
+			timeout:     proto.Duration{Seconds: 1},
+			spendLimit:  sdk.Coins{sdk.NewInt64Coin("test", 100)},
+			spendPeriod: &proto.Duration{Seconds: 10},
+		},
+		"spend limit without spend period": {
+			admin:      myAddr,
+			group:      1,
+			threshold:  "1",
+			timeout:    proto.Duration{Seconds: 1},
+			spendLimit: sdk.Coins{sdk.NewInt64Coin("test", 100)},
+			expErr:     true,
+		},
+		"spend limit with negative spend period": {
+			admin:       myAddr,
+			group:       1,
+			threshold:   "1",
+			timeout:     proto.Duration{Seconds: 1},
+			spendLimit:  sdk.Coins{sdk.NewInt64Coin("test", 100)},
+			spendPeriod: &proto.Duration{Seconds: -1},
+			expErr:      true,
+		},
+		"invalid spend limit": {
+			admin:       myAddr,
+			group:       1,
+			threshold:   "1",
+			timeout:     proto.Duration{Seconds: 1},
+			spendLimit:  sdk.Coins{sdk.Coin{Denom: "test", Amount: sdk.NewInt(-1)}},
+			spendPeriod: &proto.Duration{Seconds: 10},
+			expErr:      true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -203,6 +419,8 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 				},
 			)
 			require.NoError(t, err)
+			m.SpendLimit = spec.spendLimit
+			m.SpendPeriod = spec.spendPeriod
 
 			if spec.expErr {
 				require.Error(t, m.ValidateBasic())
@@ -220,6 +438,12 @@ func TestMsgCreateProposalRequest(t *testing.T) {
 	_, _, addr = testdata.KeyTestPubAddr()
 	memberAddr := addr.String()
 
+	policyAny := func(policy DecisionPolicy) *codectypes.Any {
+		any, err := codectypes.NewAnyWithValue(policy)
+		require.NoError(t, err)
+		return any
+	}
+
 	specs := map[string]struct {
 		src    MsgCreateProposalRequest
 		expErr bool
@@ -263,6 +487,139 @@ func TestMsgCreateProposalRequest(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with metadata uri and hash": {
+			src: MsgCreateProposalRequest{
+				GroupAccount: groupAddr,
+				Proposers:    []string{memberAddr},
+				MetadataUri:  "https://example.com/proposal.json",
+				MetadataHash: make([]byte, sha256.Size),
+			},
+		},
+		"malformed metadata uri not allowed": {
+			src: MsgCreateProposalRequest{
+				GroupAccount: groupAddr,
+				Proposers:    []string{memberAddr},
+				MetadataUri:  "https://exa mple.com/%zz",
+				MetadataHash: make([]byte, sha256.Size),
+			},
+			expErr: true,
+		},
+		"metadata hash with wrong length not allowed": {
+			src: MsgCreateProposalRequest{
+				GroupAccount: groupAddr,
+				Proposers:    []string{memberAddr},
+				MetadataUri:  "https://example.com/proposal.json",
+				MetadataHash: make([]byte, sha256.Size+1),
+			},
+			expErr: true,
+		},
+		"all good with fast track": {
+			src: MsgCreateProposalRequest{
+				GroupAccount: groupAddr,
+				Proposers:    []string{memberAddr},
+				FastTrack:    true,
+			},
+		},
+		"fast track with option set not allowed": {
+			src: MsgCreateProposalRequest{
+				GroupAccount: groupAddr,
+				Proposers:    []string{memberAddr},
+				OptionSet:    &OptionSet{Options: []string{"A", "B"}},
+				FastTrack:    true,
+			},
+			expErr: true,
+		},
+		"with decision policy": {
+			src: MsgCreateProposalRequest{
+				GroupAccount:   groupAddr,
+				Proposers:      []string{memberAddr},
+				DecisionPolicy: policyAny(NewThresholdDecisionPolicy("3", proto.Duration{Seconds: 1})),
+			},
+		},
+		"invalid decision policy": {
+			src: MsgCreateProposalRequest{
+				GroupAccount:   groupAddr,
+				Proposers:      []string{memberAddr},
+				DecisionPolicy: policyAny(NewThresholdDecisionPolicy("0", proto.Duration{Seconds: 1})),
+			},
+			expErr: true,
+		},
+		"fast track with decision policy not allowed": {
+			src: MsgCreateProposalRequest{
+				GroupAccount:   groupAddr,
+				Proposers:      []string{memberAddr},
+				DecisionPolicy: policyAny(NewThresholdDecisionPolicy("3", proto.Duration{Seconds: 1})),
+				FastTrack:      true,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCreateMembershipProposalRequest(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	groupAddr := addr.String()
+
+	_, _, addr = testdata.KeyTestPubAddr()
+	memberAddr := addr.String()
+
+	specs := map[string]struct {
+		src    MsgCreateMembershipProposalRequest
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCreateMembershipProposalRequest{
+				GroupAccount:  groupAddr,
+				Proposers:     []string{memberAddr},
+				MemberUpdates: []Member{{Address: memberAddr, Weight: "5"}},
+			},
+		},
+		"member removal": {
+			src: MsgCreateMembershipProposalRequest{
+				GroupAccount:  groupAddr,
+				Proposers:     []string{memberAddr},
+				MemberUpdates: []Member{{Address: memberAddr, Weight: "0"}},
+			},
+		},
+		"group account required": {
+			src: MsgCreateMembershipProposalRequest{
+				Proposers:     []string{memberAddr},
+				MemberUpdates: []Member{{Address: memberAddr, Weight: "5"}},
+			},
+			expErr: true,
+		},
+		"proposers required": {
+			src: MsgCreateMembershipProposalRequest{
+				GroupAccount:  groupAddr,
+				MemberUpdates: []Member{{Address: memberAddr, Weight: "5"}},
+			},
+			expErr: true,
+		},
+		"member updates required": {
+			src: MsgCreateMembershipProposalRequest{
+				GroupAccount: groupAddr,
+				Proposers:    []string{memberAddr},
+			},
+			expErr: true,
+		},
+		"duplicate member updates not allowed": {
+			src: MsgCreateMembershipProposalRequest{
+				GroupAccount:  groupAddr,
+				Proposers:     []string{memberAddr},
+				MemberUpdates: []Member{{Address: memberAddr, Weight: "5"}, {Address: memberAddr, Weight: "1"}},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -308,7 +665,16 @@ func TestMsgVote(t *testing.T) {
 		"valid choice required": {
 			src: MsgVoteRequest{
 				ProposalId: 1,
-				Choice:     5,
+				Choice:     7,
+				Voter:      memberAddr,
+			},
+			expErr: true,
+		},
+		"option requires option choice": {
+			src: MsgVoteRequest{
+				ProposalId: 1,
+				Choice:     Choice_CHOICE_YES,
+				Option:     1,
 				Voter:      memberAddr,
 			},
 			expErr: true,
@@ -336,6 +702,203 @@ func TestMsgVote(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"with nonce": {
+			src: MsgVoteRequest{
+				ProposalId: 1,
+				Choice:     Choice_CHOICE_YES,
+				Voter:      memberAddr,
+				Nonce:      []byte("nonce"),
+			},
+		},
+		"nonce too long": {
+			src: MsgVoteRequest{
+				ProposalId: 1,
+				Choice:     Choice_CHOICE_YES,
+				Voter:      memberAddr,
+				Nonce:      make([]byte, MaxVoteNonceLength+1),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCommitVote(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	memberAddr := addr.String()
+	hash := VoteCommitmentHash(1, memberAddr, Choice_CHOICE_YES, []byte("salt"))
+
+	specs := map[string]struct {
+		src    MsgCommitVoteRequest
+		expErr bool
+	}{
+		"all good with minimum fields set": {
+			src: MsgCommitVoteRequest{
+				ProposalId: 1,
+				Voter:      memberAddr,
+				CommitHash: hash,
+			},
+		},
+		"proposal required": {
+			src: MsgCommitVoteRequest{
+				Voter:      memberAddr,
+				CommitHash: hash,
+			},
+			expErr: true,
+		},
+		"commit hash required": {
+			src: MsgCommitVoteRequest{
+				ProposalId: 1,
+				Voter:      memberAddr,
+			},
+			expErr: true,
+		},
+		"valid commit hash length required": {
+			src: MsgCommitVoteRequest{
+				ProposalId: 1,
+				Voter:      memberAddr,
+				CommitHash: []byte("short"),
+			},
+			expErr: true,
+		},
+		"valid voter address required": {
+			src: MsgCommitVoteRequest{
+				ProposalId: 1,
+				Voter:      "invalid-member-address",
+				CommitHash: hash,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRevealVote(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	memberAddr := addr.String()
+
+	specs := map[string]struct {
+		src    MsgRevealVoteRequest
+		expErr bool
+	}{
+		"all good with minimum fields set": {
+			src: MsgRevealVoteRequest{
+				ProposalId: 1,
+				Voter:      memberAddr,
+				Choice:     Choice_CHOICE_YES,
+			},
+		},
+		"proposal required": {
+			src: MsgRevealVoteRequest{
+				Voter:  memberAddr,
+				Choice: Choice_CHOICE_YES,
+			},
+			expErr: true,
+		},
+		"choice required": {
+			src: MsgRevealVoteRequest{
+				ProposalId: 1,
+				Voter:      memberAddr,
+			},
+			expErr: true,
+		},
+		"valid choice required": {
+			src: MsgRevealVoteRequest{
+				ProposalId: 1,
+				Voter:      memberAddr,
+				Choice:     7,
+			},
+			expErr: true,
+		},
+		"voter required": {
+			src: MsgRevealVoteRequest{
+				ProposalId: 1,
+				Choice:     Choice_CHOICE_YES,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAdminOverride(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	adminAddr := addr.String()
+
+	specs := map[string]struct {
+		src    MsgAdminOverrideRequest
+		expErr bool
+	}{
+		"all good with execute": {
+			src: MsgAdminOverrideRequest{
+				Admin:      adminAddr,
+				ProposalId: 1,
+				Action:     OverrideAction_OVERRIDE_ACTION_EXECUTE,
+			},
+		},
+		"all good with cancel": {
+			src: MsgAdminOverrideRequest{
+				Admin:      adminAddr,
+				ProposalId: 1,
+				Action:     OverrideAction_OVERRIDE_ACTION_CANCEL,
+			},
+		},
+		"admin required": {
+			src: MsgAdminOverrideRequest{
+				ProposalId: 1,
+				Action:     OverrideAction_OVERRIDE_ACTION_EXECUTE,
+			},
+			expErr: true,
+		},
+		"proposal required": {
+			src: MsgAdminOverrideRequest{
+				Admin:  adminAddr,
+				Action: OverrideAction_OVERRIDE_ACTION_EXECUTE,
+			},
+			expErr: true,
+		},
+		"action required": {
+			src: MsgAdminOverrideRequest{
+				Admin:      adminAddr,
+				ProposalId: 1,
+			},
+			expErr: true,
+		},
+		"valid action required": {
+			src: MsgAdminOverrideRequest{
+				Admin:      adminAddr,
+				ProposalId: 1,
+				Action:     3,
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -348,3 +911,103 @@ func TestMsgVote(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgInviteMember(t *testing.T) {
+	_, _, admin := testdata.KeyTestPubAddr()
+	_, _, member := testdata.KeyTestPubAddr()
+
+	specs := map[string]struct {
+		src    MsgInviteMemberRequest
+		expErr bool
+	}{
+		"all good": {
+			src: MsgInviteMemberRequest{
+				Admin:   admin.String(),
+				GroupId: 1,
+				Member:  Member{Address: member.String(), Weight: "1"},
+			},
+		},
+		"group required": {
+			src: MsgInviteMemberRequest{
+				Admin:  admin.String(),
+				Member: Member{Address: member.String(), Weight: "1"},
+			},
+			expErr: true,
+		},
+		"admin required": {
+			src: MsgInviteMemberRequest{
+				GroupId: 1,
+				Member:  Member{Address: member.String(), Weight: "1"},
+			},
+			expErr: true,
+		},
+		"member address required": {
+			src: MsgInviteMemberRequest{
+				Admin:   admin.String(),
+				GroupId: 1,
+				Member:  Member{Weight: "1"},
+			},
+			expErr: true,
+		},
+		"zero weight not allowed": {
+			src: MsgInviteMemberRequest{
+				Admin:   admin.String(),
+				GroupId: 1,
+				Member:  Member{Address: member.String(), Weight: "0"},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgPauseAndResumeProposal(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	adminAddr := addr.String()
+
+	specs := map[string]struct {
+		admin      string
+		proposalID ProposalID
+		expErr     bool
+	}{
+		"all good": {
+			admin:      adminAddr,
+			proposalID: 1,
+		},
+		"admin required": {
+			proposalID: 1,
+			expErr:     true,
+		},
+		"valid admin required": {
+			admin:      "invalid-address",
+			proposalID: 1,
+			expErr:     true,
+		},
+		"proposal required": {
+			admin:  adminAddr,
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			pauseErr := MsgPauseProposalRequest{Admin: spec.admin, ProposalId: spec.proposalID}.ValidateBasic()
+			resumeErr := MsgResumeProposalRequest{Admin: spec.admin, ProposalId: spec.proposalID}.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, pauseErr)
+				require.Error(t, resumeErr)
+			} else {
+				require.NoError(t, pauseErr)
+				require.NoError(t, resumeErr)
+			}
+		})
+	}
+}
@@ -1,11 +1,23 @@
 package group
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
 )
 
+// MaxProposalMessages defines the max number of messages a proposal can contain.
+// TODO: This could be used as params once x/params is upgraded to use protobuf
+const MaxProposalMessages = 16
+
+// MaxProposalSize defines the max combined size in bytes of the serialized
+// messages of a proposal.
+// TODO: This could be used as params once x/params is upgraded to use protobuf
+const MaxProposalSize = 64 * 1024
+
 func (p *Proposal) GetMsgs() []sdk.Msg {
 	msgs := make([]sdk.Msg, len(p.Msgs))
 	for i, any := range p.Msgs {
@@ -33,8 +45,47 @@ func (p *Proposal) SetMsgs(new []sdk.Msg) error {
 	return nil
 }
 
+// VotingStart returns the time from which on votes are accepted. This is the
+// submission time unless a voting start time was set.
+func (p Proposal) VotingStart() (time.Time, error) {
+	if p.VotingStartTime != nil {
+		return gogotypes.TimestampFromProto(p.VotingStartTime)
+	}
+	return gogotypes.TimestampFromProto(&p.SubmittedAt)
+}
+
+// Paused returns true while the proposal is paused by the group account admin.
+func (p Proposal) Paused() bool {
+	return p.PausedAt != nil
+}
+
+// VotingDuration returns the time that passed between the voting start and now,
+// excluding the time the proposal was paused for. This is the voting duration
+// decision policies are evaluated with.
+func (p Proposal) VotingDuration(now time.Time) (time.Duration, error) {
+	votingStart, err := p.VotingStart()
+	if err != nil {
+		return 0, err
+	}
+	pausedDuration, err := gogotypes.DurationFromProto(&p.PausedDuration)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "paused duration")
+	}
+	d := now.Sub(votingStart) - pausedDuration
+	if p.PausedAt != nil {
+		pausedAt, err := gogotypes.TimestampFromProto(p.PausedAt)
+		if err != nil {
+			return 0, sdkerrors.Wrap(err, "paused at")
+		}
+		if now.After(pausedAt) {
+			d -= now.Sub(pausedAt)
+		}
+	}
+	return d, nil
+}
+
 func (p Proposal) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(p.GroupAccount)
+	groupAccount, err := sdk.AccAddressFromBech32(p.GroupAccount)
 	if err != nil {
 		return sdkerrors.Wrap(err, "group account")
 	}
@@ -53,6 +104,14 @@ func (p Proposal) ValidateBasic() error {
 	if err := AccAddresses(addrs).ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "proposers")
 	}
+	if err := validateMetadataURI(p.MetadataUri, p.MetadataHash); err != nil {
+		return err
+	}
+	for _, voter := range p.PrunedVoters {
+		if _, err := sdk.AccAddressFromBech32(voter); err != nil {
+			return sdkerrors.Wrap(err, "pruned voters")
+		}
+	}
 
 	if p.SubmittedAt.Seconds == 0 && p.SubmittedAt.Nanos == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "submitted at")
@@ -87,15 +146,111 @@ func (p Proposal) ValidateBasic() error {
 	if p.Timeout.Seconds == 0 && p.Timeout.Nanos == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "timeout")
 	}
+	if p.OptionSet != nil {
+		if err := p.OptionSet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "option set")
+		}
+		if len(p.VoteState.OptionCounts) != len(p.OptionSet.Options) {
+			return sdkerrors.Wrap(ErrInvalid, "vote state option counts don't match option set")
+		}
+	}
+	pausedDuration, err := gogotypes.DurationFromProto(&p.PausedDuration)
+	if err != nil {
+		return sdkerrors.Wrap(err, "paused duration")
+	}
+	if pausedDuration < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "paused duration must not be negative")
+	}
+	if len(p.MemberUpdates) != 0 {
+		if err := Members(p.MemberUpdates).ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "member updates")
+		}
+		if len(p.Msgs) != 0 || p.OptionSet != nil {
+			return sdkerrors.Wrap(ErrInvalid, "membership proposals can't have msgs or an option set")
+		}
+	}
+	if p.DecisionPolicy != nil {
+		policy := p.GetDecisionPolicy()
+		if policy == nil {
+			return sdkerrors.Wrap(ErrInvalid, "decision policy")
+		}
+		if err := policy.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "decision policy")
+		}
+	}
+	if err := assertProposalMsgsLimits(p.Msgs); err != nil {
+		return err
+	}
 	msgs := p.GetMsgs()
 	for i, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "message %d", i)
 		}
+		if err := assertMsgAuthorized(msg, groupAccount); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+	}
+	return nil
+}
+
+// assertMsgAuthorized checks that all signers a message requires are the group
+// account, so that a proposal can't act on behalf of accounts the group doesn't
+// control.
+func assertMsgAuthorized(msg sdk.Msg, groupAccount sdk.AccAddress) error {
+	for _, signer := range msg.GetSigners() {
+		if !groupAccount.Equals(signer) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the group account", signer)
+		}
+	}
+	return nil
+}
+
+// CachedResult returns the decision policy result persisted on the proposal
+// when it was first finalized. The boolean is false when the proposal hasn't
+// been finalized yet and its result must be computed from the tally.
+func (p Proposal) CachedResult() (DecisionPolicyResult, bool) {
+	if p.Status != ProposalStatusClosed {
+		return DecisionPolicyResult{}, false
+	}
+	return DecisionPolicyResult{Allow: p.Result == ProposalResultAccepted, Final: true, Reason: p.ResultReason}, true
+}
+
+// GetProposalResult returns the result of the proposal together with the
+// human-readable reason for it. The reason is empty while the proposal is
+// not finalized.
+func (p Proposal) GetProposalResult() (Proposal_Result, string) {
+	return p.Result, p.ResultReason
+}
+
+// assertProposalMsgsLimits returns an error if the given proposal messages
+// exceed MaxProposalMessages or MaxProposalSize.
+func assertProposalMsgsLimits(msgs []*codectypes.Any) error {
+	if len(msgs) > MaxProposalMessages {
+		return sdkerrors.Wrapf(ErrMaxLimit, "msgs: %d > %d", len(msgs), MaxProposalMessages)
+	}
+	size := 0
+	for _, any := range msgs {
+		size += any.Size()
+	}
+	if size > MaxProposalSize {
+		return sdkerrors.Wrapf(ErrMaxLimit, "msgs size: %d > %d bytes", size, MaxProposalSize)
 	}
 	return nil
 }
 
+// GetDecisionPolicy returns the decision policy overriding the decision policy of
+// the group account for this proposal, or nil if none is set.
+func (p Proposal) GetDecisionPolicy() DecisionPolicy {
+	if p.DecisionPolicy == nil {
+		return nil
+	}
+	decisionPolicy, ok := p.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, any := range p.Msgs {
@@ -106,5 +261,6 @@ func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 		}
 	}
 
-	return nil
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(p.DecisionPolicy, &decisionPolicy)
 }
@@ -0,0 +1,177 @@
+package group
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalValidateBasicMsgsLimits(t *testing.T) {
+	_, _, groupAddr := testdata.KeyTestPubAddr()
+	_, _, memberAddr := testdata.KeyTestPubAddr()
+
+	msgs := func(n int, signer string) []sdk.Msg {
+		r := make([]sdk.Msg, n)
+		for i := range r {
+			r[i] = &testdata.TestMsg{Signers: []string{signer}}
+		}
+		return r
+	}
+
+	specs := map[string]struct {
+		msgs   []sdk.Msg
+		expErr bool
+	}{
+		"single message": {
+			msgs: msgs(1, groupAddr.String()),
+		},
+		"max messages": {
+			msgs: msgs(MaxProposalMessages, groupAddr.String()),
+		},
+		"too many messages": {
+			msgs:   msgs(MaxProposalMessages+1, groupAddr.String()),
+			expErr: true,
+		},
+		"messages exceed max size": {
+			msgs:   msgs(2, strings.Repeat("a", MaxProposalSize/2)),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := Proposal{
+				GroupAccount:        groupAddr.String(),
+				Proposers:           []string{memberAddr.String()},
+				SubmittedAt:         gogotypes.Timestamp{Seconds: 1},
+				GroupVersion:        1,
+				GroupAccountVersion: 1,
+				Status:              ProposalStatusSubmitted,
+				Result:              ProposalResultUnfinalized,
+				VoteState:           Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+				Timeout:             gogotypes.Timestamp{Seconds: 2},
+				ExecutorResult:      ProposalExecutorResultNotRun,
+			}
+			require.NoError(t, p.SetMsgs(spec.msgs))
+
+			err := p.ValidateBasic()
+			if !spec.expErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, ErrMaxLimit.Is(err))
+
+			req := MsgCreateProposalRequest{
+				GroupAccount: groupAddr.String(),
+				Proposers:    []string{memberAddr.String()},
+			}
+			require.NoError(t, req.SetMsgs(spec.msgs))
+			assert.True(t, ErrMaxLimit.Is(req.ValidateBasic()))
+		})
+	}
+}
+
+func TestProposalValidateBasicMsgsAuthorization(t *testing.T) {
+	_, _, groupAddr := testdata.KeyTestPubAddr()
+	_, _, memberAddr := testdata.KeyTestPubAddr()
+	_, _, otherAddr := testdata.KeyTestPubAddr()
+
+	specs := map[string]struct {
+		msg    sdk.Msg
+		expErr bool
+	}{
+		"signed by group account": {
+			msg: &testdata.TestMsg{Signers: []string{groupAddr.String()}},
+		},
+		"signed by unrelated account": {
+			msg:    &testdata.TestMsg{Signers: []string{otherAddr.String()}},
+			expErr: true,
+		},
+		"signed by group account and unrelated account": {
+			msg:    &testdata.TestMsg{Signers: []string{groupAddr.String(), otherAddr.String()}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := Proposal{
+				GroupAccount:        groupAddr.String(),
+				Proposers:           []string{memberAddr.String()},
+				SubmittedAt:         gogotypes.Timestamp{Seconds: 1},
+				GroupVersion:        1,
+				GroupAccountVersion: 1,
+				Status:              ProposalStatusSubmitted,
+				Result:              ProposalResultUnfinalized,
+				VoteState:           Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+				Timeout:             gogotypes.Timestamp{Seconds: 2},
+				ExecutorResult:      ProposalExecutorResultNotRun,
+			}
+			require.NoError(t, p.SetMsgs([]sdk.Msg{spec.msg}))
+			req := MsgCreateProposalRequest{
+				GroupAccount: groupAddr.String(),
+				Proposers:    []string{memberAddr.String()},
+			}
+			require.NoError(t, req.SetMsgs([]sdk.Msg{spec.msg}))
+
+			if !spec.expErr {
+				require.NoError(t, p.ValidateBasic())
+				require.NoError(t, req.ValidateBasic())
+				return
+			}
+			assert.True(t, sdkerrors.ErrUnauthorized.Is(p.ValidateBasic()))
+			assert.True(t, sdkerrors.ErrUnauthorized.Is(req.ValidateBasic()))
+		})
+	}
+}
+
+func TestProposalCachedResult(t *testing.T) {
+	specs := map[string]struct {
+		status    Proposal_Status
+		result    Proposal_Result
+		reason    string
+		expResult DecisionPolicyResult
+		expCached bool
+	}{
+		"submitted": {
+			status: ProposalStatusSubmitted,
+			result: ProposalResultUnfinalized,
+		},
+		"closed and accepted": {
+			status:    ProposalStatusClosed,
+			result:    ProposalResultAccepted,
+			reason:    ResultReasonThresholdReached,
+			expResult: DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+			expCached: true,
+		},
+		"closed and rejected": {
+			status:    ProposalStatusClosed,
+			result:    ProposalResultRejected,
+			reason:    ResultReasonVetoed,
+			expResult: DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonVetoed},
+			expCached: true,
+		},
+		"aborted": {
+			status: ProposalStatusAborted,
+			result: ProposalResultUnfinalized,
+			reason: ResultReasonGroupModified,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := Proposal{Status: spec.status, Result: spec.result, ResultReason: spec.reason}
+			result, cached := p.CachedResult()
+			assert.Equal(t, spec.expCached, cached)
+			assert.Equal(t, spec.expResult, result)
+
+			proposalResult, reason := p.GetProposalResult()
+			assert.Equal(t, spec.result, proposalResult)
+			assert.Equal(t, spec.reason, reason)
+		})
+	}
+}
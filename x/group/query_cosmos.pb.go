@@ -20,39 +20,100 @@ type QueryClient interface {
 	GroupInfo(ctx context.Context, in *QueryGroupInfoRequest, opts ...grpc.CallOption) (*QueryGroupInfoResponse, error)
 	// GroupAccountInfo queries group account info based on group account address.
 	GroupAccountInfo(ctx context.Context, in *QueryGroupAccountInfoRequest, opts ...grpc.CallOption) (*QueryGroupAccountInfoResponse, error)
+	// GroupAccountDecisionPolicy queries the decision policy of a group account based on group account address.
+	GroupAccountDecisionPolicy(ctx context.Context, in *QueryGroupAccountDecisionPolicyRequest, opts ...grpc.CallOption) (*QueryGroupAccountDecisionPolicyResponse, error)
 	// GroupMembers queries members of a group
 	GroupMembers(ctx context.Context, in *QueryGroupMembersRequest, opts ...grpc.CallOption) (*QueryGroupMembersResponse, error)
+	// SampleCommittee queries a committee of group members sampled without replacement,
+	// with a probability proportional to their weight, from a seed such as a past block hash.
+	// The same seed always yields the same committee for the same group members.
+	SampleCommittee(ctx context.Context, in *QuerySampleCommitteeRequest, opts ...grpc.CallOption) (*QuerySampleCommitteeResponse, error)
+	// WeightChangeImpact checks that changing the weight of a group member keeps all decision policies
+	// of the group's accounts valid. It returns an error if any policy would become invalid.
+	WeightChangeImpact(ctx context.Context, in *QueryWeightChangeImpactRequest, opts ...grpc.CallOption) (*QueryWeightChangeImpactResponse, error)
 	// GroupsByAdmin queries groups by admin address.
 	GroupsByAdmin(ctx context.Context, in *QueryGroupsByAdminRequest, opts ...grpc.CallOption) (*QueryGroupsByAdminResponse, error)
 	// GroupAccountsByGroup queries group accounts by group id.
 	GroupAccountsByGroup(ctx context.Context, in *QueryGroupAccountsByGroupRequest, opts ...grpc.CallOption) (*QueryGroupAccountsByGroupResponse, error)
 	// GroupsByAdmin queries group accounts by admin address.
 	GroupAccountsByAdmin(ctx context.Context, in *QueryGroupAccountsByAdminRequest, opts ...grpc.CallOption) (*QueryGroupAccountsByAdminResponse, error)
+	// FindDuplicateAccounts queries the group accounts of a group which share the same
+	// admin and decision policy with another account of that group.
+	FindDuplicateAccounts(ctx context.Context, in *QueryFindDuplicateAccountsRequest, opts ...grpc.CallOption) (*QueryFindDuplicateAccountsResponse, error)
+	// TotalNetworkVotingWeight queries the sum of the total weights of all groups.
+	TotalNetworkVotingWeight(ctx context.Context, in *QueryTotalNetworkVotingWeightRequest, opts ...grpc.CallOption) (*QueryTotalNetworkVotingWeightResponse, error)
+	// UnsatisfiablePolicies queries the group accounts whose decision policy fails
+	// validation against their group's current state, e.g. a threshold greater than
+	// the group's total weight, so that their proposals can never be accepted.
+	UnsatisfiablePolicies(ctx context.Context, in *QueryUnsatisfiablePoliciesRequest, opts ...grpc.CallOption) (*QueryUnsatisfiablePoliciesResponse, error)
 	// Proposal queries a proposal based on proposal id.
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// GetNextProposalID queries the ID the next proposal will be created with. Proposal
+	// IDs are allocated from a sequence, they start at 1, are gap-free and never reused.
+	GetNextProposalID(ctx context.Context, in *QueryGetNextProposalIDRequest, opts ...grpc.CallOption) (*QueryGetNextProposalIDResponse, error)
+	// ArchivedProposal queries a proposal that was moved to the archive once it was done,
+	// see the module's ArchiveProposals setting. Proposals that weren't archived aren't found.
+	ArchivedProposal(ctx context.Context, in *QueryArchivedProposalRequest, opts ...grpc.CallOption) (*QueryArchivedProposalResponse, error)
+	// BatchProposalTallies queries the tallies of several proposals at once.
+	// Proposals that don't exist are skipped.
+	BatchProposalTallies(ctx context.Context, in *QueryBatchProposalTalliesRequest, opts ...grpc.CallOption) (*QueryBatchProposalTalliesResponse, error)
+	// ProposalSnapshot queries the members, with their weights, that an open proposal
+	// is tallied against. It fails once the group was modified since the proposal
+	// was submitted, as the proposal can't be tallied anymore then.
+	ProposalSnapshot(ctx context.Context, in *QueryProposalSnapshotRequest, opts ...grpc.CallOption) (*QueryProposalSnapshotResponse, error)
+	// SimulateOutcome queries the decision policy result of a proposal at a given time
+	// if no other votes are cast until then. For a proposal that has already been
+	// finalized, the result persisted on finalization is returned.
+	SimulateOutcome(ctx context.Context, in *QuerySimulateOutcomeRequest, opts ...grpc.CallOption) (*QuerySimulateOutcomeResponse, error)
+	// EvaluatePolicy evaluates a decision policy against a hypothetical tally
+	// without reading any proposal or group from the store.
+	EvaluatePolicy(ctx context.Context, in *QueryEvaluatePolicyRequest, opts ...grpc.CallOption) (*QueryEvaluatePolicyResponse, error)
 	// ProposalsByGroupAccount queries proposals based on group account address.
 	ProposalsByGroupAccount(ctx context.Context, in *QueryProposalsByGroupAccountRequest, opts ...grpc.CallOption) (*QueryProposalsByGroupAccountResponse, error)
+	// ProposalsByStatus queries proposals based on their status.
+	ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error)
 	// VoteByProposalVoter queries a vote by proposal id and voter.
 	VoteByProposalVoter(ctx context.Context, in *QueryVoteByProposalVoterRequest, opts ...grpc.CallOption) (*QueryVoteByProposalVoterResponse, error)
 	// VotesByProposal queries a vote by proposal.
 	VotesByProposal(ctx context.Context, in *QueryVotesByProposalRequest, opts ...grpc.CallOption) (*QueryVotesByProposalResponse, error)
-	// VotesByVoter queries a vote by voter.
+	// VotesByVoter queries a vote by voter. It returns the voting history of the
+	// voter across all proposals, using the vote table's voter index.
 	VotesByVoter(ctx context.Context, in *QueryVotesByVoterRequest, opts ...grpc.CallOption) (*QueryVotesByVoterResponse, error)
+	// ChangedVotes queries the vote changes of a proposal in the order they were made.
+	ChangedVotes(ctx context.Context, in *QueryChangedVotesRequest, opts ...grpc.CallOption) (*QueryChangedVotesResponse, error)
+	// PendingVoters queries the members of the group of an open proposal, with their
+	// weights, that have neither voted nor committed a hidden vote on it yet.
+	PendingVoters(ctx context.Context, in *QueryPendingVotersRequest, opts ...grpc.CallOption) (*QueryPendingVotersResponse, error)
 }
 
 type queryClient struct {
-	cc                       grpc.ClientConnInterface
-	_GroupInfo               types.Invoker
-	_GroupAccountInfo        types.Invoker
-	_GroupMembers            types.Invoker
-	_GroupsByAdmin           types.Invoker
-	_GroupAccountsByGroup    types.Invoker
-	_GroupAccountsByAdmin    types.Invoker
-	_Proposal                types.Invoker
-	_ProposalsByGroupAccount types.Invoker
-	_VoteByProposalVoter     types.Invoker
-	_VotesByProposal         types.Invoker
-	_VotesByVoter            types.Invoker
+	cc                          grpc.ClientConnInterface
+	_GroupInfo                  types.Invoker
+	_GroupAccountInfo           types.Invoker
+	_GroupAccountDecisionPolicy types.Invoker
+	_GroupMembers               types.Invoker
+	_SampleCommittee            types.Invoker
+	_WeightChangeImpact         types.Invoker
+	_GroupsByAdmin              types.Invoker
+	_GroupAccountsByGroup       types.Invoker
+	_GroupAccountsByAdmin       types.Invoker
+	_FindDuplicateAccounts      types.Invoker
+	_TotalNetworkVotingWeight   types.Invoker
+	_UnsatisfiablePolicies      types.Invoker
+	_Proposal                   types.Invoker
+	_GetNextProposalID          types.Invoker
+	_ArchivedProposal           types.Invoker
+	_BatchProposalTallies       types.Invoker
+	_ProposalSnapshot           types.Invoker
+	_SimulateOutcome            types.Invoker
+	_EvaluatePolicy             types.Invoker
+	_ProposalsByGroupAccount    types.Invoker
+	_ProposalsByStatus          types.Invoker
+	_VoteByProposalVoter        types.Invoker
+	_VotesByProposal            types.Invoker
+	_VotesByVoter               types.Invoker
+	_ChangedVotes               types.Invoker
+	_PendingVoters              types.Invoker
 }
 
 func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
@@ -105,6 +166,29 @@ func (c *queryClient) GroupAccountInfo(ctx context.Context, in *QueryGroupAccoun
 	return out, nil
 }
 
+func (c *queryClient) GroupAccountDecisionPolicy(ctx context.Context, in *QueryGroupAccountDecisionPolicyRequest, opts ...grpc.CallOption) (*QueryGroupAccountDecisionPolicyResponse, error) {
+	if invoker := c._GroupAccountDecisionPolicy; invoker != nil {
+		var out QueryGroupAccountDecisionPolicyResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._GroupAccountDecisionPolicy, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/GroupAccountDecisionPolicy")
+		if err != nil {
+			var out QueryGroupAccountDecisionPolicyResponse
+			err = c._GroupAccountDecisionPolicy(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryGroupAccountDecisionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/GroupAccountDecisionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GroupMembers(ctx context.Context, in *QueryGroupMembersRequest, opts ...grpc.CallOption) (*QueryGroupMembersResponse, error) {
 	if invoker := c._GroupMembers; invoker != nil {
 		var out QueryGroupMembersResponse
@@ -128,6 +212,52 @@ func (c *queryClient) GroupMembers(ctx context.Context, in *QueryGroupMembersReq
 	return out, nil
 }
 
+func (c *queryClient) SampleCommittee(ctx context.Context, in *QuerySampleCommitteeRequest, opts ...grpc.CallOption) (*QuerySampleCommitteeResponse, error) {
+	if invoker := c._SampleCommittee; invoker != nil {
+		var out QuerySampleCommitteeResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._SampleCommittee, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/SampleCommittee")
+		if err != nil {
+			var out QuerySampleCommitteeResponse
+			err = c._SampleCommittee(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QuerySampleCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/SampleCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WeightChangeImpact(ctx context.Context, in *QueryWeightChangeImpactRequest, opts ...grpc.CallOption) (*QueryWeightChangeImpactResponse, error) {
+	if invoker := c._WeightChangeImpact; invoker != nil {
+		var out QueryWeightChangeImpactResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._WeightChangeImpact, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/WeightChangeImpact")
+		if err != nil {
+			var out QueryWeightChangeImpactResponse
+			err = c._WeightChangeImpact(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryWeightChangeImpactResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/WeightChangeImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GroupsByAdmin(ctx context.Context, in *QueryGroupsByAdminRequest, opts ...grpc.CallOption) (*QueryGroupsByAdminResponse, error) {
 	if invoker := c._GroupsByAdmin; invoker != nil {
 		var out QueryGroupsByAdminResponse
@@ -197,6 +327,75 @@ func (c *queryClient) GroupAccountsByAdmin(ctx context.Context, in *QueryGroupAc
 	return out, nil
 }
 
+func (c *queryClient) FindDuplicateAccounts(ctx context.Context, in *QueryFindDuplicateAccountsRequest, opts ...grpc.CallOption) (*QueryFindDuplicateAccountsResponse, error) {
+	if invoker := c._FindDuplicateAccounts; invoker != nil {
+		var out QueryFindDuplicateAccountsResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._FindDuplicateAccounts, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/FindDuplicateAccounts")
+		if err != nil {
+			var out QueryFindDuplicateAccountsResponse
+			err = c._FindDuplicateAccounts(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryFindDuplicateAccountsResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/FindDuplicateAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalNetworkVotingWeight(ctx context.Context, in *QueryTotalNetworkVotingWeightRequest, opts ...grpc.CallOption) (*QueryTotalNetworkVotingWeightResponse, error) {
+	if invoker := c._TotalNetworkVotingWeight; invoker != nil {
+		var out QueryTotalNetworkVotingWeightResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._TotalNetworkVotingWeight, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/TotalNetworkVotingWeight")
+		if err != nil {
+			var out QueryTotalNetworkVotingWeightResponse
+			err = c._TotalNetworkVotingWeight(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryTotalNetworkVotingWeightResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/TotalNetworkVotingWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnsatisfiablePolicies(ctx context.Context, in *QueryUnsatisfiablePoliciesRequest, opts ...grpc.CallOption) (*QueryUnsatisfiablePoliciesResponse, error) {
+	if invoker := c._UnsatisfiablePolicies; invoker != nil {
+		var out QueryUnsatisfiablePoliciesResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._UnsatisfiablePolicies, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/UnsatisfiablePolicies")
+		if err != nil {
+			var out QueryUnsatisfiablePoliciesResponse
+			err = c._UnsatisfiablePolicies(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryUnsatisfiablePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/UnsatisfiablePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
 	if invoker := c._Proposal; invoker != nil {
 		var out QueryProposalResponse
@@ -220,6 +419,144 @@ func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, op
 	return out, nil
 }
 
+func (c *queryClient) GetNextProposalID(ctx context.Context, in *QueryGetNextProposalIDRequest, opts ...grpc.CallOption) (*QueryGetNextProposalIDResponse, error) {
+	if invoker := c._GetNextProposalID; invoker != nil {
+		var out QueryGetNextProposalIDResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._GetNextProposalID, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/GetNextProposalID")
+		if err != nil {
+			var out QueryGetNextProposalIDResponse
+			err = c._GetNextProposalID(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryGetNextProposalIDResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/GetNextProposalID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ArchivedProposal(ctx context.Context, in *QueryArchivedProposalRequest, opts ...grpc.CallOption) (*QueryArchivedProposalResponse, error) {
+	if invoker := c._ArchivedProposal; invoker != nil {
+		var out QueryArchivedProposalResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._ArchivedProposal, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/ArchivedProposal")
+		if err != nil {
+			var out QueryArchivedProposalResponse
+			err = c._ArchivedProposal(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryArchivedProposalResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/ArchivedProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchProposalTallies(ctx context.Context, in *QueryBatchProposalTalliesRequest, opts ...grpc.CallOption) (*QueryBatchProposalTalliesResponse, error) {
+	if invoker := c._BatchProposalTallies; invoker != nil {
+		var out QueryBatchProposalTalliesResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._BatchProposalTallies, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/BatchProposalTallies")
+		if err != nil {
+			var out QueryBatchProposalTalliesResponse
+			err = c._BatchProposalTallies(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryBatchProposalTalliesResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/BatchProposalTallies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalSnapshot(ctx context.Context, in *QueryProposalSnapshotRequest, opts ...grpc.CallOption) (*QueryProposalSnapshotResponse, error) {
+	if invoker := c._ProposalSnapshot; invoker != nil {
+		var out QueryProposalSnapshotResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._ProposalSnapshot, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/ProposalSnapshot")
+		if err != nil {
+			var out QueryProposalSnapshotResponse
+			err = c._ProposalSnapshot(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryProposalSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/ProposalSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateOutcome(ctx context.Context, in *QuerySimulateOutcomeRequest, opts ...grpc.CallOption) (*QuerySimulateOutcomeResponse, error) {
+	if invoker := c._SimulateOutcome; invoker != nil {
+		var out QuerySimulateOutcomeResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._SimulateOutcome, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/SimulateOutcome")
+		if err != nil {
+			var out QuerySimulateOutcomeResponse
+			err = c._SimulateOutcome(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QuerySimulateOutcomeResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/SimulateOutcome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EvaluatePolicy(ctx context.Context, in *QueryEvaluatePolicyRequest, opts ...grpc.CallOption) (*QueryEvaluatePolicyResponse, error) {
+	if invoker := c._EvaluatePolicy; invoker != nil {
+		var out QueryEvaluatePolicyResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._EvaluatePolicy, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/EvaluatePolicy")
+		if err != nil {
+			var out QueryEvaluatePolicyResponse
+			err = c._EvaluatePolicy(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryEvaluatePolicyResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/EvaluatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ProposalsByGroupAccount(ctx context.Context, in *QueryProposalsByGroupAccountRequest, opts ...grpc.CallOption) (*QueryProposalsByGroupAccountResponse, error) {
 	if invoker := c._ProposalsByGroupAccount; invoker != nil {
 		var out QueryProposalsByGroupAccountResponse
@@ -243,6 +580,29 @@ func (c *queryClient) ProposalsByGroupAccount(ctx context.Context, in *QueryProp
 	return out, nil
 }
 
+func (c *queryClient) ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error) {
+	if invoker := c._ProposalsByStatus; invoker != nil {
+		var out QueryProposalsByStatusResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._ProposalsByStatus, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/ProposalsByStatus")
+		if err != nil {
+			var out QueryProposalsByStatusResponse
+			err = c._ProposalsByStatus(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryProposalsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/ProposalsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) VoteByProposalVoter(ctx context.Context, in *QueryVoteByProposalVoterRequest, opts ...grpc.CallOption) (*QueryVoteByProposalVoterResponse, error) {
 	if invoker := c._VoteByProposalVoter; invoker != nil {
 		var out QueryVoteByProposalVoterResponse
@@ -312,30 +672,122 @@ func (c *queryClient) VotesByVoter(ctx context.Context, in *QueryVotesByVoterReq
 	return out, nil
 }
 
+func (c *queryClient) ChangedVotes(ctx context.Context, in *QueryChangedVotesRequest, opts ...grpc.CallOption) (*QueryChangedVotesResponse, error) {
+	if invoker := c._ChangedVotes; invoker != nil {
+		var out QueryChangedVotesResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._ChangedVotes, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/ChangedVotes")
+		if err != nil {
+			var out QueryChangedVotesResponse
+			err = c._ChangedVotes(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryChangedVotesResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/ChangedVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingVoters(ctx context.Context, in *QueryPendingVotersRequest, opts ...grpc.CallOption) (*QueryPendingVotersResponse, error) {
+	if invoker := c._PendingVoters; invoker != nil {
+		var out QueryPendingVotersResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._PendingVoters, err = invokerConn.Invoker("/regen.group.v1alpha1.Query/PendingVoters")
+		if err != nil {
+			var out QueryPendingVotersResponse
+			err = c._PendingVoters(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(QueryPendingVotersResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/PendingVoters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
 	GroupInfo(types.Context, *QueryGroupInfoRequest) (*QueryGroupInfoResponse, error)
 	// GroupAccountInfo queries group account info based on group account address.
 	GroupAccountInfo(types.Context, *QueryGroupAccountInfoRequest) (*QueryGroupAccountInfoResponse, error)
+	// GroupAccountDecisionPolicy queries the decision policy of a group account based on group account address.
+	GroupAccountDecisionPolicy(types.Context, *QueryGroupAccountDecisionPolicyRequest) (*QueryGroupAccountDecisionPolicyResponse, error)
 	// GroupMembers queries members of a group
 	GroupMembers(types.Context, *QueryGroupMembersRequest) (*QueryGroupMembersResponse, error)
+	// SampleCommittee queries a committee of group members sampled without replacement,
+	// with a probability proportional to their weight, from a seed such as a past block hash.
+	// The same seed always yields the same committee for the same group members.
+	SampleCommittee(types.Context, *QuerySampleCommitteeRequest) (*QuerySampleCommitteeResponse, error)
+	// WeightChangeImpact checks that changing the weight of a group member keeps all decision policies
+	// of the group's accounts valid. It returns an error if any policy would become invalid.
+	WeightChangeImpact(types.Context, *QueryWeightChangeImpactRequest) (*QueryWeightChangeImpactResponse, error)
 	// GroupsByAdmin queries groups by admin address.
 	GroupsByAdmin(types.Context, *QueryGroupsByAdminRequest) (*QueryGroupsByAdminResponse, error)
 	// GroupAccountsByGroup queries group accounts by group id.
 	GroupAccountsByGroup(types.Context, *QueryGroupAccountsByGroupRequest) (*QueryGroupAccountsByGroupResponse, error)
 	// GroupsByAdmin queries group accounts by admin address.
 	GroupAccountsByAdmin(types.Context, *QueryGroupAccountsByAdminRequest) (*QueryGroupAccountsByAdminResponse, error)
+	// FindDuplicateAccounts queries the group accounts of a group which share the same
+	// admin and decision policy with another account of that group.
+	FindDuplicateAccounts(types.Context, *QueryFindDuplicateAccountsRequest) (*QueryFindDuplicateAccountsResponse, error)
+	// TotalNetworkVotingWeight queries the sum of the total weights of all groups.
+	TotalNetworkVotingWeight(types.Context, *QueryTotalNetworkVotingWeightRequest) (*QueryTotalNetworkVotingWeightResponse, error)
+	// UnsatisfiablePolicies queries the group accounts whose decision policy fails
+	// validation against their group's current state, e.g. a threshold greater than
+	// the group's total weight, so that their proposals can never be accepted.
+	UnsatisfiablePolicies(types.Context, *QueryUnsatisfiablePoliciesRequest) (*QueryUnsatisfiablePoliciesResponse, error)
 	// Proposal queries a proposal based on proposal id.
 	Proposal(types.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// GetNextProposalID queries the ID the next proposal will be created with. Proposal
+	// IDs are allocated from a sequence, they start at 1, are gap-free and never reused.
+	GetNextProposalID(types.Context, *QueryGetNextProposalIDRequest) (*QueryGetNextProposalIDResponse, error)
+	// ArchivedProposal queries a proposal that was moved to the archive once it was done,
+	// see the module's ArchiveProposals setting. Proposals that weren't archived aren't found.
+	ArchivedProposal(types.Context, *QueryArchivedProposalRequest) (*QueryArchivedProposalResponse, error)
+	// BatchProposalTallies queries the tallies of several proposals at once.
+	// Proposals that don't exist are skipped.
+	BatchProposalTallies(types.Context, *QueryBatchProposalTalliesRequest) (*QueryBatchProposalTalliesResponse, error)
+	// ProposalSnapshot queries the members, with their weights, that an open proposal
+	// is tallied against. It fails once the group was modified since the proposal
+	// was submitted, as the proposal can't be tallied anymore then.
+	ProposalSnapshot(types.Context, *QueryProposalSnapshotRequest) (*QueryProposalSnapshotResponse, error)
+	// SimulateOutcome queries the decision policy result of a proposal at a given time
+	// if no other votes are cast until then. For a proposal that has already been
+	// finalized, the result persisted on finalization is returned.
+	SimulateOutcome(types.Context, *QuerySimulateOutcomeRequest) (*QuerySimulateOutcomeResponse, error)
+	// EvaluatePolicy evaluates a decision policy against a hypothetical tally
+	// without reading any proposal or group from the store.
+	EvaluatePolicy(types.Context, *QueryEvaluatePolicyRequest) (*QueryEvaluatePolicyResponse, error)
 	// ProposalsByGroupAccount queries proposals based on group account address.
 	ProposalsByGroupAccount(types.Context, *QueryProposalsByGroupAccountRequest) (*QueryProposalsByGroupAccountResponse, error)
+	// ProposalsByStatus queries proposals based on their status.
+	ProposalsByStatus(types.Context, *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error)
 	// VoteByProposalVoter queries a vote by proposal id and voter.
 	VoteByProposalVoter(types.Context, *QueryVoteByProposalVoterRequest) (*QueryVoteByProposalVoterResponse, error)
 	// VotesByProposal queries a vote by proposal.
 	VotesByProposal(types.Context, *QueryVotesByProposalRequest) (*QueryVotesByProposalResponse, error)
-	// VotesByVoter queries a vote by voter.
+	// VotesByVoter queries a vote by voter. It returns the voting history of the
+	// voter across all proposals, using the vote table's voter index.
 	VotesByVoter(types.Context, *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error)
+	// ChangedVotes queries the vote changes of a proposal in the order they were made.
+	ChangedVotes(types.Context, *QueryChangedVotesRequest) (*QueryChangedVotesResponse, error)
+	// PendingVoters queries the members of the group of an open proposal, with their
+	// weights, that have neither voted nor committed a hidden vote on it yet.
+	PendingVoters(types.Context, *QueryPendingVotersRequest) (*QueryPendingVotersResponse, error)
 }
 
 func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
@@ -378,6 +830,24 @@ func _Query_GroupAccountInfo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupAccountDecisionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAccountDecisionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAccountDecisionPolicy(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/GroupAccountDecisionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAccountDecisionPolicy(types.UnwrapSDKContext(ctx), req.(*QueryGroupAccountDecisionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGroupMembersRequest)
 	if err := dec(in); err != nil {
@@ -396,6 +866,42 @@ func _Query_GroupMembers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SampleCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySampleCommitteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SampleCommittee(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/SampleCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SampleCommittee(types.UnwrapSDKContext(ctx), req.(*QuerySampleCommitteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WeightChangeImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWeightChangeImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WeightChangeImpact(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/WeightChangeImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WeightChangeImpact(types.UnwrapSDKContext(ctx), req.(*QueryWeightChangeImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GroupsByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGroupsByAdminRequest)
 	if err := dec(in); err != nil {
@@ -450,6 +956,60 @@ func _Query_GroupAccountsByAdmin_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FindDuplicateAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFindDuplicateAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FindDuplicateAccounts(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/FindDuplicateAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FindDuplicateAccounts(types.UnwrapSDKContext(ctx), req.(*QueryFindDuplicateAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalNetworkVotingWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalNetworkVotingWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalNetworkVotingWeight(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/TotalNetworkVotingWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalNetworkVotingWeight(types.UnwrapSDKContext(ctx), req.(*QueryTotalNetworkVotingWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnsatisfiablePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnsatisfiablePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnsatisfiablePolicies(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/UnsatisfiablePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsatisfiablePolicies(types.UnwrapSDKContext(ctx), req.(*QueryUnsatisfiablePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProposalRequest)
 	if err := dec(in); err != nil {
@@ -468,6 +1028,114 @@ func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetNextProposalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNextProposalIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNextProposalID(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/GetNextProposalID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNextProposalID(types.UnwrapSDKContext(ctx), req.(*QueryGetNextProposalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArchivedProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedProposal(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/ArchivedProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedProposal(types.UnwrapSDKContext(ctx), req.(*QueryArchivedProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchProposalTallies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchProposalTalliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchProposalTallies(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/BatchProposalTallies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchProposalTallies(types.UnwrapSDKContext(ctx), req.(*QueryBatchProposalTalliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalSnapshot(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/ProposalSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalSnapshot(types.UnwrapSDKContext(ctx), req.(*QueryProposalSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateOutcome(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/SimulateOutcome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateOutcome(types.UnwrapSDKContext(ctx), req.(*QuerySimulateOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvaluatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvaluatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvaluatePolicy(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/EvaluatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvaluatePolicy(types.UnwrapSDKContext(ctx), req.(*QueryEvaluatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ProposalsByGroupAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProposalsByGroupAccountRequest)
 	if err := dec(in); err != nil {
@@ -486,6 +1154,24 @@ func _Query_ProposalsByGroupAccount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByStatus(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/ProposalsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByStatus(types.UnwrapSDKContext(ctx), req.(*QueryProposalsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_VoteByProposalVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVoteByProposalVoterRequest)
 	if err := dec(in); err != nil {
@@ -540,6 +1226,42 @@ func _Query_VotesByVoter_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ChangedVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChangedVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChangedVotes(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/ChangedVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChangedVotes(types.UnwrapSDKContext(ctx), req.(*QueryChangedVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingVotersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingVoters(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/PendingVoters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingVoters(types.UnwrapSDKContext(ctx), req.(*QueryPendingVotersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -555,10 +1277,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GroupAccountInfo",
 			Handler:    _Query_GroupAccountInfo_Handler,
 		},
+		{
+			MethodName: "GroupAccountDecisionPolicy",
+			Handler:    _Query_GroupAccountDecisionPolicy_Handler,
+		},
 		{
 			MethodName: "GroupMembers",
 			Handler:    _Query_GroupMembers_Handler,
 		},
+		{
+			MethodName: "SampleCommittee",
+			Handler:    _Query_SampleCommittee_Handler,
+		},
+		{
+			MethodName: "WeightChangeImpact",
+			Handler:    _Query_WeightChangeImpact_Handler,
+		},
 		{
 			MethodName: "GroupsByAdmin",
 			Handler:    _Query_GroupsByAdmin_Handler,
@@ -571,14 +1305,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GroupAccountsByAdmin",
 			Handler:    _Query_GroupAccountsByAdmin_Handler,
 		},
+		{
+			MethodName: "FindDuplicateAccounts",
+			Handler:    _Query_FindDuplicateAccounts_Handler,
+		},
+		{
+			MethodName: "TotalNetworkVotingWeight",
+			Handler:    _Query_TotalNetworkVotingWeight_Handler,
+		},
+		{
+			MethodName: "UnsatisfiablePolicies",
+			Handler:    _Query_UnsatisfiablePolicies_Handler,
+		},
 		{
 			MethodName: "Proposal",
 			Handler:    _Query_Proposal_Handler,
 		},
+		{
+			MethodName: "GetNextProposalID",
+			Handler:    _Query_GetNextProposalID_Handler,
+		},
+		{
+			MethodName: "ArchivedProposal",
+			Handler:    _Query_ArchivedProposal_Handler,
+		},
+		{
+			MethodName: "BatchProposalTallies",
+			Handler:    _Query_BatchProposalTallies_Handler,
+		},
+		{
+			MethodName: "ProposalSnapshot",
+			Handler:    _Query_ProposalSnapshot_Handler,
+		},
+		{
+			MethodName: "SimulateOutcome",
+			Handler:    _Query_SimulateOutcome_Handler,
+		},
+		{
+			MethodName: "EvaluatePolicy",
+			Handler:    _Query_EvaluatePolicy_Handler,
+		},
 		{
 			MethodName: "ProposalsByGroupAccount",
 			Handler:    _Query_ProposalsByGroupAccount_Handler,
 		},
+		{
+			MethodName: "ProposalsByStatus",
+			Handler:    _Query_ProposalsByStatus_Handler,
+		},
 		{
 			MethodName: "VoteByProposalVoter",
 			Handler:    _Query_VoteByProposalVoter_Handler,
@@ -591,20 +1365,43 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VotesByVoter",
 			Handler:    _Query_VotesByVoter_Handler,
 		},
+		{
+			MethodName: "ChangedVotes",
+			Handler:    _Query_ChangedVotes_Handler,
+		},
+		{
+			MethodName: "PendingVoters",
+			Handler:    _Query_PendingVoters_Handler,
+		},
 	},
 	Metadata: "regen/group/v1alpha1/query.proto",
 }
 
 const (
-	QueryGroupInfoMethod               = "/regen.group.v1alpha1.Query/GroupInfo"
-	QueryGroupAccountInfoMethod        = "/regen.group.v1alpha1.Query/GroupAccountInfo"
-	QueryGroupMembersMethod            = "/regen.group.v1alpha1.Query/GroupMembers"
-	QueryGroupsByAdminMethod           = "/regen.group.v1alpha1.Query/GroupsByAdmin"
-	QueryGroupAccountsByGroupMethod    = "/regen.group.v1alpha1.Query/GroupAccountsByGroup"
-	QueryGroupAccountsByAdminMethod    = "/regen.group.v1alpha1.Query/GroupAccountsByAdmin"
-	QueryProposalMethod                = "/regen.group.v1alpha1.Query/Proposal"
-	QueryProposalsByGroupAccountMethod = "/regen.group.v1alpha1.Query/ProposalsByGroupAccount"
-	QueryVoteByProposalVoterMethod     = "/regen.group.v1alpha1.Query/VoteByProposalVoter"
-	QueryVotesByProposalMethod         = "/regen.group.v1alpha1.Query/VotesByProposal"
-	QueryVotesByVoterMethod            = "/regen.group.v1alpha1.Query/VotesByVoter"
+	QueryGroupInfoMethod                  = "/regen.group.v1alpha1.Query/GroupInfo"
+	QueryGroupAccountInfoMethod           = "/regen.group.v1alpha1.Query/GroupAccountInfo"
+	QueryGroupAccountDecisionPolicyMethod = "/regen.group.v1alpha1.Query/GroupAccountDecisionPolicy"
+	QueryGroupMembersMethod               = "/regen.group.v1alpha1.Query/GroupMembers"
+	QuerySampleCommitteeMethod            = "/regen.group.v1alpha1.Query/SampleCommittee"
+	QueryWeightChangeImpactMethod         = "/regen.group.v1alpha1.Query/WeightChangeImpact"
+	QueryGroupsByAdminMethod              = "/regen.group.v1alpha1.Query/GroupsByAdmin"
+	QueryGroupAccountsByGroupMethod       = "/regen.group.v1alpha1.Query/GroupAccountsByGroup"
+	QueryGroupAccountsByAdminMethod       = "/regen.group.v1alpha1.Query/GroupAccountsByAdmin"
+	QueryFindDuplicateAccountsMethod      = "/regen.group.v1alpha1.Query/FindDuplicateAccounts"
+	QueryTotalNetworkVotingWeightMethod   = "/regen.group.v1alpha1.Query/TotalNetworkVotingWeight"
+	QueryUnsatisfiablePoliciesMethod      = "/regen.group.v1alpha1.Query/UnsatisfiablePolicies"
+	QueryProposalMethod                   = "/regen.group.v1alpha1.Query/Proposal"
+	QueryGetNextProposalIDMethod          = "/regen.group.v1alpha1.Query/GetNextProposalID"
+	QueryArchivedProposalMethod           = "/regen.group.v1alpha1.Query/ArchivedProposal"
+	QueryBatchProposalTalliesMethod       = "/regen.group.v1alpha1.Query/BatchProposalTallies"
+	QueryProposalSnapshotMethod           = "/regen.group.v1alpha1.Query/ProposalSnapshot"
+	QuerySimulateOutcomeMethod            = "/regen.group.v1alpha1.Query/SimulateOutcome"
+	QueryEvaluatePolicyMethod             = "/regen.group.v1alpha1.Query/EvaluatePolicy"
+	QueryProposalsByGroupAccountMethod    = "/regen.group.v1alpha1.Query/ProposalsByGroupAccount"
+	QueryProposalsByStatusMethod          = "/regen.group.v1alpha1.Query/ProposalsByStatus"
+	QueryVoteByProposalVoterMethod        = "/regen.group.v1alpha1.Query/VoteByProposalVoter"
+	QueryVotesByProposalMethod            = "/regen.group.v1alpha1.Query/VotesByProposal"
+	QueryVotesByVoterMethod               = "/regen.group.v1alpha1.Query/VotesByVoter"
+	QueryChangedVotesMethod               = "/regen.group.v1alpha1.Query/ChangedVotes"
+	QueryPendingVotersMethod              = "/regen.group.v1alpha1.Query/PendingVoters"
 )
@@ -0,0 +1,22 @@
+package server
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// ArchiveProposals moves the proposals that are done, see proposalDone, from the
+// proposal table to the archived proposal table and returns the number of archived
+// proposals. Archived proposals keep their final result and tally, the result of
+// expired proposals is tallied before they are archived. Their individual votes are
+// deleted.
+func (s serverImpl) ArchiveProposals(ctx types.Context) (int, error) {
+	return s.forEachDoneProposal(ctx, func(id group.ProposalID, p group.Proposal) error {
+		if err := s.archivedProposalTable.Create(ctx, id.Bytes(), &p); err != nil {
+			return sdkerrors.Wrap(err, "archive proposal")
+		}
+		return s.deleteProposal(ctx, id)
+	})
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestArchiveProposals(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+	s.archiveProposals = true
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member := sdk.AccAddress([]byte("member-address-_____")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: member, Weight: "1"}},
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+
+	createProposal := func(ctx types.Context) group.ProposalID {
+		res, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{member},
+		})
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+	assertHot := func(ctx types.Context, id group.ProposalID) {
+		_, err := s.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		require.NoError(t, err)
+		_, err = s.ArchivedProposal(ctx, &group.QueryArchivedProposalRequest{ProposalId: id})
+		assert.True(t, orm.ErrNotFound.Is(err))
+	}
+	assertArchived := func(ctx types.Context, id group.ProposalID) group.Proposal {
+		_, err := s.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		assert.True(t, orm.ErrNotFound.Is(err))
+		res, err := s.ArchivedProposal(ctx, &group.QueryArchivedProposalRequest{ProposalId: id})
+		require.NoError(t, err)
+		return *res.Proposal
+	}
+
+	rejectedID := createProposal(ctxAt(0))
+	expiringID := createProposal(ctxAt(0))
+	_, err = s.Vote(ctxAt(time.Second), &group.MsgVoteRequest{ProposalId: rejectedID, Voter: member, Choice: group.Choice_CHOICE_NO})
+	require.NoError(t, err)
+	openID := createProposal(ctxAt(5 * time.Second))
+
+	// nothing is archived before finalization
+	assertHot(ctxAt(time.Second), rejectedID)
+	assertHot(ctxAt(time.Second), expiringID)
+
+	// finalized proposals are moved, open ones kept
+	s.EndBlock(ctxAt(5 * time.Second).Context)
+	p := assertArchived(ctxAt(5*time.Second), rejectedID)
+	assert.Equal(t, group.ProposalStatusClosed, p.Status)
+	assert.Equal(t, group.ProposalResultRejected, p.Result)
+	assert.Equal(t, "1", p.VoteState.NoCount)
+	votesRes, err := s.VotesByProposal(ctxAt(5*time.Second), &group.QueryVotesByProposalRequest{ProposalId: rejectedID})
+	require.NoError(t, err)
+	assert.Empty(t, votesRes.Votes)
+	assertHot(ctxAt(5*time.Second), expiringID)
+	assertHot(ctxAt(5*time.Second), openID)
+
+	// expired proposals are archived with their tallied result
+	archived, err := s.ArchiveProposals(ctxAt(10 * time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 1, archived)
+	p = assertArchived(ctxAt(10*time.Second), expiringID)
+	assert.Equal(t, group.ProposalResultRejected, p.Result)
+	assertHot(ctxAt(10*time.Second), openID)
+}
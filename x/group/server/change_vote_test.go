@@ -0,0 +1,82 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestChangeVote(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1Addr := sdk.AccAddress([]byte("member1-address-____"))
+	member1 := member1Addr.String()
+	member2 := sdk.AccAddress([]byte("member2-address-____")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: member1, Weight: "1"},
+			{Address: member2, Weight: "2"},
+		},
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 100})))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+	proposalRes, err := s.CreateProposal(ctxAt(0), &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{member1},
+	})
+	require.NoError(t, err)
+	id := proposalRes.ProposalId
+
+	changedVotes := func() []*group.VoteChange {
+		res, err := s.ChangedVotes(ctxAt(time.Minute), &group.QueryChangedVotesRequest{ProposalId: id})
+		require.NoError(t, err)
+		return res.VoteChanges
+	}
+
+	// a vote can only be changed once cast
+	_, err = s.ChangeVote(ctxAt(0), &group.MsgChangeVoteRequest{ProposalId: id, Voter: member1, Choice: group.Choice_CHOICE_NO})
+	require.Error(t, err)
+	assert.True(t, orm.ErrNotFound.Is(err))
+
+	// a first vote isn't a change
+	_, err = s.Vote(ctxAt(time.Second), &group.MsgVoteRequest{ProposalId: id, Voter: member1, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+	assert.Empty(t, changedVotes())
+
+	_, err = s.ChangeVote(ctxAt(2*time.Second), &group.MsgChangeVoteRequest{ProposalId: id, Voter: member1, Choice: group.Choice_CHOICE_YES})
+	require.Error(t, err)
+	assert.True(t, group.ErrInvalid.Is(err))
+
+	_, err = s.ChangeVote(ctxAt(3*time.Second), &group.MsgChangeVoteRequest{ProposalId: id, Voter: member1, Choice: group.Choice_CHOICE_NO})
+	require.NoError(t, err)
+	assert.Equal(t, []*group.VoteChange{{
+		ProposalId: id,
+		Voter:      member1,
+		OldChoice:  group.Choice_CHOICE_YES,
+		NewChoice:  group.Choice_CHOICE_NO,
+		ChangedAt:  gogotypes.Timestamp{Seconds: testBlockTime.Add(3 * time.Second).Unix()},
+	}}, changedVotes())
+
+	// the old vote is no longer counted
+	p, err := s.getProposal(ctxAt(3*time.Second), id)
+	require.NoError(t, err)
+	assert.Equal(t, "0", p.VoteState.YesCount)
+	assert.Equal(t, "1", p.VoteState.NoCount)
+	vote, err := s.getVote(ctxAt(3*time.Second), id, member1Addr)
+	require.NoError(t, err)
+	assert.Equal(t, group.Choice_CHOICE_NO, vote.Choice)
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// CloneGroup creates a new group administered by the request's new admin with
+// the members, weights and settings of the source group, which only the source
+// group's admin can clone. Each group account of the source group is cloned into
+// a new group account of the new group with the same decision policy. The total
+// weight of the new group is recomputed.
+func (s serverImpl) CloneGroup(ctx types.Context, req *group.MsgCloneGroupRequest) (*group.MsgCloneGroupResponse, error) {
+	var cloneID group.ID
+	action := func(source *group.GroupInfo) error {
+		var err error
+		cloneID, err = s.cloneGroup(ctx, *source, req.NewAdmin)
+		return err
+	}
+
+	err := s.doAuthenticated(ctx, req, action, "clone group")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCloneGroupResponse{GroupId: cloneID}, nil
+}
+
+func (s serverImpl) cloneGroup(ctx types.Context, source group.GroupInfo, newAdmin string) (group.ID, error) {
+	memberIt, err := s.groupMemberByGroupIndex.Get(ctx, source.GroupId.Uint64())
+	if err != nil {
+		return 0, err
+	}
+	var groupMembers []*group.GroupMember
+	if _, err := orm.ReadAll(memberIt, &groupMembers); err != nil {
+		return 0, sdkerrors.Wrap(err, "members")
+	}
+	members := make([]group.Member, len(groupMembers))
+	for i, m := range groupMembers {
+		members[i] = *m.Member
+	}
+
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:             newAdmin,
+		Members:           members,
+		Metadata:          source.Metadata,
+		RoleMultipliers:   source.RoleMultipliers,
+		RevokeOnRemoval:   source.RevokeOnRemoval,
+		WeightDecay:       source.WeightDecay,
+		MetadataUri:       source.MetadataUri,
+		MetadataHash:      source.MetadataHash,
+		PruneVotes:        source.PruneVotes,
+		Seats:             source.Seats,
+		ProposalSchema:    source.ProposalSchema,
+		NormalizedWeights: source.NormalizedWeights,
+		NormalizedTotal:   source.NormalizedTotal,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	accountIt, err := s.groupAccountByGroupIndex.Get(ctx, source.GroupId.Uint64())
+	if err != nil {
+		return 0, err
+	}
+	var accounts []*group.GroupAccountInfo
+	if _, err := orm.ReadAll(accountIt, &accounts); err != nil {
+		return 0, sdkerrors.Wrap(err, "group accounts")
+	}
+	for _, account := range accounts {
+		_, err := s.CreateGroupAccount(ctx, &group.MsgCreateGroupAccountRequest{
+			Admin:          newAdmin,
+			GroupId:        groupRes.GroupId,
+			Metadata:       account.Metadata,
+			DecisionPolicy: account.DecisionPolicy,
+			SpendLimit:     account.SpendLimit,
+			SpendPeriod:    account.SpendPeriod,
+		})
+		if err != nil {
+			return 0, sdkerrors.Wrapf(err, "clone group account %s", account.GroupAccount)
+		}
+	}
+	return groupRes.GroupId, nil
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestCloneGroup(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______"))
+	newAdmin := sdk.AccAddress([]byte("new-admin-address-__"))
+	member1 := sdk.AccAddress([]byte("member1-address-____")).String()
+	member2 := sdk.AccAddress([]byte("member2-address-____")).String()
+
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: admin.String(),
+		Members: []group.Member{
+			{Address: member1, Weight: "1", Metadata: []byte("first")},
+			{Address: member2, Weight: "2.5", Role: "council"},
+		},
+		Metadata:        []byte("metadata"),
+		RoleMultipliers: []group.RoleMultiplier{{Role: "council", Multiplier: "2"}},
+	})
+	require.NoError(t, err)
+	sourceID := groupRes.GroupId
+
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin.String(), GroupId: sourceID, Metadata: []byte("account")}
+	policy := group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 10})
+	require.NoError(t, accountReq.SetDecisionPolicy(policy))
+	accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+
+	// only the admin of the source group can clone it
+	_, err = s.CloneGroup(ctx, &group.MsgCloneGroupRequest{Admin: newAdmin.String(), GroupId: sourceID, NewAdmin: newAdmin.String()})
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+
+	cloneRes, err := s.CloneGroup(ctx, &group.MsgCloneGroupRequest{Admin: admin.String(), GroupId: sourceID, NewAdmin: newAdmin.String()})
+	require.NoError(t, err)
+	cloneID := cloneRes.GroupId
+	assert.NotEqual(t, sourceID, cloneID)
+
+	source, err := s.getGroupInfo(ctx, sourceID)
+	require.NoError(t, err)
+	clone, err := s.getGroupInfo(ctx, cloneID)
+	require.NoError(t, err)
+	assert.Equal(t, newAdmin.String(), clone.Admin)
+	assert.Equal(t, "6.0", clone.TotalWeight)
+	assert.Equal(t, source.TotalWeight, clone.TotalWeight)
+	assert.Equal(t, source.Metadata, clone.Metadata)
+	assert.Equal(t, source.RoleMultipliers, clone.RoleMultipliers)
+
+	members := func(id group.ID) []group.Member {
+		it, err := s.groupMemberByGroupIndex.Get(ctx, id.Uint64())
+		require.NoError(t, err)
+		var loaded []*group.GroupMember
+		_, err = orm.ReadAll(it, &loaded)
+		require.NoError(t, err)
+		r := make([]group.Member, len(loaded))
+		for i := range loaded {
+			r[i] = *loaded[i].Member
+		}
+		return r
+	}
+	assert.Len(t, members(cloneID), 2)
+	assert.Equal(t, members(sourceID), members(cloneID))
+
+	accountIt, err := s.groupAccountByGroupIndex.Get(ctx, cloneID.Uint64())
+	require.NoError(t, err)
+	var accounts []*group.GroupAccountInfo
+	_, err = orm.ReadAll(accountIt, &accounts)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.NotEqual(t, accountRes.GroupAccount, accounts[0].GroupAccount)
+	assert.Equal(t, newAdmin.String(), accounts[0].Admin)
+	assert.Equal(t, []byte("account"), accounts[0].Metadata)
+	assert.Equal(t, policy, accounts[0].GetDecisionPolicy())
+
+	// unknown source group
+	_, err = s.CloneGroup(ctx, &group.MsgCloneGroupRequest{Admin: admin.String(), GroupId: 100, NewAdmin: newAdmin.String()})
+	require.Error(t, err)
+}
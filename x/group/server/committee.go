@@ -0,0 +1,117 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/cockroachdb/apd/v2"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/math"
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// hashSpace is the number of distinct sha256 hashes, 2^256.
+var hashSpace = apd.NewWithBigInt(new(big.Int).Lsh(big.NewInt(1), 256), 0)
+
+// SampleCommittee samples a committee of size members of the group without
+// replacement, each draw picking one of the remaining members with a probability
+// proportional to their effective weight. The draws are derived from the given
+// seed, which should be verifiable on-chain, e.g. a past block hash, so the same
+// seed always yields the same committee for the same group members. Members
+// without weight are never sampled.
+func (s serverImpl) SampleCommittee(ctx types.Context, request *group.QuerySampleCommitteeRequest) (*group.QuerySampleCommitteeResponse, error) {
+	size, seed := int(request.CommitteeSize), request.Seed
+	if size <= 0 {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "committee size must be positive")
+	}
+	if len(seed) == 0 {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "seed")
+	}
+	g, err := s.getGroupInfo(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	it, err := s.groupMemberByGroupIndex.Get(ctx, request.GroupId.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	var members []*group.GroupMember
+	if _, err := orm.ReadAll(it, &members); err != nil {
+		return nil, err
+	}
+
+	// Candidates are kept in the deterministic order of the member index.
+	var candidates []string
+	var weights []*apd.Decimal
+	remaining := apd.New(0, 0)
+	for _, m := range members {
+		weight, err := g.EffectiveWeight(*m.Member)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "member %s", m.Member.Address)
+		}
+		if weight.IsZero() {
+			continue
+		}
+		candidates = append(candidates, m.Member.Address)
+		weights = append(weights, weight)
+		if err := math.Add(remaining, remaining, weight); err != nil {
+			return nil, err
+		}
+	}
+	if size > len(candidates) {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "committee size %d exceeds %d members with weight", size, len(candidates))
+	}
+
+	committee := make([]string, 0, size)
+	for draw := 0; draw < size; draw++ {
+		point, err := samplePoint(seed, uint64(draw), remaining)
+		if err != nil {
+			return nil, err
+		}
+		i, err := pickWeighted(weights, point)
+		if err != nil {
+			return nil, err
+		}
+		committee = append(committee, candidates[i])
+		if err := math.SafeSub(remaining, remaining, weights[i]); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates[:i], candidates[i+1:]...)
+		weights = append(weights[:i], weights[i+1:]...)
+	}
+	return &group.QuerySampleCommitteeResponse{Members: committee}, nil
+}
+
+// samplePoint derives a point in [0, total) from the hash of the seed and draw.
+func samplePoint(seed []byte, draw uint64, total *apd.Decimal) (*apd.Decimal, error) {
+	var drawBytes [8]byte
+	binary.BigEndian.PutUint64(drawBytes[:], draw)
+	hash := sha256.Sum256(append(append([]byte{}, seed...), drawBytes[:]...))
+	point := apd.NewWithBigInt(new(big.Int).SetBytes(hash[:]), 0)
+	if err := math.Mul(point, point, total); err != nil {
+		return nil, err
+	}
+	if err := math.Quo(point, point, hashSpace); err != nil {
+		return nil, err
+	}
+	return point, nil
+}
+
+// pickWeighted returns the index of the weight whose cumulative range contains point.
+func pickWeighted(weights []*apd.Decimal, point *apd.Decimal) (int, error) {
+	cumulative := apd.New(0, 0)
+	for i, weight := range weights {
+		if err := math.Add(cumulative, cumulative, weight); err != nil {
+			return 0, err
+		}
+		if point.Cmp(cumulative) < 0 {
+			return i, nil
+		}
+	}
+	// Rounding of the point can only reach the total, which falls to the last weight.
+	return len(weights) - 1, nil
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"encoding/binary"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestSampleCommittee(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______"))
+	heavy := sdk.AccAddress([]byte("heavy-address-______"))
+	light := sdk.AccAddress([]byte("light-address-______"))
+	medium := sdk.AccAddress([]byte("medium-address-_____"))
+
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: admin.String(),
+		Members: []group.Member{
+			{Address: heavy.String(), Weight: "8"},
+			{Address: light.String(), Weight: "1"},
+			{Address: medium.String(), Weight: "2.5"},
+		},
+	})
+	require.NoError(t, err)
+	groupID := groupRes.GroupId
+	sampleCommittee := func(size uint32, seed []byte) ([]string, error) {
+		res, err := s.SampleCommittee(ctx, &group.QuerySampleCommitteeRequest{GroupId: groupID, CommitteeSize: size, Seed: seed})
+		if err != nil {
+			return nil, err
+		}
+		return res.Members, nil
+	}
+
+	t.Run("deterministic for a fixed seed", func(t *testing.T) {
+		committee, err := sampleCommittee(2, []byte("seed"))
+		require.NoError(t, err)
+		require.Len(t, committee, 2)
+		assert.NotEqual(t, committee[0], committee[1])
+		for i := 0; i < 5; i++ {
+			again, err := sampleCommittee(2, []byte("seed"))
+			require.NoError(t, err)
+			assert.Equal(t, committee, again)
+		}
+	})
+	t.Run("without replacement", func(t *testing.T) {
+		committee, err := sampleCommittee(3, []byte("seed"))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{heavy.String(), light.String(), medium.String()}, committee)
+	})
+	t.Run("biased toward higher weights", func(t *testing.T) {
+		counts := make(map[string]int)
+		for i := uint64(0); i < 1000; i++ {
+			seed := make([]byte, 8)
+			binary.BigEndian.PutUint64(seed, i)
+			committee, err := sampleCommittee(1, seed)
+			require.NoError(t, err)
+			counts[committee[0]]++
+		}
+		// expected shares are 8/11.5, 2.5/11.5 and 1/11.5 of the draws
+		assert.Greater(t, counts[heavy.String()], counts[medium.String()])
+		assert.Greater(t, counts[medium.String()], counts[light.String()])
+		assert.InDelta(t, 696, counts[heavy.String()], 60)
+	})
+	t.Run("invalid size", func(t *testing.T) {
+		_, err := sampleCommittee(4, []byte("seed"))
+		assert.True(t, group.ErrInvalid.Is(err))
+		_, err = sampleCommittee(0, []byte("seed"))
+		assert.True(t, group.ErrInvalid.Is(err))
+	})
+	t.Run("empty seed", func(t *testing.T) {
+		_, err := sampleCommittee(1, nil)
+		assert.True(t, group.ErrEmpty.Is(err))
+	})
+}
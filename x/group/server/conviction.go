@@ -0,0 +1,54 @@
+package server
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/regen-network/regen-ledger/math"
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// convictionTally returns the tally of the proposal with the yes count replaced by
+// the conviction of its yes votes at the block time, or at the proposal timeout once
+// the voting period ended. Votes are weighted with the voters' current effective
+// weights, votes of members that were removed from the group don't count.
+func (s serverImpl) convictionTally(ctx types.Context, id group.ProposalID, p group.Proposal, electorate group.GroupInfo, policy group.ConvictionDecisionPolicy) (group.Tally, error) {
+	it, err := s.voteByProposalIndex.Get(ctx, id.Uint64())
+	if err != nil {
+		return group.Tally{}, err
+	}
+	var votes []*group.Vote
+	if _, err := orm.ReadAll(it, &votes); err != nil {
+		return group.Tally{}, err
+	}
+
+	counted := make([]group.Vote, 0, len(votes))
+	weights := make([]string, 0, len(votes))
+	for _, vote := range votes {
+		voter := group.GroupMember{GroupId: electorate.GroupId, Member: &group.Member{Address: vote.Voter}}
+		switch err := s.groupMemberTable.GetOne(ctx, voter.NaturalKey(), &voter); {
+		case orm.ErrNotFound.Is(err):
+			continue
+		case err != nil:
+			return group.Tally{}, sdkerrors.Wrapf(err, "voter %s", vote.Voter)
+		}
+		weight, err := electorate.EffectiveWeight(*voter.Member)
+		if err != nil {
+			return group.Tally{}, sdkerrors.Wrapf(err, "voter %s", vote.Voter)
+		}
+		counted = append(counted, *vote)
+		weights = append(weights, math.DecimalString(weight))
+	}
+
+	at := ctx.BlockTime()
+	timeout, err := gogotypes.TimestampFromProto(&p.Timeout)
+	if err != nil {
+		return group.Tally{}, sdkerrors.Wrap(err, "timeout")
+	}
+	if timeout.Before(at) {
+		at = timeout
+	}
+	return policy.ConvictionTally(p.VoteState, counted, weights, at)
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestConvictionVoting(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member-address-1____")).String()
+	member2 := sdk.AccAddress([]byte("member-address-2____")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: member1, Weight: "1"},
+			{Address: member2, Weight: "1"},
+		},
+	})
+	require.NoError(t, err)
+	// the multiplier of a yes vote grows by 1 every 20s up to 3
+	policy := group.NewConvictionDecisionPolicy("2.5", gogotypes.Duration{Seconds: 100}, gogotypes.Duration{Seconds: 20}, "3")
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(policy))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+
+	createProposal := func() group.ProposalID {
+		res, err := s.CreateProposal(ctxAt(0), &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{member1},
+		})
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+	voteYes := func(id group.ProposalID, at time.Duration) {
+		_, err := s.Vote(ctxAt(at), &group.MsgVoteRequest{ProposalId: id, Voter: member1, Choice: group.Choice_CHOICE_YES})
+		require.NoError(t, err)
+	}
+	earlyID := createProposal()
+	lateID := createProposal()
+	voteYes(earlyID, 0)
+	voteYes(lateID, 90*time.Second)
+
+	conviction := func(id group.ProposalID, at time.Duration) string {
+		p, err := s.getProposal(ctxAt(at), id)
+		require.NoError(t, err)
+		g, err := s.getGroupInfo(ctxAt(at), groupRes.GroupId)
+		require.NoError(t, err)
+		tally, err := s.convictionTally(ctxAt(at), id, p, g, *policy.(*group.ConvictionDecisionPolicy))
+		require.NoError(t, err)
+		return tally.YesCount
+	}
+	// both votes have the same weight but the early one accrued more conviction
+	assert.Equal(t, "3", conviction(earlyID, 100*time.Second))
+	assert.Equal(t, "1.5", conviction(lateID, 100*time.Second))
+	// conviction doesn't grow after the deadline
+	assert.Equal(t, "1.5", conviction(lateID, 200*time.Second))
+
+	for _, id := range []group.ProposalID{earlyID, lateID} {
+		// votes are kept as cast, conviction is only used for tallies
+		p, err := s.getProposal(ctxAt(0), id)
+		require.NoError(t, err)
+		assert.Equal(t, "1", p.VoteState.YesCount)
+		assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+
+		_, err = s.Exec(ctxAt(100*time.Second), &group.MsgExecRequest{ProposalId: id, Signer: member1})
+		require.NoError(t, err)
+	}
+	early, err := s.getProposal(ctxAt(0), earlyID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalResultAccepted, early.Result)
+	assert.Equal(t, group.ResultReasonConvictionReached, early.ResultReason)
+	late, err := s.getProposal(ctxAt(0), lateID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalResultRejected, late.Result)
+	assert.Equal(t, group.ResultReasonExpiredWithoutConviction, late.ResultReason)
+}
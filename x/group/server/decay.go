@@ -0,0 +1,228 @@
+package server
+
+import (
+	"time"
+
+	"github.com/cockroachdb/apd/v2"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/regen-network/regen-ledger/math"
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// recordActivity stores the block time as the last participation of the member
+// if the group has weight decay.
+func (s serverImpl) recordActivity(ctx types.Context, g group.GroupInfo, member string) error {
+	if g.WeightDecay == nil {
+		return nil
+	}
+	blockTime, err := gogotypes.TimestampProto(ctx.BlockTime())
+	if err != nil {
+		return sdkerrors.Wrap(err, "block time conversion")
+	}
+	activity := group.GroupMemberActivity{GroupId: g.GroupId, Member: member, LastActive: *blockTime}
+	if s.groupMemberActivityTable.Has(ctx, activity.NaturalKey()) {
+		return s.groupMemberActivityTable.Save(ctx, &activity)
+	}
+	return s.groupMemberActivityTable.Create(ctx, &activity)
+}
+
+// deleteActivity deletes the recorded participation of a removed member, if any.
+func (s serverImpl) deleteActivity(ctx types.Context, m group.GroupMember) error {
+	activity := group.GroupMemberActivity{GroupId: m.GroupId, Member: m.Member.Address}
+	if !s.groupMemberActivityTable.Has(ctx, activity.NaturalKey()) {
+		return nil
+	}
+	if err := s.groupMemberActivityTable.Delete(ctx, &activity); err != nil {
+		return sdkerrors.Wrap(err, "delete member activity")
+	}
+	return nil
+}
+
+// DecayWeights subtracts the decay rate from the weight of the members of groups
+// with weight decay for each full period they didn't participate. Weights don't
+// decay below zero, members whose weight reaches zero are removed. The total weight
+// of groups with decayed weights is updated.
+// The group version is kept, so that open proposals of the group stay votable and
+// are tallied against the decayed weights. Votes that were already cast on them
+// are counted with the decayed weight of their voter, see decayVotes.
+// The inactivity of members without a recorded participation is measured from the
+// first time they are seen here.
+func (s serverImpl) DecayWeights(ctx types.Context) error {
+	it, err := s.groupTable.PrefixScan(ctx, nil, nil)
+	if err != nil {
+		return err
+	}
+	var groups []*group.GroupInfo
+	if _, err := orm.ReadAll(it, &groups); err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if g.WeightDecay == nil {
+			continue
+		}
+		if err := s.decayGroupWeights(ctx, g); err != nil {
+			return sdkerrors.Wrapf(err, "group %d", g.GroupId)
+		}
+	}
+	return nil
+}
+
+func (s serverImpl) decayGroupWeights(ctx types.Context, g *group.GroupInfo) error {
+	rate, err := math.ParsePositiveDecimal(g.WeightDecay.Rate)
+	if err != nil {
+		return sdkerrors.Wrap(err, "weight decay rate")
+	}
+	period, err := gogotypes.DurationFromProto(&g.WeightDecay.Period)
+	if err != nil {
+		return sdkerrors.Wrap(err, "weight decay period")
+	}
+	totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	if err != nil {
+		return err
+	}
+
+	it, err := s.groupMemberByGroupIndex.Get(ctx, g.GroupId.Uint64())
+	if err != nil {
+		return err
+	}
+	var members []*group.GroupMember
+	if _, err := orm.ReadAll(it, &members); err != nil {
+		return err
+	}
+
+	var decayed bool
+	for _, m := range members {
+		activity := group.GroupMemberActivity{GroupId: g.GroupId, Member: m.Member.Address}
+		switch err := s.groupMemberActivityTable.GetOne(ctx, activity.NaturalKey(), &activity); {
+		case orm.ErrNotFound.Is(err):
+			if err := s.recordActivity(ctx, *g, m.Member.Address); err != nil {
+				return err
+			}
+			continue
+		case err != nil:
+			return sdkerrors.Wrap(err, "get member activity")
+		}
+		lastActive, err := gogotypes.TimestampFromProto(&activity.LastActive)
+		if err != nil {
+			return sdkerrors.Wrap(err, "last active")
+		}
+		periods := int64(ctx.BlockTime().Sub(lastActive) / period)
+		if periods <= 0 {
+			continue
+		}
+
+		previousWeight, err := g.EffectiveWeight(*m.Member)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Member.Address)
+		}
+		weight, err := math.ParseNonNegativeDecimal(m.Member.Weight)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Member.Address)
+		}
+		decay := apd.New(periods, 0)
+		if err := math.Mul(decay, decay, rate); err != nil {
+			return err
+		}
+		if decay.Cmp(weight) >= 0 {
+			weight = apd.New(0, 0)
+		} else if err := math.SafeSub(weight, weight, decay); err != nil {
+			return err
+		}
+		m.Member.Weight = math.DecimalString(weight)
+		newWeight, err := g.EffectiveWeight(*m.Member)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Member.Address)
+		}
+		if err := math.SafeSub(totalWeight, totalWeight, previousWeight); err != nil {
+			return err
+		}
+		if err := math.Add(totalWeight, totalWeight, newWeight); err != nil {
+			return err
+		}
+		decayed = true
+
+		if weight.IsZero() && g.RevokeOnRemoval {
+			if err := s.revokeVotes(ctx, *g, *m.Member, previousWeight); err != nil {
+				return sdkerrors.Wrap(err, "revoke votes")
+			}
+		} else if err := s.decayVotes(ctx, *g, *m.Member, previousWeight, newWeight); err != nil {
+			return sdkerrors.Wrap(err, "decay votes")
+		}
+
+		if weight.IsZero() {
+			if err := s.groupMemberTable.Delete(ctx, m); err != nil {
+				return sdkerrors.Wrap(err, "delete member")
+			}
+			if err := s.deleteActivity(ctx, *m); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := s.groupMemberTable.Save(ctx, m); err != nil {
+			return sdkerrors.Wrap(err, "save member")
+		}
+		// Inactivity is measured from the end of the last decayed period, so that
+		// the next decay happens one period later.
+		nextLastActive, err := gogotypes.TimestampProto(lastActive.Add(time.Duration(periods) * period))
+		if err != nil {
+			return err
+		}
+		activity.LastActive = *nextLastActive
+		if err := s.groupMemberActivityTable.Save(ctx, &activity); err != nil {
+			return sdkerrors.Wrap(err, "save member activity")
+		}
+	}
+	if !decayed {
+		return nil
+	}
+	g.TotalWeight = math.DecimalString(totalWeight)
+	return s.groupTable.Save(ctx, g.GroupId.Bytes(), g)
+}
+
+// decayVotes lowers the weight the votes of a member with decayed weight are
+// counted with on the open proposals of the group to the member's new weight.
+// Otherwise the counts of a tally could exceed the decayed total weight of the
+// group, which fails the evaluation of the decision policy. The previous weight
+// is only used for votes stored without their weight.
+func (s serverImpl) decayVotes(ctx types.Context, g group.GroupInfo, member group.Member, previousWeight, weight *apd.Decimal) error {
+	previousWeightStr := math.DecimalString(previousWeight)
+	weightStr := math.DecimalString(weight)
+	return s.updateOpenVotes(ctx, g, member.Address, func(vote group.Vote, proposal *group.Proposal) error {
+		voteWeight := vote.Weight
+		if voteWeight == "" {
+			voteWeight = previousWeightStr
+		}
+		counted, err := math.ParseNonNegativeDecimal(voteWeight)
+		if err != nil {
+			return sdkerrors.Wrap(err, "vote weight")
+		}
+		if counted.Cmp(weight) <= 0 {
+			return nil
+		}
+		// The vote is subtracted and added back rather than reduced, so that
+		// veto voters stay counted.
+		if err := proposal.VoteState.Sub(vote, voteWeight); err != nil {
+			return err
+		}
+		if err := proposal.VoteState.Add(vote, weightStr); err != nil {
+			return err
+		}
+		if member.Role != "" {
+			if err := proposal.VoteState.SubRoleVote(member.Role, vote, voteWeight); err != nil {
+				return err
+			}
+			if err := proposal.VoteState.AddRoleVote(member.Role, vote, weightStr); err != nil {
+				return err
+			}
+		}
+		vote.Weight = weightStr
+		if err := s.voteTable.Save(ctx, &vote); err != nil {
+			return sdkerrors.Wrap(err, "save vote")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,266 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestDecayWeights(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	inactive := sdk.AccAddress([]byte("inactive-address-___")).String()
+	active := sdk.AccAddress([]byte("active-address-_____")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: inactive, Weight: "2.5"},
+			{Address: active, Weight: "5"},
+		},
+		WeightDecay: &group.WeightDecay{Rate: "1", Period: gogotypes.Duration{Seconds: 3600}},
+	})
+	require.NoError(t, err)
+	groupID := groupRes.GroupId
+
+	// a group without weight decay is never changed
+	otherRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: inactive, Weight: "1"}},
+	})
+	require.NoError(t, err)
+
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupID}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 24 * 3600})))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+
+	memberWeight := func(member string) (string, bool) {
+		var m group.GroupMember
+		err := s.groupMemberTable.GetOne(ctxAt(0), group.GroupMember{GroupId: groupID, Member: &group.Member{Address: member}}.NaturalKey(), &m)
+		if orm.ErrNotFound.Is(err) {
+			return "", false
+		}
+		require.NoError(t, err)
+		return m.Member.Weight, true
+	}
+	groupInfo := func(id group.ID) group.GroupInfo {
+		g, err := s.getGroupInfo(ctxAt(0), id)
+		require.NoError(t, err)
+		return g
+	}
+
+	// inactivity of existing members is measured from the first decay run
+	require.NoError(t, s.DecayWeights(ctxAt(0)))
+	assert.Equal(t, uint64(1), groupInfo(groupID).Version)
+
+	// the active member participates by proposing and voting
+	proposalRes, err := s.CreateProposal(ctxAt(30*time.Minute), &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{active},
+	})
+	require.NoError(t, err)
+	_, err = s.Vote(ctxAt(30*time.Minute), &group.MsgVoteRequest{ProposalId: proposalRes.ProposalId, Voter: active, Choice: group.Choice_CHOICE_NO})
+	require.NoError(t, err)
+
+	// before a full period nothing decays
+	require.NoError(t, s.DecayWeights(ctxAt(59*time.Minute)))
+	assert.Equal(t, "7.5", groupInfo(groupID).TotalWeight)
+
+	// after one period only the inactive member's weight decays
+	require.NoError(t, s.DecayWeights(ctxAt(time.Hour)))
+	weight, found := memberWeight(inactive)
+	require.True(t, found)
+	assert.Equal(t, "1.5", weight)
+	weight, found = memberWeight(active)
+	require.True(t, found)
+	assert.Equal(t, "5", weight)
+	g := groupInfo(groupID)
+	assert.Equal(t, "6.5", g.TotalWeight)
+	assert.Equal(t, uint64(1), g.Version)
+
+	// two more periods decay the inactive member's weight to zero, not below,
+	// and remove the member, while the active member now decays too
+	require.NoError(t, s.DecayWeights(ctxAt(3*time.Hour)))
+	_, found = memberWeight(inactive)
+	assert.False(t, found)
+	weight, found = memberWeight(active)
+	require.True(t, found)
+	assert.Equal(t, "3", weight)
+	g = groupInfo(groupID)
+	assert.Equal(t, "3.0", g.TotalWeight)
+	assert.Equal(t, uint64(1), g.Version)
+
+	other := groupInfo(otherRes.GroupId)
+	assert.Equal(t, "1", other.TotalWeight)
+	assert.Equal(t, uint64(1), other.Version)
+}
+
+func TestDecayKeepsProposalsOpen(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	proposer := sdk.AccAddress([]byte("proposer-address-___")).String()
+	voter := sdk.AccAddress([]byte("voter-address-______")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: proposer, Weight: "2"},
+			{Address: voter, Weight: "2"},
+		},
+		WeightDecay: &group.WeightDecay{Rate: "1", Period: gogotypes.Duration{Seconds: 3600}},
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewPercentageDecisionPolicy("0.5", gogotypes.Duration{Seconds: 24 * 3600}, false)))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+	proposalRes, err := s.CreateProposal(ctxAt(0), &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{proposer},
+	})
+	require.NoError(t, err)
+
+	// both members decay while the proposal is open
+	s.EndBlock(ctxAt(0).Context)
+	s.EndBlock(ctxAt(90 * time.Minute).Context)
+	g, err := s.getGroupInfo(ctxAt(0), groupRes.GroupId)
+	require.NoError(t, err)
+	assert.Equal(t, "2", g.TotalWeight)
+	p, err := s.getProposal(ctxAt(0), proposalRes.ProposalId)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+
+	// the proposal is still votable and decided against the decayed weights
+	_, err = s.Vote(ctxAt(100*time.Minute), &group.MsgVoteRequest{ProposalId: proposalRes.ProposalId, Voter: voter, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+	p, err = s.getProposal(ctxAt(0), proposalRes.ProposalId)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusClosed, p.Status)
+	assert.Equal(t, group.ProposalResultAccepted, p.Result)
+	assert.Equal(t, "1", p.VoteState.YesCount)
+}
+
+func TestDecayRevokesCountedWeight(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	proposer := sdk.AccAddress([]byte("proposer-address-___")).String()
+	voterAddr := sdk.AccAddress([]byte("voter-address-______"))
+	voter := voterAddr.String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: proposer, Weight: "5"},
+			{Address: voter, Weight: "2"},
+		},
+		WeightDecay:     &group.WeightDecay{Rate: "1", Period: gogotypes.Duration{Seconds: 3600}},
+		RevokeOnRemoval: true,
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("4", gogotypes.Duration{Seconds: 24 * 3600})))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+	proposalRes, err := s.CreateProposal(ctxAt(0), &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{proposer},
+	})
+	require.NoError(t, err)
+	proposalID := proposalRes.ProposalId
+
+	s.EndBlock(ctxAt(0).Context)
+	_, err = s.Vote(ctxAt(0), &group.MsgVoteRequest{ProposalId: proposalID, Voter: voter, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+	vote, err := s.getVote(ctxAt(0), proposalID, voterAddr)
+	require.NoError(t, err)
+	assert.Equal(t, "2", vote.Weight)
+
+	// the voter decays to 1 and is then removed, the vote is revoked with
+	// the weight it was counted with
+	s.EndBlock(ctxAt(90 * time.Minute).Context)
+	s.EndBlock(ctxAt(150 * time.Minute).Context)
+	var m group.GroupMember
+	err = s.groupMemberTable.GetOne(ctxAt(0), group.GroupMember{GroupId: groupRes.GroupId, Member: &group.Member{Address: voter}}.NaturalKey(), &m)
+	require.True(t, orm.ErrNotFound.Is(err), err)
+
+	p, err := s.getProposal(ctxAt(0), proposalID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+	assert.Equal(t, "0", p.VoteState.YesCount)
+}
+
+func TestDecayLowersCountedWeight(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1Addr := sdk.AccAddress([]byte("member1-address-____"))
+	member1 := member1Addr.String()
+	member2 := sdk.AccAddress([]byte("member2-address-____")).String()
+	member3 := sdk.AccAddress([]byte("member3-address-____")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: member1, Weight: "5"},
+			{Address: member2, Weight: "5"},
+			{Address: member3, Weight: "5"},
+		},
+		WeightDecay: &group.WeightDecay{Rate: "1", Period: gogotypes.Duration{Seconds: 3600}},
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewPercentageDecisionPolicy("0.3", gogotypes.Duration{Seconds: 4 * 3600}, false)))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+	proposalRes, err := s.CreateProposal(ctxAt(0), &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{member1},
+	})
+	require.NoError(t, err)
+	id := proposalRes.ProposalId
+
+	_, err = s.Vote(ctxAt(0), &group.MsgVoteRequest{ProposalId: id, Voter: member1, Choice: group.Choice_CHOICE_NO})
+	require.NoError(t, err)
+	_, err = s.Vote(ctxAt(0), &group.MsgVoteRequest{ProposalId: id, Voter: member2, Choice: group.Choice_CHOICE_ABSTAIN})
+	require.NoError(t, err)
+
+	// without lowering the counted weights, the counts of 10 would exceed the
+	// decayed total weight of 6
+	for h := 0; h <= 3; h++ {
+		s.EndBlock(ctxAt(time.Duration(h) * time.Hour).Context)
+	}
+	g, err := s.getGroupInfo(ctxAt(0), groupRes.GroupId)
+	require.NoError(t, err)
+	assert.Equal(t, "6", g.TotalWeight)
+	p, err := s.getProposal(ctxAt(0), id)
+	require.NoError(t, err)
+	assert.Equal(t, "2", p.VoteState.NoCount)
+	assert.Equal(t, "2", p.VoteState.AbstainCount)
+	vote, err := s.getVote(ctxAt(0), id, member1Addr)
+	require.NoError(t, err)
+	assert.Equal(t, "2", vote.Weight)
+
+	_, err = s.Vote(ctxAt(3*time.Hour), &group.MsgVoteRequest{ProposalId: id, Voter: member3, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+
+	// the proposal is finalized at the end of its voting period
+	require.NotPanics(t, func() { s.EndBlock(ctxAt(4 * time.Hour).Context) })
+	p, err = s.getProposal(ctxAt(0), id)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusClosed, p.Status)
+}
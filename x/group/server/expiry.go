@@ -0,0 +1,82 @@
+package server
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/math"
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// ExpireMembers removes the members whose membership has expired at the block
+// time from their groups. The total weight of these groups is recomputed from
+// the remaining members and their version is bumped. It returns the number of
+// removed members.
+func (s serverImpl) ExpireMembers(ctx types.Context) (int, error) {
+	// Index keys of members expiring at the block time are greater than the
+	// formatted block time, so the scan ends right after it.
+	it, err := s.groupMemberByExpiryIndex.PrefixScan(ctx, nil, sdk.FormatTimeBytes(ctx.BlockTime().Add(time.Nanosecond)))
+	if err != nil {
+		return 0, err
+	}
+	var members []*group.GroupMember
+	if _, err := orm.ReadAll(it, &members); err != nil {
+		return 0, err
+	}
+
+	// Groups are processed in the order of their first expired member.
+	var groupIDs []group.ID
+	expired := make(map[group.ID][]*group.GroupMember)
+	for _, m := range members {
+		if _, ok := expired[m.GroupId]; !ok {
+			groupIDs = append(groupIDs, m.GroupId)
+		}
+		expired[m.GroupId] = append(expired[m.GroupId], m)
+	}
+	for _, id := range groupIDs {
+		if err := s.expireGroupMembers(ctx, id, expired[id]); err != nil {
+			return 0, sdkerrors.Wrapf(err, "group %d", id)
+		}
+	}
+	return len(members), nil
+}
+
+func (s serverImpl) expireGroupMembers(ctx types.Context, id group.ID, members []*group.GroupMember) error {
+	g, err := s.getGroupInfo(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		weight, err := g.EffectiveWeight(*m.Member)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Member.Address)
+		}
+		if err := s.groupMemberTable.Delete(ctx, m); err != nil {
+			return sdkerrors.Wrap(err, "delete member")
+		}
+		if err := s.deleteActivity(ctx, *m); err != nil {
+			return err
+		}
+		if g.RevokeOnRemoval {
+			if err := s.revokeVotes(ctx, g, *m.Member, weight); err != nil {
+				return sdkerrors.Wrap(err, "revoke votes")
+			}
+		}
+	}
+	// The total weight is recomputed rather than decreased, so it doesn't depend
+	// on whether it was repaired earlier in the block.
+	totalWeight, _, err := s.sumMemberWeights(ctx.Context, g)
+	if err != nil {
+		return err
+	}
+	g.TotalWeight = math.DecimalString(totalWeight)
+	if err := s.normalizeWeights(ctx, &g); err != nil {
+		return err
+	}
+	g.Version++
+	return s.groupTable.Save(ctx, g.GroupId.Bytes(), &g)
+}
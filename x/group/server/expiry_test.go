@@ -0,0 +1,121 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/math"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestExpireMembers(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	temporary := sdk.AccAddress([]byte("temporary-address-__")).String()
+	permanent := sdk.AccAddress([]byte("permanent-address-__")).String()
+	invitee := sdk.AccAddress([]byte("invitee-address-____")).String()
+	expiresAt, err := gogotypes.TimestampProto(testBlockTime.Add(time.Hour))
+	require.NoError(t, err)
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: temporary, Weight: "2", ExpiresAt: expiresAt},
+			{Address: permanent, Weight: "3"},
+		},
+	})
+	require.NoError(t, err)
+	groupID := groupRes.GroupId
+
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupID}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("4", gogotypes.Duration{Seconds: 24 * 3600})))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+	proposalRes, err := s.CreateProposal(ctxAt(0), &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{permanent},
+	})
+	require.NoError(t, err)
+
+	groupInfo := func() group.GroupInfo {
+		g, err := s.getGroupInfo(ctxAt(0), groupID)
+		require.NoError(t, err)
+		return g
+	}
+	sumAt := func(d time.Duration) (string, string) {
+		sum, expiredSum, err := s.sumMemberWeights(ctxAt(d).Context, groupInfo())
+		require.NoError(t, err)
+		return math.DecimalString(sum), math.DecimalString(expiredSum)
+	}
+
+	// before the expiry time the member's weight counts
+	sum, expiredSum := sumAt(59 * time.Minute)
+	assert.Equal(t, "5", sum)
+	assert.Equal(t, "0", expiredSum)
+	n, err := s.ExpireMembers(ctxAt(59 * time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	// from the expiry time on the member's weight no longer counts and the member
+	// can't vote, while the stored total weight stays consistent until removal
+	sum, expiredSum = sumAt(time.Hour)
+	assert.Equal(t, "3", sum)
+	assert.Equal(t, "2", expiredSum)
+	_, broken := s.checkGroupTotalWeights(ctxAt(time.Hour).Context)
+	assert.False(t, broken)
+	_, err = s.Vote(ctxAt(time.Hour), &group.MsgVoteRequest{ProposalId: proposalRes.ProposalId, Voter: temporary, Choice: group.Choice_CHOICE_YES})
+	require.Error(t, err)
+	assert.True(t, group.ErrExpired.Is(err), err)
+	_, err = s.Vote(ctxAt(time.Hour), &group.MsgVoteRequest{ProposalId: proposalRes.ProposalId, Voter: permanent, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+
+	// an expired member can't be added back with the same expiry
+	_, err = s.UpdateGroupMembers(ctxAt(time.Hour), &group.MsgUpdateGroupMembersRequest{
+		Admin:         admin,
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: temporary, Weight: "1", ExpiresAt: expiresAt}},
+	})
+	require.Error(t, err)
+	assert.True(t, group.ErrExpired.Is(err), err)
+
+	// nor invited with it, and an invitation can't be accepted once the
+	// membership it offers has expired
+	invite := func(ctx types.Context) error {
+		_, err := s.InviteMember(ctx, &group.MsgInviteMemberRequest{
+			Admin:   admin,
+			GroupId: groupID,
+			Member:  group.Member{Address: invitee, Weight: "1", ExpiresAt: expiresAt},
+		})
+		return err
+	}
+	err = invite(ctxAt(time.Hour))
+	require.Error(t, err)
+	assert.True(t, group.ErrExpired.Is(err), err)
+	require.NoError(t, invite(ctxAt(0)))
+	_, err = s.AcceptInvitation(ctxAt(time.Hour), &group.MsgAcceptInvitationRequest{Invitee: invitee, GroupId: groupID})
+	require.Error(t, err)
+	assert.True(t, group.ErrExpired.Is(err), err)
+
+	// the expired member is removed from the group
+	n, err = s.ExpireMembers(ctxAt(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.False(t, s.groupMemberTable.Has(ctxAt(0), group.GroupMember{GroupId: groupID, Member: &group.Member{Address: temporary}}.NaturalKey()))
+	g := groupInfo()
+	assert.Equal(t, "3", g.TotalWeight)
+	assert.Equal(t, uint64(2), g.Version)
+	_, broken = s.checkGroupTotalWeights(ctxAt(time.Hour).Context)
+	assert.False(t, broken)
+
+	n, err = s.ExpireMembers(ctxAt(2 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
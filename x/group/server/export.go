@@ -0,0 +1,203 @@
+package server
+
+import (
+	"github.com/cockroachdb/apd/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/math"
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// ExportGroup returns the group info, members and group accounts of a group.
+// If withProposals is set, the open proposals to the group accounts are
+// exported with their votes as well.
+func (s serverImpl) ExportGroup(ctx types.Context, groupID group.ID, withProposals bool) (group.GroupExport, error) {
+	g, err := s.getGroupInfo(ctx, groupID)
+	if err != nil {
+		return group.GroupExport{}, err
+	}
+	export := group.GroupExport{Group: g}
+
+	memberIt, err := s.groupMemberByGroupIndex.Get(ctx, groupID.Uint64())
+	if err != nil {
+		return group.GroupExport{}, err
+	}
+	if _, err := orm.ReadAll(memberIt, &export.Members); err != nil {
+		return group.GroupExport{}, sdkerrors.Wrap(err, "members")
+	}
+
+	accountIt, err := s.groupAccountByGroupIndex.Get(ctx, groupID.Uint64())
+	if err != nil {
+		return group.GroupExport{}, err
+	}
+	if _, err := orm.ReadAll(accountIt, &export.GroupAccounts); err != nil {
+		return group.GroupExport{}, sdkerrors.Wrap(err, "group accounts")
+	}
+
+	if !withProposals {
+		return export, nil
+	}
+	for _, account := range export.GroupAccounts {
+		addr, err := sdk.AccAddressFromBech32(account.GroupAccount)
+		if err != nil {
+			return group.GroupExport{}, sdkerrors.Wrap(err, "group account")
+		}
+		proposalIt, err := s.proposalByGroupAccountIndex.Get(ctx, addr.Bytes())
+		if err != nil {
+			return group.GroupExport{}, err
+		}
+		var proposals []group.Proposal
+		rowIDs, err := orm.ReadAll(proposalIt, &proposals)
+		if err != nil {
+			return group.GroupExport{}, sdkerrors.Wrap(err, "proposals")
+		}
+		for i, p := range proposals {
+			if p.Status != group.ProposalStatusSubmitted {
+				continue
+			}
+			id := group.ProposalID(orm.DecodeSequence(rowIDs[i]))
+			voteIt, err := s.voteByProposalIndex.Get(ctx, id.Uint64())
+			if err != nil {
+				return group.GroupExport{}, err
+			}
+			proposal := group.ProposalExport{ProposalId: id, Proposal: p}
+			if _, err := orm.ReadAll(voteIt, &proposal.Votes); err != nil {
+				return group.GroupExport{}, sdkerrors.Wrapf(err, "votes of proposal %d", id)
+			}
+			export.Proposals = append(export.Proposals, proposal)
+		}
+	}
+	return export, nil
+}
+
+// ImportGroup stores an exported group under a new group ID, which is returned.
+// Group accounts keep their addresses and proposals are stored under new
+// proposal IDs, with their votes. Versions are kept, so that imported proposals
+// stay valid. The group, its members and group accounts are validated like new
+// ones and the group's total weight must match the weights of its members.
+// It fails if any of the group accounts already exists.
+func (s serverImpl) ImportGroup(ctx types.Context, export group.GroupExport) (group.ID, error) {
+	groupID := group.ID(s.groupSeq.NextVal(ctx))
+	g := export.Group
+	g.GroupId = groupID
+	for i := range export.Members {
+		export.Members[i].GroupId = groupID
+	}
+	for i := range export.GroupAccounts {
+		export.GroupAccounts[i].GroupId = groupID
+	}
+	if err := s.validateImport(ctx, g, export.Members, export.GroupAccounts); err != nil {
+		return 0, err
+	}
+
+	if err := s.groupTable.Create(ctx, groupID.Bytes(), &g); err != nil {
+		return 0, sdkerrors.Wrap(err, "could not create group")
+	}
+
+	for _, m := range export.Members {
+		if err := s.groupMemberTable.Create(ctx, &m); err != nil {
+			return 0, sdkerrors.Wrapf(err, "could not store member %s", m.Member.Address)
+		}
+	}
+
+	for _, account := range export.GroupAccounts {
+		if err := s.groupAccountTable.Create(ctx, &account); err != nil {
+			return 0, sdkerrors.Wrapf(err, "could not create group account %s", account.GroupAccount)
+		}
+	}
+
+	for _, p := range export.Proposals {
+		// Imported tallies are stored in the canonical form of tallies updated by votes.
+		if err := p.Proposal.VoteState.Canonicalize(); err != nil {
+			return 0, sdkerrors.Wrapf(err, "proposal %d: vote state", p.ProposalId)
+		}
+		id, err := s.proposalTable.Create(ctx, &p.Proposal)
+		if err != nil {
+			return 0, sdkerrors.Wrapf(err, "could not store proposal %d", p.ProposalId)
+		}
+		for _, vote := range p.Votes {
+			vote.ProposalId = group.ProposalID(id)
+			if err := s.voteTable.Create(ctx, &vote); err != nil {
+				return 0, sdkerrors.Wrapf(err, "could not store vote of %s on proposal %d", vote.Voter, p.ProposalId)
+			}
+		}
+	}
+	return groupID, nil
+}
+
+// validateImport applies the checks of CreateGroup and CreateGroupAccount to an
+// imported group, its members and group accounts, and checks that the total weight
+// of the group is the sum of the effective weights of its members.
+func (s serverImpl) validateImport(ctx types.Context, g group.GroupInfo, groupMembers []group.GroupMember, accounts []group.GroupAccountInfo) error {
+	if err := g.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "group")
+	}
+	maxMetadataLength := s.maxMetadataLength(ctx)
+	if err := assertMetadataLength(g.Metadata, maxMetadataLength, "group metadata"); err != nil {
+		return err
+	}
+	if err := s.assertGroupName(g.Metadata); err != nil {
+		return err
+	}
+
+	members := make(group.Members, len(groupMembers))
+	for i, m := range groupMembers {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+		members[i] = *m.Member
+	}
+	if err := members.ValidateBasic(); err != nil {
+		return err
+	}
+	totalWeight := apd.New(0, 0)
+	for _, m := range members {
+		if err := assertMetadataLength(m.Metadata, maxMetadataLength, "member metadata"); err != nil {
+			return err
+		}
+		if _, err := math.ParsePositiveDecimal(m.Weight); err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Address)
+		}
+		if err := g.AssertSeatWeight(m); err != nil {
+			return err
+		}
+		weight, err := g.EffectiveWeight(m)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Address)
+		}
+		if err := s.assertWeightPrecision(weight); err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Address)
+		}
+		if err := math.Add(totalWeight, totalWeight, weight); err != nil {
+			return err
+		}
+	}
+	groupWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "total weight")
+	}
+	if groupWeight.Cmp(totalWeight) != 0 {
+		return sdkerrors.Wrapf(group.ErrInvalid, "total weight %s doesn't match the sum of member weights %s", g.TotalWeight, math.DecimalString(totalWeight))
+	}
+	if s.groupValidator != nil {
+		if err := s.groupValidator.ValidateCreateGroup(ctx.Context, g.Admin, members); err != nil {
+			return sdkerrors.Wrap(err, "group validator")
+		}
+	}
+
+	for _, account := range accounts {
+		if err := account.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "group account %s", account.GroupAccount)
+		}
+		if err := assertMetadataLength(account.Metadata, maxMetadataLength, "group account metadata"); err != nil {
+			return err
+		}
+		if err := s.assertDecisionPolicyTypeAllowed(account.DecisionPolicy.TypeUrl); err != nil {
+			return sdkerrors.Wrapf(err, "group account %s", account.GroupAccount)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestExportImportGroup(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	group.RegisterTypes(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	newStore := func() (types.Context, serverImpl) {
+		f := newTestFixture(t)
+		return f.ctx(), f.s
+	}
+	ctx, s := newStore()
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member1-address-____")).String()
+	member2 := sdk.AccAddress([]byte("member2-address-____")).String()
+
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: member1, Weight: "1", Metadata: []byte("first")},
+			{Address: member2, Weight: "2"},
+		},
+		Metadata: []byte("metadata"),
+	})
+	require.NoError(t, err)
+	groupID := groupRes.GroupId
+
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupID, Metadata: []byte("account")}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+
+	proposalRes, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{member1},
+		Metadata:     []byte("proposal"),
+	})
+	require.NoError(t, err)
+	_, err = s.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalRes.ProposalId, Voter: member1, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+
+	export, err := s.ExportGroup(ctx, groupID, true)
+	require.NoError(t, err)
+	assert.Equal(t, groupID, export.Group.GroupId)
+	assert.Len(t, export.Members, 2)
+	require.Len(t, export.GroupAccounts, 1)
+	assert.Equal(t, accountRes.GroupAccount, export.GroupAccounts[0].GroupAccount)
+	require.Len(t, export.Proposals, 1)
+	assert.Equal(t, proposalRes.ProposalId, export.Proposals[0].ProposalId)
+	require.Len(t, export.Proposals[0].Votes, 1)
+	assert.Equal(t, member1, export.Proposals[0].Votes[0].Voter)
+
+	withoutProposals, err := s.ExportGroup(ctx, groupID, false)
+	require.NoError(t, err)
+	assert.Empty(t, withoutProposals.Proposals)
+
+	// the export is serializable
+	bz, err := cdc.MarshalBinaryBare(&export)
+	require.NoError(t, err)
+	var loaded group.GroupExport
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &loaded))
+
+	// import into another store, which already has a group
+	targetCtx, target := newStore()
+	_, err = target.CreateGroup(targetCtx, &group.MsgCreateGroupRequest{Admin: admin})
+	require.NoError(t, err)
+	importedID, err := target.ImportGroup(targetCtx, loaded)
+	require.NoError(t, err)
+	assert.NotEqual(t, groupID, importedID)
+
+	reexport, err := target.ExportGroup(targetCtx, importedID, true)
+	require.NoError(t, err)
+
+	// the round trip only changes the group ID
+	expected := export
+	expected.Group.GroupId = importedID
+	for i := range expected.Members {
+		expected.Members[i].GroupId = importedID
+	}
+	for i := range expected.GroupAccounts {
+		expected.GroupAccounts[i].GroupId = importedID
+	}
+	assert.Equal(t, cdc.MustMarshalBinaryBare(&expected), cdc.MustMarshalBinaryBare(&reexport))
+
+	accountAddr, err := sdk.AccAddressFromBech32(accountRes.GroupAccount)
+	require.NoError(t, err)
+	policy, err := target.getGroupAccountPolicy(targetCtx, accountAddr)
+	require.NoError(t, err)
+	assert.Equal(t, "3", policy.(*group.ThresholdDecisionPolicy).Threshold)
+
+	// group accounts can't be imported twice
+	_, err = target.ImportGroup(targetCtx, loaded)
+	require.Error(t, err)
+
+	// new group accounts don't collide with the imported ones
+	newAccountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: importedID}
+	require.NoError(t, newAccountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 10})))
+	newAccountRes, err := target.CreateGroupAccount(targetCtx, newAccountReq)
+	require.NoError(t, err)
+	assert.NotEqual(t, accountRes.GroupAccount, newAccountRes.GroupAccount)
+
+	invalidSpecs := map[string]func(export *group.GroupExport){
+		"total weight mismatch": func(export *group.GroupExport) {
+			export.Group.TotalWeight = "4"
+		},
+		"invalid group": func(export *group.GroupExport) {
+			export.Group.Admin = ""
+		},
+		"member without weight": func(export *group.GroupExport) {
+			export.Members[1].Member.Weight = "0"
+			export.Group.TotalWeight = "1"
+		},
+		"duplicate member": func(export *group.GroupExport) {
+			export.Members[1].Member.Address = member1
+			export.Group.TotalWeight = "2"
+			export.Members[1].Member.Weight = "1"
+		},
+		"invalid group account": func(export *group.GroupExport) {
+			export.GroupAccounts[0].Admin = ""
+		},
+	}
+	for msg, malleate := range invalidSpecs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, s := newStore()
+			var export group.GroupExport
+			require.NoError(t, cdc.UnmarshalBinaryBare(bz, &export))
+			malleate(&export)
+			_, err := s.ImportGroup(ctx, export)
+			require.Error(t, err)
+		})
+	}
+
+	// group names are required on import as on creation
+	namedCtx, named := newStore()
+	named.requireGroupName = true
+	var unnamed, withName group.GroupExport
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &unnamed))
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &withName))
+	unnamed.Group.Metadata = nil
+	_, err = named.ImportGroup(namedCtx, unnamed)
+	require.Error(t, err)
+	assert.True(t, group.ErrEmpty.Is(err))
+	_, err = named.ImportGroup(namedCtx, withName)
+	require.NoError(t, err)
+}
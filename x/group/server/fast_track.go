@@ -0,0 +1,31 @@
+package server
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// fastTrackPolicy returns the percentage decision policy fast-track proposals are
+// decided by, with the fast-track window as timeout.
+func (s serverImpl) fastTrackPolicy() group.DecisionPolicy {
+	return group.NewPercentageDecisionPolicy(s.fastTrackPercentage, *gogotypes.DurationProto(s.fastTrackWindow), false)
+}
+
+// proposalPolicy returns the decision policy the given proposal is decided by, which
+// is the fast-track policy for fast-track proposals, the overriding decision policy
+// of the proposal if any, and the decision policy of the group account otherwise.
+func (s serverImpl) proposalPolicy(p group.Proposal, accountInfo group.GroupAccountInfo) (group.DecisionPolicy, error) {
+	if p.FastTrack {
+		return s.fastTrackPolicy(), nil
+	}
+	if override := p.GetDecisionPolicy(); override != nil {
+		return override, nil
+	}
+	policy := accountInfo.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "nil policy")
+	}
+	return policy, nil
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// FinalizeExpiredProposals computes and stores the final result of the submitted
+// proposals whose voting period ended, emitting an EventProposalFinalized for
+// each of them, and returns their IDs. As on execution, proposals whose group or
+// group account was modified since submission are aborted. Paused proposals and
+// proposals that are still not final after the tally are left open.
+func (s serverImpl) FinalizeExpiredProposals(ctx types.Context) ([]uint64, error) {
+	it, err := s.proposalByStatusIndex.Get(ctx, uint64(group.ProposalStatusSubmitted))
+	if err != nil {
+		return nil, err
+	}
+	var proposals []group.Proposal
+	rowIDs, err := orm.ReadAll(it, &proposals)
+	if err != nil {
+		return nil, err
+	}
+
+	var finalized []uint64
+	for i := range proposals {
+		id := group.ProposalID(orm.DecodeSequence(rowIDs[i]))
+		ok, err := s.finalizeExpiredProposal(ctx, id, &proposals[i])
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "proposal %d", id)
+		}
+		if ok {
+			finalized = append(finalized, id.Uint64())
+		}
+	}
+	return finalized, nil
+}
+
+func (s serverImpl) finalizeExpiredProposal(ctx types.Context, id group.ProposalID, p *group.Proposal) (bool, error) {
+	expired, err := proposalExpired(ctx, *p)
+	if err != nil || !expired {
+		return false, err
+	}
+
+	accountAddr, err := sdk.AccAddressFromBech32(p.GroupAccount)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, accountAddr)
+	if err != nil {
+		return false, err
+	}
+	electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case p.GroupAccountVersion != accountInfo.Version:
+		p.Result = group.ProposalResultUnfinalized
+		p.ResultReason = group.ResultReasonGroupAccountModified
+		p.Status = group.ProposalStatusAborted
+	case p.GroupVersion != electorate.Version:
+		p.Result = group.ProposalResultUnfinalized
+		p.ResultReason = group.ResultReasonGroupModified
+		p.Status = group.ProposalStatusAborted
+	default:
+		if err := s.doTally(ctx, id, p, electorate, accountInfo); err != nil {
+			return false, err
+		}
+		if p.Status == group.ProposalStatusSubmitted {
+			return false, nil
+		}
+	}
+	if err := s.pruneFinalizedVotes(ctx, id, p, electorate); err != nil {
+		return false, err
+	}
+
+	if err := s.proposalTable.Save(ctx, id.Uint64(), p); err != nil {
+		return false, err
+	}
+	err = ctx.EventManager().EmitTypedEvent(&group.EventProposalFinalized{
+		ProposalId:   id.Uint64(),
+		Status:       p.Status,
+		Result:       p.Result,
+		ResultReason: p.ResultReason,
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestFinalizeExpiredProposals(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt := func(d time.Duration) types.Context {
+		return types.Context{Context: f.ctxAt(d).WithEventManager(sdk.NewEventManager())}
+	}
+	s := f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member := sdk.AccAddress([]byte("member-address-_____")).String()
+	other := sdk.AccAddress([]byte("other-address-______")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: member, Weight: "1"}, {Address: other, Weight: "2"}},
+	})
+	require.NoError(t, err)
+	policy := group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 3600}).(*group.ThresholdDecisionPolicy)
+	policy.Quorum = "1"
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(policy))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+
+	createProposal := func(d time.Duration) group.ProposalID {
+		res, err := s.CreateProposal(ctxAt(d), &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{member},
+		})
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+	voted := createProposal(0)
+	_, err = s.Vote(ctxAt(10*time.Minute), &group.MsgVoteRequest{ProposalId: voted, Voter: member, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+	withoutVotes := createProposal(0)
+	open := createProposal(45 * time.Minute)
+
+	// nothing expired yet
+	ids, err := s.FinalizeExpiredProposals(ctxAt(59 * time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	ctx := ctxAt(90 * time.Minute)
+	ids, err = s.FinalizeExpiredProposals(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{voted.Uint64(), withoutVotes.Uint64()}, ids)
+
+	getProposal := func(id group.ProposalID) group.Proposal {
+		p, err := s.getProposal(ctxAt(0), id)
+		require.NoError(t, err)
+		return p
+	}
+	p := getProposal(voted)
+	assert.Equal(t, group.ProposalStatusClosed, p.Status)
+	assert.Equal(t, group.ProposalResultRejected, p.Result)
+	assert.Equal(t, group.ResultReasonExpired, p.ResultReason)
+	p = getProposal(withoutVotes)
+	assert.Equal(t, group.ProposalStatusClosed, p.Status)
+	assert.Equal(t, group.ProposalResultRejected, p.Result)
+	assert.Equal(t, group.ResultReasonExpiredWithoutQuorum, p.ResultReason)
+	p = getProposal(open)
+	assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+	assert.Equal(t, group.ProposalResultUnfinalized, p.Result)
+
+	var events []*group.EventProposalFinalized
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		if e.Type != proto.MessageName(&group.EventProposalFinalized{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(e)
+		require.NoError(t, err)
+		events = append(events, msg.(*group.EventProposalFinalized))
+	}
+	assert.Equal(t, []*group.EventProposalFinalized{
+		{ProposalId: voted.Uint64(), Status: group.ProposalStatusClosed, Result: group.ProposalResultRejected, ResultReason: group.ResultReasonExpired},
+		{ProposalId: withoutVotes.Uint64(), Status: group.ProposalStatusClosed, Result: group.ProposalResultRejected, ResultReason: group.ResultReasonExpiredWithoutQuorum},
+	}, events)
+
+	// finalized proposals aren't finalized again
+	ids, err = s.FinalizeExpiredProposals(ctxAt(90 * time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
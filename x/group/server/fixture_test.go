@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// testBlockTime is the block time of the contexts returned by a testFixture.
+var testBlockTime = time.Unix(1000, 0).UTC()
+
+// testFixture is a group server on an in-memory IAVL store, used by the tests
+// of the server internals. The Msg and Query services are tested end to end
+// by the testsuite package.
+type testFixture struct {
+	key    *sdk.KVStoreKey
+	cdc    *codec.ProtoCodec
+	sdkCtx sdk.Context
+	s      serverImpl
+}
+
+func newTestFixture(t *testing.T) testFixture {
+	key := sdk.NewKVStoreKey(group.ModuleName)
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	sdkCtx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()).WithBlockTime(testBlockTime)
+
+	registry := codectypes.NewInterfaceRegistry()
+	group.RegisterTypes(registry)
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	return testFixture{
+		key:    key,
+		cdc:    cdc,
+		sdkCtx: sdkCtx,
+		s:      newServer(key, nil, nil, cdc),
+	}
+}
+
+// ctx returns a context at testBlockTime.
+func (f testFixture) ctx() types.Context {
+	return types.Context{Context: f.sdkCtx}
+}
+
+// ctxAt returns a context d after testBlockTime.
+func (f testFixture) ctxAt(d time.Duration) types.Context {
+	return types.Context{Context: f.sdkCtx.WithBlockTime(testBlockTime.Add(d))}
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// InitGenesis imports the group module genesis state. It restores the proposal ID
+// sequence, so that the IDs of exported proposals are never reused.
+func (s serverImpl) InitGenesis(ctx sdk.Context, data json.RawMessage) error {
+	var genesisState group.GenesisState
+	if err := s.cdc.UnmarshalJSON(data, &genesisState); err != nil {
+		return sdkerrors.Wrap(err, "unmarshal genesis state")
+	}
+	if err := genesisState.Validate(); err != nil {
+		return err
+	}
+	if genesisState.NextProposalId > 1 {
+		if err := s.proposalTable.Sequence().InitVal(ctx, genesisState.NextProposalId.Uint64()-1); err != nil {
+			return sdkerrors.Wrap(err, "proposal id sequence")
+		}
+	}
+	return nil
+}
+
+// ExportGenesis exports the group module genesis state.
+func (s serverImpl) ExportGenesis(ctx sdk.Context) (json.RawMessage, error) {
+	genesisState := group.NewGenesisState()
+	genesisState.NextProposalId = group.ProposalID(s.proposalTable.Sequence().PeekNextVal(ctx))
+	return s.cdc.MarshalJSON(genesisState)
+}
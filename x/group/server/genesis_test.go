@@ -0,0 +1,90 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestGenesisProposalIDs(t *testing.T) {
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member := sdk.AccAddress([]byte("member-address-_____")).String()
+
+	// setup creates a group account in the fixture and returns a function
+	// creating proposals for it.
+	setup := func(f testFixture) func(ctx types.Context) group.ProposalID {
+		groupRes, err := f.s.CreateGroup(f.ctx(), &group.MsgCreateGroupRequest{
+			Admin:   admin,
+			Members: []group.Member{{Address: member, Weight: "1"}},
+		})
+		require.NoError(t, err)
+		accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+		require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 10})))
+		accountRes, err := f.s.CreateGroupAccount(f.ctx(), accountReq)
+		require.NoError(t, err)
+		return func(ctx types.Context) group.ProposalID {
+			res, err := f.s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+				GroupAccount: accountRes.GroupAccount,
+				Proposers:    []string{member},
+			})
+			require.NoError(t, err)
+			return res.ProposalId
+		}
+	}
+	nextProposalID := func(f testFixture) group.ProposalID {
+		res, err := f.s.GetNextProposalID(f.ctx(), &group.QueryGetNextProposalIDRequest{})
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+
+	f := newTestFixture(t)
+	f.s.archiveProposals = true
+	createProposal := setup(f)
+
+	// IDs increment by one
+	first := createProposal(f.ctx())
+	assert.Equal(t, group.ProposalID(1), first)
+	second := createProposal(f.ctx())
+	assert.Equal(t, first+1, second)
+
+	// an archived proposal, deleted from the proposal table, keeps its ID
+	_, err := f.s.Vote(f.ctxAt(time.Second), &group.MsgVoteRequest{ProposalId: second, Voter: member, Choice: group.Choice_CHOICE_NO})
+	require.NoError(t, err)
+	f.s.EndBlock(f.ctxAt(5 * time.Second).Context)
+	_, err = f.s.ArchivedProposal(f.ctx(), &group.QueryArchivedProposalRequest{ProposalId: second})
+	require.NoError(t, err)
+	third := createProposal(f.ctxAt(5 * time.Second))
+	assert.Equal(t, second+1, third)
+
+	// the sequence round-trips through genesis
+	data, err := f.s.ExportGenesis(f.sdkCtx)
+	require.NoError(t, err)
+	var genesisState group.GenesisState
+	require.NoError(t, f.cdc.UnmarshalJSON(data, &genesisState))
+	assert.Equal(t, third+1, genesisState.NextProposalId)
+
+	imported := newTestFixture(t)
+	require.NoError(t, imported.s.InitGenesis(imported.sdkCtx, data))
+	assert.Equal(t, third+1, nextProposalID(imported))
+	reexported, err := imported.s.ExportGenesis(imported.sdkCtx)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(reexported))
+
+	// and no exported ID is reused after the import
+	createImported := setup(imported)
+	assert.Equal(t, third+1, createImported(imported.ctx()))
+
+	// the default genesis starts the sequence at 1
+	fresh := newTestFixture(t)
+	defaultData, err := fresh.cdc.MarshalJSON(group.NewGenesisState())
+	require.NoError(t, err)
+	require.NoError(t, fresh.s.InitGenesis(fresh.sdkCtx, defaultData))
+	assert.Equal(t, group.ProposalID(1), nextProposalID(fresh))
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/apd/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/regen-network/regen-ledger/math"
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+const totalWeightInvariant = "Group-TotalWeight"
+
+// RegisterInvariants registers all group invariants.
+func (s serverImpl) RegisterInvariants(ir sdk.InvariantRegistry) {
+	ir.RegisterRoute(group.ModuleName, totalWeightInvariant, s.groupTotalWeightInvariant())
+}
+
+// AllInvariants runs all invariants of the group module.
+func (s serverImpl) AllInvariants() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return s.groupTotalWeightInvariant()(ctx)
+	}
+}
+
+// groupTotalWeightInvariant checks that the total weight of every group equals
+// the sum of its members' effective weights. It reports the first group with a mismatch.
+func (s serverImpl) groupTotalWeightInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := s.checkGroupTotalWeights(ctx)
+		return sdk.FormatInvariant(group.ModuleName, totalWeightInvariant, msg), broken
+	}
+}
+
+func (s serverImpl) checkGroupTotalWeights(ctx sdk.Context) (string, bool) {
+	groupIt, err := s.groupTable.PrefixScan(ctx, nil, nil)
+	if err != nil {
+		return fmt.Sprintf("group table scan: %s", err), true
+	}
+	defer groupIt.Close()
+
+	for {
+		var groupInfo group.GroupInfo
+		_, err := groupIt.LoadNext(&groupInfo)
+		if orm.ErrIteratorDone.Is(err) {
+			return "", false
+		}
+		if err != nil {
+			return fmt.Sprintf("load group: %s", err), true
+		}
+
+		// Expired members still count toward the stored total weight until they are
+		// removed at the end of the block.
+		membersWeight, expiredWeight, err := s.sumMemberWeights(ctx, groupInfo)
+		if err != nil {
+			return fmt.Sprintf("group %d: %s", groupInfo.GroupId, err), true
+		}
+		if err := math.Add(membersWeight, membersWeight, expiredWeight); err != nil {
+			return fmt.Sprintf("group %d: %s", groupInfo.GroupId, err), true
+		}
+		totalWeight, err := math.ParseNonNegativeDecimal(groupInfo.TotalWeight)
+		if err != nil {
+			return fmt.Sprintf("group %d total weight: %s", groupInfo.GroupId, err), true
+		}
+		if totalWeight.Cmp(membersWeight) != 0 {
+			return fmt.Sprintf("group %d total weight %s doesn't match the sum of member weights %s",
+				groupInfo.GroupId, groupInfo.TotalWeight, math.DecimalString(membersWeight)), true
+		}
+	}
+}
+
+// sumMemberWeights returns the sum of the effective weights of the group members
+// whose membership hasn't expired at the block time, and separately the sum of
+// the effective weights of the expired members that haven't been removed yet.
+func (s serverImpl) sumMemberWeights(ctx sdk.Context, groupInfo group.GroupInfo) (sum, expiredSum *apd.Decimal, err error) {
+	memIt, err := s.groupMemberByGroupIndex.Get(ctx, groupInfo.GroupId.Uint64())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer memIt.Close()
+
+	sum, expiredSum = apd.New(0, 0), apd.New(0, 0)
+	for {
+		var member group.GroupMember
+		_, err := memIt.LoadNext(&member)
+		if orm.ErrIteratorDone.Is(err) {
+			return sum, expiredSum, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		weight, err := groupInfo.EffectiveWeight(*member.Member)
+		if err != nil {
+			return nil, nil, fmt.Errorf("member %s weight: %w", member.Member.Address, err)
+		}
+		expired, err := member.Member.Expired(ctx.BlockTime())
+		if err != nil {
+			return nil, nil, fmt.Errorf("member %s: %w", member.Member.Address, err)
+		}
+		target := sum
+		if expired {
+			target = expiredSum
+		}
+		if err := math.Add(target, target, weight); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// repairTotalWeight recomputes the total weight of the group from the sum of
+// its members' effective weights and overwrites the stored GroupInfo.TotalWeight,
+// bumping the group version. It returns the total weight before and after
+// the repair.
+func (s serverImpl) repairTotalWeight(ctx sdk.Context, g *group.GroupInfo) (oldWeight, newWeight string, err error) {
+	// Expired members are only subtracted from the total weight once they are
+	// removed at the end of the block.
+	membersWeight, expiredWeight, err := s.sumMemberWeights(ctx, *g)
+	if err != nil {
+		return "", "", err
+	}
+	if err := math.Add(membersWeight, membersWeight, expiredWeight); err != nil {
+		return "", "", err
+	}
+
+	oldWeight = g.TotalWeight
+	newWeight = math.DecimalString(membersWeight)
+	g.TotalWeight = newWeight
+	g.Version++
+	if err := s.groupTable.Save(ctx, g.GroupId.Bytes(), g); err != nil {
+		return "", "", err
+	}
+	return oldWeight, newWeight, nil
+}
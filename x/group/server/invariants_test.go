@@ -0,0 +1,77 @@
+package server
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestGroupTotalWeightInvariant(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.sdkCtx, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member1-address-____")).String()
+	member2 := sdk.AccAddress([]byte("member2-address-____")).String()
+
+	groupInfo := &group.GroupInfo{GroupId: 1, Admin: admin, Version: 1, TotalWeight: "3.5"}
+	require.NoError(t, s.groupTable.Create(ctx, groupInfo.GroupId.Bytes(), groupInfo))
+	for _, m := range []*group.Member{{Address: member1, Weight: "1"}, {Address: member2, Weight: "2.5"}} {
+		require.NoError(t, s.groupMemberTable.Create(ctx, &group.GroupMember{GroupId: 1, Member: m}))
+	}
+
+	msg, broken := s.AllInvariants()(ctx)
+	require.False(t, broken, msg)
+
+	// corrupt the total weight
+	groupInfo.TotalWeight = "4"
+	require.NoError(t, s.groupTable.Save(ctx, groupInfo.GroupId.Bytes(), groupInfo))
+
+	msg, broken = s.AllInvariants()(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "group 1 total weight 4 doesn't match the sum of member weights 3.5")
+}
+
+func TestRepairTotalWeight(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.sdkCtx, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member1-address-____")).String()
+	member2 := sdk.AccAddress([]byte("member2-address-____")).String()
+
+	// corrupted total weight
+	groupInfo := &group.GroupInfo{GroupId: 1, Admin: admin, Version: 1, TotalWeight: "4"}
+	require.NoError(t, s.groupTable.Create(ctx, groupInfo.GroupId.Bytes(), groupInfo))
+	for _, m := range []*group.Member{{Address: member1, Weight: "1"}, {Address: member2, Weight: "2.5"}} {
+		require.NoError(t, s.groupMemberTable.Create(ctx, &group.GroupMember{GroupId: 1, Member: m}))
+	}
+	_, broken := s.AllInvariants()(ctx)
+	require.True(t, broken)
+
+	// only the group admin can repair the total weight
+	_, err := s.RepairTotalWeight(types.Context{Context: ctx}, &group.MsgRepairTotalWeightRequest{Admin: member1, GroupId: 1})
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+
+	res, err := s.RepairTotalWeight(types.Context{Context: ctx}, &group.MsgRepairTotalWeightRequest{Admin: admin, GroupId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "4", res.OldTotalWeight)
+	require.Equal(t, "3.5", res.NewTotalWeight)
+
+	var loaded group.GroupInfo
+	require.NoError(t, s.groupTable.GetOne(ctx, group.ID(1).Bytes(), &loaded))
+	require.Equal(t, "3.5", loaded.TotalWeight)
+	require.Equal(t, uint64(2), loaded.Version)
+
+	msg, broken := s.AllInvariants()(ctx)
+	require.False(t, broken, msg)
+
+	// unknown group
+	_, err = s.RepairTotalWeight(types.Context{Context: ctx}, &group.MsgRepairTotalWeightRequest{Admin: admin, GroupId: 2})
+	require.Error(t, err)
+}
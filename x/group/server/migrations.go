@@ -0,0 +1,89 @@
+package server
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// decisionPolicyAnyStoreVersion is the store version from which on all group
+// account decision policies are stored as a polymorphic Any.
+const decisionPolicyAnyStoreVersion uint64 = 1
+
+// storeVersion returns the version of the group module store, 0 if it was never set.
+func (s serverImpl) storeVersion(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(s.storeKey).Get([]byte{StoreVersionKey})
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (s serverImpl) setStoreVersion(ctx sdk.Context, version uint64) {
+	ctx.KVStore(s.storeKey).Set([]byte{StoreVersionKey}, sdk.Uint64ToBigEndian(version))
+}
+
+// Migrate runs the store migrations of the group module that haven't run yet.
+func (s serverImpl) Migrate(ctx sdk.Context) error {
+	migrated, err := s.MigrateDecisionPolicies(ctx)
+	if err != nil {
+		return sdkerrors.Wrap(err, "migrate decision policies")
+	}
+	if migrated != 0 {
+		ctx.Logger().Info("migrated group account decision policies", "count", migrated)
+	}
+	return nil
+}
+
+// MigrateDecisionPolicies re-stores the decision policies of all group accounts
+// that were persisted in the legacy encoding, i.e. a plain ThresholdDecisionPolicy
+// without a type URL, as a polymorphic Any. The migration is gated by the store
+// version and does nothing once it has run. It returns the number of migrated
+// group accounts.
+func (s serverImpl) MigrateDecisionPolicies(ctx sdk.Context) (int, error) {
+	if s.storeVersion(ctx) >= decisionPolicyAnyStoreVersion {
+		return 0, nil
+	}
+
+	it, err := s.groupAccountTable.PrefixScan(ctx, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var legacy []group.GroupAccountInfo
+	for {
+		var accountInfo group.GroupAccountInfo
+		_, err := it.LoadNext(&accountInfo)
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if accountInfo.DecisionPolicy == nil || accountInfo.DecisionPolicy.TypeUrl != "" {
+			continue
+		}
+		legacy = append(legacy, accountInfo)
+	}
+
+	for _, accountInfo := range legacy {
+		var policy group.ThresholdDecisionPolicy
+		if err := policy.Unmarshal(accountInfo.DecisionPolicy.Value); err != nil {
+			return 0, sdkerrors.Wrapf(err, "group account %s: legacy decision policy", accountInfo.GroupAccount)
+		}
+		any, err := codectypes.NewAnyWithValue(&policy)
+		if err != nil {
+			return 0, err
+		}
+		accountInfo.DecisionPolicy = any
+		if err := s.groupAccountTable.Save(ctx, &accountInfo); err != nil {
+			return 0, sdkerrors.Wrapf(err, "group account %s", accountInfo.GroupAccount)
+		}
+	}
+
+	s.setStoreVersion(ctx, decisionPolicyAnyStoreVersion)
+	return len(legacy), nil
+}
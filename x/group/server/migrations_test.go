@@ -0,0 +1,96 @@
+package server
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestMigrateDecisionPolicies(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.sdkCtx, f.s
+
+	adminAddr := sdk.AccAddress([]byte("admin-address-______"))
+	admin := adminAddr.String()
+	legacyAccounts := []sdk.AccAddress{
+		sdk.AccAddress([]byte("legacy-account-1____")),
+		sdk.AccAddress([]byte("legacy-account-2____")),
+	}
+	currentAccount := sdk.AccAddress([]byte("current-account-____"))
+
+	// legacy records store the threshold policy without a type URL
+	legacyPolicy := group.ThresholdDecisionPolicy{Threshold: "2", Timeout: gogotypes.Duration{Seconds: 10}}
+	legacyPolicyBz, err := legacyPolicy.Marshal()
+	require.NoError(t, err)
+	accountStore := prefix.NewStore(ctx.KVStore(f.key), []byte{GroupAccountTablePrefix})
+	for _, addr := range legacyAccounts {
+		accountInfo := group.GroupAccountInfo{
+			GroupAccount:   addr.String(),
+			GroupId:        1,
+			Admin:          admin,
+			Version:        1,
+			DecisionPolicy: &codectypes.Any{Value: legacyPolicyBz},
+		}
+		bz, err := f.cdc.MarshalBinaryBare(&accountInfo)
+		require.NoError(t, err)
+		accountStore.Set(addr, bz)
+	}
+
+	currentPolicy := group.NewPercentageDecisionPolicy("0.5", gogotypes.Duration{Seconds: 10}, false)
+	currentInfo, err := group.NewGroupAccountInfo(currentAccount, 1, adminAddr, nil, 1, currentPolicy)
+	require.NoError(t, err)
+	require.NoError(t, s.groupAccountTable.Create(ctx, &currentInfo))
+
+	var loaded group.GroupAccountInfo
+	require.NoError(t, s.groupAccountTable.GetOne(ctx, legacyAccounts[0].Bytes(), &loaded))
+	require.Nil(t, loaded.GetDecisionPolicy())
+
+	migrated, err := s.MigrateDecisionPolicies(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, migrated)
+
+	for _, addr := range legacyAccounts {
+		var loaded group.GroupAccountInfo
+		require.NoError(t, s.groupAccountTable.GetOne(ctx, addr.Bytes(), &loaded))
+		policy, ok := loaded.GetDecisionPolicy().(*group.ThresholdDecisionPolicy)
+		require.True(t, ok)
+		require.Equal(t, legacyPolicy, *policy)
+		require.Equal(t, uint64(1), loaded.Version)
+	}
+	var current group.GroupAccountInfo
+	require.NoError(t, s.groupAccountTable.GetOne(ctx, currentAccount.Bytes(), &current))
+	require.Equal(t, currentPolicy, current.GetDecisionPolicy())
+
+	// the version gate prevents the migration from running again
+	accountInfo := group.GroupAccountInfo{
+		GroupAccount:   legacyAccounts[0].String(),
+		GroupId:        1,
+		Admin:          admin,
+		Version:        1,
+		DecisionPolicy: &codectypes.Any{Value: legacyPolicyBz},
+	}
+	bz, err := f.cdc.MarshalBinaryBare(&accountInfo)
+	require.NoError(t, err)
+	accountStore.Set(legacyAccounts[0], bz)
+
+	migrated, err = s.MigrateDecisionPolicies(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, migrated)
+	var notMigrated group.GroupAccountInfo
+	require.NoError(t, s.groupAccountTable.GetOne(ctx, legacyAccounts[0].Bytes(), &notMigrated))
+	require.Nil(t, notMigrated.GetDecisionPolicy())
+
+	// the migration handler run before every block migrates stores of an older version
+	s.setStoreVersion(ctx, 0)
+	require.NoError(t, s.Migrate(ctx))
+	require.Equal(t, decisionPolicyAnyStoreVersion, s.storeVersion(ctx))
+	var migratedInfo group.GroupAccountInfo
+	require.NoError(t, s.groupAccountTable.GetOne(ctx, legacyAccounts[0].Bytes(), &migratedInfo))
+	require.Equal(t, &legacyPolicy, migratedInfo.GetDecisionPolicy())
+}
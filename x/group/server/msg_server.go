@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 
@@ -28,6 +29,28 @@ func (s serverImpl) CreateGroup(ctx types.Context, req *group.MsgCreateGroupRequ
 	if err := assertMetadataLength(metadata, maxMetadataLength, "group metadata"); err != nil {
 		return nil, err
 	}
+	if err := s.assertGroupName(metadata); err != nil {
+		return nil, err
+	}
+
+	groupInfo := group.GroupInfo{
+		Admin:             admin,
+		Metadata:          metadata,
+		Version:           1,
+		RoleMultipliers:   req.RoleMultipliers,
+		RevokeOnRemoval:   req.RevokeOnRemoval,
+		WeightDecay:       req.WeightDecay,
+		MetadataUri:       req.MetadataUri,
+		MetadataHash:      req.MetadataHash,
+		PruneVotes:        req.PruneVotes,
+		Seats:             req.Seats,
+		ProposalSchema:    req.ProposalSchema,
+		NormalizedWeights: req.NormalizedWeights,
+		NormalizedTotal:   req.NormalizedTotal,
+	}
+	if err := group.RoleMultipliers(groupInfo.RoleMultipliers).ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(err, "role multipliers")
+	}
 
 	totalWeight := apd.New(0, 0)
 	for i := range members {
@@ -37,27 +60,38 @@ func (s serverImpl) CreateGroup(ctx types.Context, req *group.MsgCreateGroupRequ
 		}
 
 		// Members of a group must have a positive weight.
-		weight, err := math.ParsePositiveDecimal(m.Weight)
-		if err != nil {
+		if _, err := math.ParsePositiveDecimal(m.Weight); err != nil {
+			return nil, err
+		}
+		if err := assertNotExpired(ctx, m); err != nil {
 			return nil, err
 		}
+		weight, err := groupInfo.EffectiveWeight(m)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "member %s", m.Address)
+		}
+		if err := s.assertWeightPrecision(weight); err != nil {
+			return nil, sdkerrors.Wrapf(err, "member %s", m.Address)
+		}
 
-		// Adding up members weights to compute group total weight.
+		// Adding up members effective weights to compute group total weight.
 		err = math.Add(totalWeight, totalWeight, weight)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if s.groupValidator != nil {
+		if err := s.groupValidator.ValidateCreateGroup(ctx.Context, admin, members); err != nil {
+			return nil, sdkerrors.Wrap(err, "group validator")
+		}
+	}
+
 	// Create a new group in the groupTable.
 	groupID := group.ID(s.groupSeq.NextVal(ctx))
-	err := s.groupTable.Create(ctx, groupID.Bytes(), &group.GroupInfo{
-		GroupId:     groupID,
-		Admin:       admin,
-		Metadata:    metadata,
-		Version:     1,
-		TotalWeight: math.DecimalString(totalWeight),
-	})
+	groupInfo.GroupId = groupID
+	groupInfo.TotalWeight = math.DecimalString(totalWeight)
+	err := s.groupTable.Create(ctx, groupID.Bytes(), &groupInfo)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "could not create group")
 	}
@@ -68,15 +102,25 @@ func (s serverImpl) CreateGroup(ctx types.Context, req *group.MsgCreateGroupRequ
 		err := s.groupMemberTable.Create(ctx, &group.GroupMember{
 			GroupId: groupID,
 			Member: &group.Member{
-				Address:  m.Address,
-				Weight:   m.Weight,
-				Metadata: m.Metadata,
+				Address:   m.Address,
+				Weight:    m.Weight,
+				Metadata:  m.Metadata,
+				Role:      m.Role,
+				ExpiresAt: m.ExpiresAt,
 			},
 		})
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "could not store member %d", i)
 		}
 	}
+	if groupInfo.NormalizedWeights {
+		if err := s.normalizeWeights(ctx, &groupInfo); err != nil {
+			return nil, err
+		}
+		if err := s.groupTable.Save(ctx, groupID.Bytes(), &groupInfo); err != nil {
+			return nil, sdkerrors.Wrap(err, "could not normalize group weights")
+		}
+	}
 
 	groupIDStr := util.Uint64ToBase58Check(groupID.Uint64())
 	err = ctx.EventManager().EmitTypedEvent(&group.EventCreateGroup{GroupId: groupIDStr})
@@ -89,97 +133,151 @@ func (s serverImpl) CreateGroup(ctx types.Context, req *group.MsgCreateGroupRequ
 
 func (s serverImpl) UpdateGroupMembers(ctx types.Context, req *group.MsgUpdateGroupMembersRequest) (*group.MsgUpdateGroupMembersResponse, error) {
 	action := func(g *group.GroupInfo) error {
-		totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+		return s.updateGroupMembers(ctx, g, req.MemberUpdates)
+	}
+
+	err := s.doUpdateGroup(ctx, req, action, "members updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupMembersResponse{}, nil
+}
+
+// updateGroupMembers adds, updates or, for a zero weight, removes the given
+// members of the group and saves the group with its new total weight and version.
+// Updates that only remove members of a group that revokes the votes of removed
+// members keep the group version, see keepsVersionOnRemoval.
+func (s serverImpl) updateGroupMembers(ctx types.Context, g *group.GroupInfo, updates []group.Member) error {
+	totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	if err != nil {
+		return err
+	}
+	onlyRemovals := true
+	for i := range updates {
+		groupMember := group.GroupMember{GroupId: g.GroupId,
+			Member: &group.Member{
+				Address:   updates[i].Address,
+				Weight:    updates[i].Weight,
+				Metadata:  updates[i].Metadata,
+				Role:      updates[i].Role,
+				ExpiresAt: updates[i].ExpiresAt,
+			},
+		}
+
+		// Checking if the group member is already part of the group.
+		var found bool
+		var prevGroupMember group.GroupMember
+		switch err := s.groupMemberTable.GetOne(ctx, groupMember.NaturalKey(), &prevGroupMember); {
+		case err == nil:
+			found = true
+		case orm.ErrNotFound.Is(err):
+			found = false
+		default:
+			return sdkerrors.Wrap(err, "get group member")
+		}
+
+		newMemberWeight, err := g.EffectiveWeight(*groupMember.Member)
 		if err != nil {
 			return err
 		}
-		for i := range req.MemberUpdates {
-			groupMember := group.GroupMember{GroupId: req.GroupId,
-				Member: &group.Member{
-					Address:  req.MemberUpdates[i].Address,
-					Weight:   req.MemberUpdates[i].Weight,
-					Metadata: req.MemberUpdates[i].Metadata,
-				},
-			}
+		if err := s.assertWeightPrecision(newMemberWeight); err != nil {
+			return sdkerrors.Wrapf(err, "member %s", groupMember.Member.Address)
+		}
 
-			// Checking if the group member is already part of the group.
-			var found bool
-			var prevGroupMember group.GroupMember
-			switch err := s.groupMemberTable.GetOne(ctx, groupMember.NaturalKey(), &prevGroupMember); {
-			case err == nil:
-				found = true
-			case orm.ErrNotFound.Is(err):
-				found = false
-			default:
-				return sdkerrors.Wrap(err, "get group member")
+		// Handle delete for members with zero weight.
+		if newMemberWeight.IsZero() {
+			// We can't delete a group member that doesn't already exist.
+			if !found {
+				return sdkerrors.Wrap(orm.ErrNotFound, "unknown member")
 			}
 
-			newMemberWeight, err := math.ParseNonNegativeDecimal(groupMember.Member.Weight)
+			previousMemberWeight, err := g.EffectiveWeight(*prevGroupMember.Member)
 			if err != nil {
 				return err
 			}
 
-			// Handle delete for members with zero weight.
-			if newMemberWeight.IsZero() {
-				// We can't delete a group member that doesn't already exist.
-				if !found {
-					return sdkerrors.Wrap(orm.ErrNotFound, "unknown member")
-				}
-
-				previousMemberWeight, err := math.ParseNonNegativeDecimal(prevGroupMember.Member.Weight)
-				if err != nil {
-					return err
-				}
-
-				// Subtract the weight of the group member to delete from the group total weight.
-				err = math.SafeSub(totalWeight, totalWeight, previousMemberWeight)
-				if err != nil {
-					return err
-				}
+			// Subtract the weight of the group member to delete from the group total weight.
+			err = math.SafeSub(totalWeight, totalWeight, previousMemberWeight)
+			if err != nil {
+				return err
+			}
 
-				// Delete group member in the groupMemberTable.
-				if err := s.groupMemberTable.Delete(ctx, &groupMember); err != nil {
-					return sdkerrors.Wrap(err, "delete member")
-				}
-				continue
+			// Delete group member in the groupMemberTable.
+			if err := s.groupMemberTable.Delete(ctx, &groupMember); err != nil {
+				return sdkerrors.Wrap(err, "delete member")
 			}
-			// If group member already exists, handle update
-			if found {
-				previousMemberWeight, err := math.ParseNonNegativeDecimal(prevGroupMember.Member.Weight)
-				if err != nil {
-					return err
-				}
-				// Subtract previous weight from the group total weight.
-				err = math.SafeSub(totalWeight, totalWeight, previousMemberWeight)
-				if err != nil {
-					return err
-				}
-				// Save updated group member in the groupMemberTable.
-				if err := s.groupMemberTable.Save(ctx, &groupMember); err != nil {
-					return sdkerrors.Wrap(err, "add member")
+			if err := s.deleteActivity(ctx, groupMember); err != nil {
+				return err
+			}
+			if g.RevokeOnRemoval {
+				if err := s.revokeVotes(ctx, *g, *prevGroupMember.Member, previousMemberWeight); err != nil {
+					return sdkerrors.Wrap(err, "revoke votes")
 				}
-				// else handle create.
-			} else if err := s.groupMemberTable.Create(ctx, &groupMember); err != nil {
-				return sdkerrors.Wrap(err, "add member")
 			}
-			// In both cases (handle + update), we need to add the new member's weight to the group total weight.
-			err = math.Add(totalWeight, totalWeight, newMemberWeight)
+			continue
+		}
+		onlyRemovals = false
+		if err := g.AssertSeatWeight(*groupMember.Member); err != nil {
+			return err
+		}
+		if err := assertNotExpired(ctx, *groupMember.Member); err != nil {
+			return err
+		}
+		// If group member already exists, handle update
+		if found {
+			previousMemberWeight, err := g.EffectiveWeight(*prevGroupMember.Member)
+			if err != nil {
+				return err
+			}
+			// Subtract previous weight from the group total weight.
+			err = math.SafeSub(totalWeight, totalWeight, previousMemberWeight)
 			if err != nil {
 				return err
 			}
+			// Save updated group member in the groupMemberTable.
+			if err := s.groupMemberTable.Save(ctx, &groupMember); err != nil {
+				return sdkerrors.Wrap(err, "add member")
+			}
+			// else handle create.
+		} else {
+			if err := s.validateAddMember(ctx, g.GroupId, *groupMember.Member); err != nil {
+				return err
+			}
+			if err := s.groupMemberTable.Create(ctx, &groupMember); err != nil {
+				return sdkerrors.Wrap(err, "add member")
+			}
+			if err := s.recordActivity(ctx, *g, groupMember.Member.Address); err != nil {
+				return err
+			}
+		}
+		// In both cases (handle + update), we need to add the new member's weight to the group total weight.
+		err = math.Add(totalWeight, totalWeight, newMemberWeight)
+		if err != nil {
+			return err
 		}
-		// Update group in the groupTable.
-		g.TotalWeight = math.DecimalString(totalWeight)
-		g.Version++
-		return s.groupTable.Save(ctx, g.GroupId.Bytes(), g)
 	}
-
-	err := s.doUpdateGroup(ctx, req, action, "members updated")
-	if err != nil {
-		return nil, err
+	if err := s.assertSeats(ctx, *g); err != nil {
+		return err
 	}
+	// Update group in the groupTable.
+	g.TotalWeight = math.DecimalString(totalWeight)
+	if err := s.normalizeWeights(ctx, g); err != nil {
+		return err
+	}
+	if !onlyRemovals || !keepsVersionOnRemoval(*g) {
+		g.Version++
+	}
+	return s.groupTable.Save(ctx, g.GroupId.Bytes(), g)
+}
 
-	return &group.MsgUpdateGroupMembersResponse{}, nil
+// keepsVersionOnRemoval returns whether removing members keeps the version of the
+// group. The votes of removed members are then revoked from open proposals, which
+// are tallied against the remaining members and stay open. Removals from groups
+// with normalized weights change the weights of the remaining members and always
+// increment the version.
+func keepsVersionOnRemoval(g group.GroupInfo) bool {
+	return g.RevokeOnRemoval && !g.NormalizedWeights
 }
 
 func (s serverImpl) UpdateGroupAdmin(ctx types.Context, req *group.MsgUpdateGroupAdminRequest) (*group.MsgUpdateGroupAdminResponse, error) {
@@ -198,6 +296,9 @@ func (s serverImpl) UpdateGroupAdmin(ctx types.Context, req *group.MsgUpdateGrou
 }
 
 func (s serverImpl) UpdateGroupMetadata(ctx types.Context, req *group.MsgUpdateGroupMetadataRequest) (*group.MsgUpdateGroupMetadataResponse, error) {
+	if err := s.assertGroupName(req.Metadata); err != nil {
+		return nil, err
+	}
 	action := func(g *group.GroupInfo) error {
 		g.Metadata = req.Metadata
 		g.Version++
@@ -212,6 +313,272 @@ func (s serverImpl) UpdateGroupMetadata(ctx types.Context, req *group.MsgUpdateG
 	return &group.MsgUpdateGroupMetadataResponse{}, nil
 }
 
+func (s serverImpl) UpdateGroupProposalSchema(ctx types.Context, req *group.MsgUpdateGroupProposalSchemaRequest) (*group.MsgUpdateGroupProposalSchemaResponse, error) {
+	action := func(g *group.GroupInfo) error {
+		g.ProposalSchema = req.ProposalSchema
+		if g.ProposalSchema != nil && len(g.ProposalSchema.RequiredKeys) == 0 {
+			g.ProposalSchema = nil
+		}
+		g.Version++
+		return s.groupTable.Save(ctx, g.GroupId.Bytes(), g)
+	}
+
+	err := s.doUpdateGroup(ctx, req, action, "proposal schema updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupProposalSchemaResponse{}, nil
+}
+
+func (s serverImpl) RepairTotalWeight(ctx types.Context, req *group.MsgRepairTotalWeightRequest) (*group.MsgRepairTotalWeightResponse, error) {
+	var res group.MsgRepairTotalWeightResponse
+	action := func(g *group.GroupInfo) error {
+		var err error
+		res.OldTotalWeight, res.NewTotalWeight, err = s.repairTotalWeight(ctx.Context, g)
+		return err
+	}
+
+	err := s.doUpdateGroup(ctx, req, action, "total weight repaired")
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s serverImpl) InviteMember(ctx types.Context, req *group.MsgInviteMemberRequest) (*group.MsgInviteMemberResponse, error) {
+	if err := assertMetadataLength(req.Member.Metadata, s.maxMetadataLength(ctx), "member metadata"); err != nil {
+		return nil, err
+	}
+	if err := assertNotExpired(ctx, req.Member); err != nil {
+		return nil, err
+	}
+	blockTime, err := gogotypes.TimestampProto(ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	action := func(g *group.GroupInfo) error {
+		invitation := group.GroupInvitation{
+			GroupId:   g.GroupId,
+			Member:    &req.Member,
+			InvitedAt: *blockTime,
+		}
+		if s.groupMemberTable.Has(ctx, invitation.NaturalKey()) {
+			return sdkerrors.Wrap(group.ErrDuplicate, "already a member")
+		}
+		if _, err := group.RoleMultipliers(g.RoleMultipliers).Multiplier(req.Member.Role); err != nil {
+			return sdkerrors.Wrap(err, "member role")
+		}
+		weight, err := g.EffectiveWeight(req.Member)
+		if err != nil {
+			return sdkerrors.Wrap(err, "member weight")
+		}
+		if err := s.assertWeightPrecision(weight); err != nil {
+			return sdkerrors.Wrap(err, "member weight")
+		}
+		if err := g.AssertSeatWeight(req.Member); err != nil {
+			return err
+		}
+		if err := s.groupInvitationTable.Create(ctx, &invitation); err != nil {
+			if orm.ErrUniqueConstraint.Is(err) {
+				return sdkerrors.Wrap(group.ErrDuplicate, "already invited")
+			}
+			return sdkerrors.Wrap(err, "create invitation")
+		}
+		return nil
+	}
+
+	err = s.doAuthenticated(ctx, req, action, "member invited")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgInviteMemberResponse{}, nil
+}
+
+func (s serverImpl) AcceptInvitation(ctx types.Context, req *group.MsgAcceptInvitationRequest) (*group.MsgAcceptInvitationResponse, error) {
+	invitation, err := s.getGroupInvitation(ctx, req.GroupId, req.Invitee)
+	if err != nil {
+		return nil, err
+	}
+	if err := assertNotExpired(ctx, *invitation.Member); err != nil {
+		return nil, err
+	}
+	g, err := s.getGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateAddMember(ctx, req.GroupId, *invitation.Member); err != nil {
+		return nil, err
+	}
+	groupMember := group.GroupMember{GroupId: invitation.GroupId, Member: invitation.Member}
+	if err := s.groupMemberTable.Create(ctx, &groupMember); err != nil {
+		return nil, sdkerrors.Wrap(err, "add member")
+	}
+	if err := s.assertSeats(ctx, g); err != nil {
+		return nil, err
+	}
+	if err := s.recordActivity(ctx, g, groupMember.Member.Address); err != nil {
+		return nil, err
+	}
+	totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := math.ParsePositiveDecimal(invitation.Member.Weight); err != nil {
+		return nil, err
+	}
+	memberWeight, err := g.EffectiveWeight(*invitation.Member)
+	if err != nil {
+		return nil, err
+	}
+	if err := math.Add(totalWeight, totalWeight, memberWeight); err != nil {
+		return nil, err
+	}
+	g.TotalWeight = math.DecimalString(totalWeight)
+	if err := s.normalizeWeights(ctx, &g); err != nil {
+		return nil, err
+	}
+	g.Version++
+	if err := s.groupTable.Save(ctx, g.GroupId.Bytes(), &g); err != nil {
+		return nil, err
+	}
+	if err := s.groupInvitationTable.Delete(ctx, &invitation); err != nil {
+		return nil, sdkerrors.Wrap(err, "delete invitation")
+	}
+
+	groupIDStr := util.Uint64ToBase58Check(req.GroupId.Uint64())
+	err = ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroup{GroupId: groupIDStr})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgAcceptInvitationResponse{}, nil
+}
+
+func (s serverImpl) DeclineInvitation(ctx types.Context, req *group.MsgDeclineInvitationRequest) (*group.MsgDeclineInvitationResponse, error) {
+	invitation, err := s.getGroupInvitation(ctx, req.GroupId, req.Invitee)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.groupInvitationTable.Delete(ctx, &invitation); err != nil {
+		return nil, sdkerrors.Wrap(err, "delete invitation")
+	}
+	return &group.MsgDeclineInvitationResponse{}, nil
+}
+
+// AssignSeat adds a new member with the weight of a seat to a council group with
+// a free seat.
+func (s serverImpl) AssignSeat(ctx types.Context, req *group.MsgAssignSeatRequest) (*group.MsgAssignSeatResponse, error) {
+	if err := s.assertCouncilSeat(ctx, req.GroupId, req.Member, false); err != nil {
+		return nil, err
+	}
+	_, err := s.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         req.Admin,
+		GroupId:       req.GroupId,
+		MemberUpdates: []group.Member{{Address: req.Member, Weight: "1", Metadata: req.Metadata}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &group.MsgAssignSeatResponse{}, nil
+}
+
+// VacateSeat removes a member from a council group.
+func (s serverImpl) VacateSeat(ctx types.Context, req *group.MsgVacateSeatRequest) (*group.MsgVacateSeatResponse, error) {
+	if err := s.assertCouncilSeat(ctx, req.GroupId, req.Member, true); err != nil {
+		return nil, err
+	}
+	_, err := s.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         req.Admin,
+		GroupId:       req.GroupId,
+		MemberUpdates: []group.Member{{Address: req.Member, Weight: "0"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &group.MsgVacateSeatResponse{}, nil
+}
+
+// assertCouncilSeat checks that the group is a council and whether the member
+// holds a seat of it.
+func (s serverImpl) assertCouncilSeat(ctx types.Context, groupID group.ID, member string, seated bool) error {
+	g, err := s.getGroupInfo(ctx, groupID)
+	if err != nil {
+		return sdkerrors.Wrap(err, "load group")
+	}
+	if g.Seats == 0 {
+		return sdkerrors.Wrap(group.ErrInvalid, "not a council group")
+	}
+	groupMember := group.GroupMember{GroupId: groupID, Member: &group.Member{Address: member}}
+	switch found := s.groupMemberTable.Has(ctx, groupMember.NaturalKey()); {
+	case seated && !found:
+		return sdkerrors.Wrap(orm.ErrNotFound, "member has no seat")
+	case !seated && found:
+		return sdkerrors.Wrap(group.ErrDuplicate, "member already has a seat")
+	}
+	return nil
+}
+
+// assertSeats checks that the members of a council group don't exceed its seats.
+func (s serverImpl) assertSeats(ctx types.Context, g group.GroupInfo) error {
+	if g.Seats == 0 {
+		return nil
+	}
+	it, err := s.groupMemberByGroupIndex.Get(ctx, g.GroupId.Uint64())
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for count := uint64(0); count <= g.Seats; count++ {
+		_, err := it.LoadNext(&group.GroupMember{})
+		if orm.ErrIteratorDone.Is(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return sdkerrors.Wrapf(group.ErrMaxLimit, "all %d seats of the council are taken", g.Seats)
+}
+
+// assertNotExpired returns an error if the membership of m has already expired
+// at the block time.
+func assertNotExpired(ctx types.Context, m group.Member) error {
+	expired, err := m.Expired(ctx.BlockTime())
+	if err != nil {
+		return sdkerrors.Wrapf(err, "member %s", m.Address)
+	}
+	if expired {
+		return sdkerrors.Wrapf(group.ErrExpired, "membership of member %s", m.Address)
+	}
+	return nil
+}
+
+// validateAddMember runs the optional group validator before a new member is added to a group.
+func (s serverImpl) validateAddMember(ctx types.Context, id group.ID, member group.Member) error {
+	if s.groupValidator == nil {
+		return nil
+	}
+	if err := s.groupValidator.ValidateAddMember(ctx.Context, id, member); err != nil {
+		return sdkerrors.Wrap(err, "group validator")
+	}
+	return nil
+}
+
+// getGroupInvitation loads the pending invitation of invitee to the given group.
+func (s serverImpl) getGroupInvitation(ctx types.Context, id group.ID, invitee string) (group.GroupInvitation, error) {
+	invitation := group.GroupInvitation{GroupId: id, Member: &group.Member{Address: invitee}}
+	if err := s.groupInvitationTable.GetOne(ctx, invitation.NaturalKey(), &invitation); err != nil {
+		return group.GroupInvitation{}, sdkerrors.Wrap(err, "load invitation")
+	}
+	return invitation, nil
+}
+
 func (s serverImpl) CreateGroupAccount(ctx types.Context, req *group.MsgCreateGroupAccountRequest) (*group.MsgCreateGroupAccountResponse, error) {
 	admin, err := sdk.AccAddressFromBech32(req.GetAdmin())
 	if err != nil {
@@ -240,7 +607,7 @@ func (s serverImpl) CreateGroupAccount(ctx types.Context, req *group.MsgCreateGr
 
 	// Generate group account address.
 	// TODO this will need to be revisited with ADR 028 (#211).
-	accountAddr := group.AccountCondition(s.groupAccountSeq.NextVal(ctx)).Address()
+	accountAddr := s.nextGroupAccountAddress(ctx)
 	groupAccount, err := group.NewGroupAccountInfo(
 		accountAddr,
 		groupID,
@@ -252,6 +619,17 @@ func (s serverImpl) CreateGroupAccount(ctx types.Context, req *group.MsgCreateGr
 	if err != nil {
 		return nil, err
 	}
+	if err := s.assertDecisionPolicyTypeAllowed(groupAccount.DecisionPolicy.TypeUrl); err != nil {
+		return nil, err
+	}
+	if err := s.assertTimeoutGranularity(policy); err != nil {
+		return nil, err
+	}
+	if err := assertGroupCanReachThreshold(g, policy); err != nil {
+		return nil, err
+	}
+	groupAccount.SpendLimit = req.SpendLimit
+	groupAccount.SpendPeriod = req.SpendPeriod
 
 	// TODO Once we update to use ADR 028 (#211), we'll also need to
 	// ensure that a module account exists (that could be provided by ADR 033).
@@ -267,13 +645,66 @@ func (s serverImpl) CreateGroupAccount(ctx types.Context, req *group.MsgCreateGr
 	return &group.MsgCreateGroupAccountResponse{GroupAccount: accountAddr.String()}, nil
 }
 
+// nextGroupAccountAddress derives the address of a new group account from the
+// next value of the group account sequence. Values whose address is taken are
+// skipped, as group accounts imported with ImportGroup keep the address they
+// were derived with in another store.
+func (s serverImpl) nextGroupAccountAddress(ctx types.Context) sdk.AccAddress {
+	for {
+		addr := group.AccountCondition(s.groupAccountSeq.NextVal(ctx)).Address()
+		if !s.groupAccountTable.Has(ctx, addr.Bytes()) {
+			return addr
+		}
+	}
+}
+
 func (s serverImpl) UpdateGroupAccountAdmin(ctx types.Context, req *group.MsgUpdateGroupAccountAdminRequest) (*group.MsgUpdateGroupAccountAdminResponse, error) {
 	// TODO #224
 	return &group.MsgUpdateGroupAccountAdminResponse{}, nil
 }
 
 func (s serverImpl) UpdateGroupAccountDecisionPolicy(ctx types.Context, req *group.MsgUpdateGroupAccountDecisionPolicyRequest) (*group.MsgUpdateGroupAccountDecisionPolicyResponse, error) {
-	// TODO #224
+	accountAddress, err := sdk.AccAddressFromBech32(req.GroupAccount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, accountAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group account")
+	}
+	if accountInfo.Admin != req.Admin {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not group account admin")
+	}
+
+	policy := req.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "nil policy")
+	}
+	if err := s.assertDecisionPolicyTypeAllowed(req.DecisionPolicy.TypeUrl); err != nil {
+		return nil, err
+	}
+	if err := s.assertTimeoutGranularity(policy); err != nil {
+		return nil, err
+	}
+	g, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.Validate(g); err != nil {
+		return nil, sdkerrors.Wrap(err, "decision policy")
+	}
+
+	accountInfo.DecisionPolicy = req.DecisionPolicy
+	accountInfo.Version++
+	if err := s.groupAccountTable.Save(ctx, &accountInfo); err != nil {
+		return nil, sdkerrors.Wrap(err, "save group account")
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroupAccount{GroupAccount: req.GroupAccount})
+	if err != nil {
+		return nil, err
+	}
+
 	return &group.MsgUpdateGroupAccountDecisionPolicyResponse{}, nil
 }
 
@@ -282,6 +713,53 @@ func (s serverImpl) UpdateGroupAccountMetadata(ctx types.Context, req *group.Msg
 	return &group.MsgUpdateGroupAccountMetadataResponse{}, nil
 }
 
+func (s serverImpl) ReassignGroupAccount(ctx types.Context, req *group.MsgReassignGroupAccountRequest) (*group.MsgReassignGroupAccountResponse, error) {
+	accountAddress, err := sdk.AccAddressFromBech32(req.GroupAccount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, accountAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group account")
+	}
+	if accountInfo.Admin != req.Admin {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not group account admin")
+	}
+	if accountInfo.GroupId == req.NewGroupId {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "group account already belongs to group")
+	}
+
+	newGroup, err := s.getGroupInfo(ctx, req.NewGroupId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load new group")
+	}
+	// Only the admin of the new group is authorized to add a group account to it.
+	if newGroup.Admin != req.Admin {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not new group admin")
+	}
+
+	policy := accountInfo.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "nil policy")
+	}
+	if err := policy.Validate(newGroup); err != nil {
+		return nil, sdkerrors.Wrap(err, "decision policy for new group")
+	}
+
+	accountInfo.GroupId = req.NewGroupId
+	accountInfo.Version++
+	if err := s.groupAccountTable.Save(ctx, &accountInfo); err != nil {
+		return nil, sdkerrors.Wrap(err, "save group account")
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroupAccount{GroupAccount: req.GroupAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgReassignGroupAccountResponse{}, nil
+}
+
 func (s serverImpl) CreateProposal(ctx types.Context, req *group.MsgCreateProposalRequest) (*group.MsgCreateProposalResponse, error) {
 	accountAddress, err := sdk.AccAddressFromBech32(req.GroupAccount)
 	if err != nil {
@@ -304,11 +782,28 @@ func (s serverImpl) CreateProposal(ctx types.Context, req *group.MsgCreatePropos
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "get group by account")
 	}
+	if err := g.ValidateProposalMetadata(metadata); err != nil {
+		return nil, err
+	}
 
-	// Only members of the group can submit a new proposal.
+	if err := s.assertMinMembersForProposals(ctx, g.GroupId); err != nil {
+		return nil, err
+	}
+	if err := s.assertMaxOpenProposals(ctx, g.GroupId); err != nil {
+		return nil, err
+	}
+
+	// Only members of the group can submit a new proposal, unless admins are allowed
+	// to submit proposals on behalf of the group.
 	for i := range proposers {
+		if s.allowAdminProposers && proposers[i] == g.Admin {
+			continue
+		}
 		if !s.groupMemberTable.Has(ctx, group.GroupMember{GroupId: g.GroupId, Member: &group.Member{Address: proposers[i]}}.NaturalKey()) {
-			return nil, sdkerrors.Wrapf(group.ErrUnauthorized, "not in group: %s", proposers[i])
+			return nil, sdkerrors.Wrapf(group.ErrNotGroupMember, "proposer %s", proposers[i])
+		}
+		if err := s.recordActivity(ctx, g, proposers[i]); err != nil {
+			return nil, err
 		}
 	}
 
@@ -326,6 +821,38 @@ func (s serverImpl) CreateProposal(ctx types.Context, req *group.MsgCreatePropos
 	if policy == nil {
 		return nil, sdkerrors.Wrap(group.ErrEmpty, "nil policy")
 	}
+	// Fast-track proposals are decided by the fast-track policy instead, which also
+	// defines their shorter timeout.
+	if req.FastTrack {
+		if s.fastTrackWindow == 0 {
+			return nil, sdkerrors.Wrap(group.ErrInvalid, "fast-track proposals are disabled")
+		}
+		policy = s.fastTrackPolicy()
+		if err := policy.ValidateBasic(); err != nil {
+			return nil, sdkerrors.Wrap(err, "fast-track policy")
+		}
+	}
+	// A proposal can be decided by another decision policy than the one of the group
+	// account, e.g. a stricter one, only if the group account admin proposes it.
+	if override := req.GetDecisionPolicy(); override != nil {
+		var isAdminProposer bool
+		for _, proposer := range proposers {
+			if proposer == account.Admin {
+				isAdminProposer = true
+				break
+			}
+		}
+		if !isAdminProposer {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "decision policy override requires the group account admin as proposer")
+		}
+		if err := s.assertDecisionPolicyTypeAllowed(req.DecisionPolicy.TypeUrl); err != nil {
+			return nil, err
+		}
+		if err := s.assertTimeoutGranularity(override); err != nil {
+			return nil, err
+		}
+		policy = override
+	}
 
 	// Prevent proposal that can not succeed.
 	err = policy.Validate(g)
@@ -333,14 +860,33 @@ func (s serverImpl) CreateProposal(ctx types.Context, req *group.MsgCreatePropos
 		return nil, err
 	}
 
+	// Only a plurality decision policy can select an option of an option set.
+	_, isPlurality := policy.(*group.PluralityDecisionPolicy)
+	if req.OptionSet != nil && !isPlurality {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "option set requires a plurality decision policy, got %s", policy.PolicyType())
+	}
+	if req.OptionSet == nil && isPlurality {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "option set required by plurality decision policy")
+	}
+
 	// Define proposal timout.
-	// The voting window begins as soon as the proposal is submitted.
+	// The voting window begins as soon as the proposal is submitted unless a voting start time is set.
+	votingStart := ctx.BlockTime()
+	if req.VotingStartTime != nil {
+		votingStart, err = gogotypes.TimestampFromProto(req.VotingStartTime)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "voting start time conversion")
+		}
+		if votingStart.Before(ctx.BlockTime()) {
+			return nil, sdkerrors.Wrap(group.ErrInvalid, "voting start time must not be in the past")
+		}
+	}
 	timeout := policy.GetTimeout()
 	window, err := gogotypes.DurationFromProto(&timeout)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "maxVotingWindow time conversion")
 	}
-	endTime, err := gogotypes.TimestampProto(ctx.BlockTime().Add(window))
+	endTime, err := gogotypes.TimestampProto(votingStart.Add(window))
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "end time conversion")
 	}
@@ -356,12 +902,24 @@ func (s serverImpl) CreateProposal(ctx types.Context, req *group.MsgCreatePropos
 		Status:              group.ProposalStatusSubmitted,
 		ExecutorResult:      group.ProposalExecutorResultNotRun,
 		Timeout:             *endTime,
+		VotingStartTime:     req.VotingStartTime,
 		VoteState: group.Tally{
 			YesCount:     "0",
 			NoCount:      "0",
 			AbstainCount: "0",
 			VetoCount:    "0",
 		},
+		OptionSet:      req.OptionSet,
+		MetadataUri:    req.MetadataUri,
+		MetadataHash:   req.MetadataHash,
+		FastTrack:      req.FastTrack,
+		DecisionPolicy: req.DecisionPolicy,
+	}
+	if req.OptionSet != nil {
+		m.VoteState.OptionCounts = make([]string, len(req.OptionSet.Options))
+		for i := range m.VoteState.OptionCounts {
+			m.VoteState.OptionCounts[i] = "0"
+		}
 	}
 	if err := m.SetMsgs(msgs); err != nil {
 		return nil, sdkerrors.Wrap(err, "create proposal")
@@ -377,33 +935,331 @@ func (s serverImpl) CreateProposal(ctx types.Context, req *group.MsgCreatePropos
 	return &group.MsgCreateProposalResponse{ProposalId: group.ProposalID(id)}, nil
 }
 
-func (s serverImpl) Vote(ctx types.Context, req *group.MsgVoteRequest) (*group.MsgVoteResponse, error) {
+// CreateMembershipProposal submits a proposal to update the members of the group of
+// the group account. The members are updated like with Msg/UpdateGroupMembers once
+// the accepted proposal is executed.
+func (s serverImpl) CreateMembershipProposal(ctx types.Context, req *group.MsgCreateMembershipProposalRequest) (*group.MsgCreateMembershipProposalResponse, error) {
+	res, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: req.GroupAccount,
+		Proposers:    req.Proposers,
+		Metadata:     req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := s.getProposal(ctx, res.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	proposal.MemberUpdates = req.MemberUpdates
+	if err := s.proposalTable.Save(ctx, res.ProposalId.Uint64(), &proposal); err != nil {
+		return nil, sdkerrors.Wrap(err, "save proposal")
+	}
+	return &group.MsgCreateMembershipProposalResponse{ProposalId: res.ProposalId}, nil
+}
+
+// AmendProposal lets a proposer replace the metadata and msgs of a proposal within
+// the proposal editing window after its submission. Amendments are rejected once a
+// vote was cast, including hidden votes, or the voting start time, if any, was
+// reached. Every amendment increments the proposal revision.
+func (s serverImpl) AmendProposal(ctx types.Context, req *group.MsgAmendProposalRequest) (*group.MsgAmendProposalResponse, error) {
 	id := req.ProposalId
-	choice := req.Choice
-	metadata := req.Metadata
+	if s.proposalEditingWindow == 0 {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal amendments are disabled")
+	}
+	if err := assertMetadataLength(req.Metadata, s.maxMetadataLength(ctx), "metadata"); err != nil {
+		return nil, err
+	}
 
-	if err := assertMetadataLength(metadata, s.maxMetadataLength(ctx), "metadata"); err != nil {
+	proposal, err := s.getProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != group.ProposalStatusSubmitted {
+		return nil, sdkerrors.Wrapf(group.ErrProposalFinal, "not possible with proposal status %s", proposal.Status.String())
+	}
+	var isProposer bool
+	for _, proposer := range proposal.Proposers {
+		if proposer == req.Proposer {
+			isProposer = true
+			break
+		}
+	}
+	if !isProposer {
+		return nil, sdkerrors.Wrapf(group.ErrUnauthorized, "%s is not a proposer", req.Proposer)
+	}
+
+	submittedAt, err := gogotypes.TimestampFromProto(&proposal.SubmittedAt)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "submitted at")
+	}
+	if !ctx.BlockTime().Before(submittedAt.Add(s.proposalEditingWindow)) {
+		return nil, sdkerrors.Wrap(group.ErrExpired, "proposal editing window ended")
+	}
+	if proposal.VotingStartTime != nil {
+		votingStart, err := gogotypes.TimestampFromProto(proposal.VotingStartTime)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "voting start time")
+		}
+		if !ctx.BlockTime().Before(votingStart) {
+			return nil, sdkerrors.Wrap(group.ErrInvalid, "voting started")
+		}
+	}
+	hasVotes, err := s.hasVotes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if hasVotes {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal was voted on")
+	}
+
+	msgs := req.GetMsgs()
+	if proposal.OptionSet != nil && len(msgs) != 0 {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "msgs are not supported with an option set")
+	}
+	if len(proposal.MemberUpdates) != 0 && len(msgs) != 0 {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "msgs are not supported with member updates")
+	}
+	accountAddress, err := sdk.AccAddressFromBech32(proposal.GroupAccount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	if err := ensureMsgAuthZ(msgs, accountAddress); err != nil {
+		return nil, err
+	}
+	account, err := s.getGroupAccountInfo(ctx, accountAddress.Bytes())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group account")
+	}
+	g, err := s.getGroupInfo(ctx, account.GroupId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "get group by account")
+	}
+	if err := g.ValidateProposalMetadata(req.Metadata); err != nil {
+		return nil, err
+	}
+
+	proposal.Metadata = req.Metadata
+	if err := proposal.SetMsgs(msgs); err != nil {
+		return nil, sdkerrors.Wrap(err, "amend proposal")
+	}
+	proposal.Revision++
+	if err := s.proposalTable.Save(ctx, id.Uint64(), &proposal); err != nil {
+		return nil, err
+	}
+	return &group.MsgAmendProposalResponse{Revision: proposal.Revision}, nil
+}
+
+// hasVotes returns true if a vote or a vote commitment was submitted for the proposal.
+func (s serverImpl) hasVotes(ctx types.Context, id group.ProposalID) (bool, error) {
+	if s.voteByProposalIndex.Has(ctx, id.Uint64()) {
+		return true, nil
+	}
+	it, err := s.voteCommitmentTable.PrefixScan(ctx, id.Bytes(), (id + 1).Bytes())
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+	switch _, err := it.LoadNext(&group.VoteCommitment{}); {
+	case orm.ErrIteratorDone.Is(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, nil
+}
+
+func (s serverImpl) Vote(ctx types.Context, req *group.MsgVoteRequest) (*group.MsgVoteResponse, error) {
+	if err := assertMetadataLength(req.Metadata, s.maxMetadataLength(ctx), "metadata"); err != nil {
+		return nil, err
+	}
+
+	// A resubmission of the same vote with the same nonce is a no-op.
+	if len(req.Nonce) != 0 {
+		var vote group.Vote
+		switch err := s.voteTable.GetOne(ctx, group.VoteNaturalKey(req.ProposalId, req.Voter), &vote); {
+		case err == nil:
+			if bytes.Equal(vote.Nonce, req.Nonce) {
+				if vote.Choice != req.Choice || vote.Option != req.Option || !bytes.Equal(vote.Metadata, req.Metadata) {
+					return nil, sdkerrors.Wrap(group.ErrInvalid, "nonce reused for a different vote")
+				}
+				return &group.MsgVoteResponse{}, nil
+			}
+		case orm.ErrNotFound.Is(err):
+		default:
+			return nil, sdkerrors.Wrap(err, "load vote")
+		}
+	}
+
+	if err := s.doVote(ctx, req.ProposalId, req.Voter, req.Choice, req.Option, req.Metadata, req.Nonce); err != nil {
+		return nil, err
+	}
+
+	// TODO: add event #215
+
+	return &group.MsgVoteResponse{}, nil
+}
+
+// ChangeVote replaces the vote of a voter on a proposal that is still open for
+// voting and records the change. The old vote is subtracted from the tally with
+// the weight it was counted with, the new one is counted like a first vote.
+func (s serverImpl) ChangeVote(ctx types.Context, req *group.MsgChangeVoteRequest) (*group.MsgChangeVoteResponse, error) {
+	if err := assertMetadataLength(req.Metadata, s.maxMetadataLength(ctx), "metadata"); err != nil {
+		return nil, err
+	}
+	changedAt, err := gogotypes.TimestampProto(ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	var oldVote group.Vote
+	if err := s.voteTable.GetOne(ctx, group.VoteNaturalKey(req.ProposalId, req.Voter), &oldVote); err != nil {
+		return nil, sdkerrors.Wrap(err, "load vote")
+	}
+	if oldVote.Choice == req.Choice && oldVote.Option == req.Option {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "vote not changed")
+	}
+
+	proposal, _, electorate, err := s.getVotableProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	voter := group.GroupMember{GroupId: electorate.GroupId, Member: &group.Member{Address: req.Voter}}
+	if err := s.groupMemberTable.GetOne(ctx, voter.NaturalKey(), &voter); err != nil {
+		return nil, sdkerrors.Wrapf(err, "address: %s", req.Voter)
+	}
+	oldWeight := oldVote.Weight
+	if oldWeight == "" {
+		weight, err := electorate.EffectiveWeight(*voter.Member)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "address: %s", req.Voter)
+		}
+		oldWeight = math.DecimalString(weight)
+	}
+	if err := proposal.VoteState.Sub(oldVote, oldWeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "subtract old vote")
+	}
+	if voter.Member.Role != "" {
+		if err := proposal.VoteState.SubRoleVote(voter.Member.Role, oldVote, oldWeight); err != nil {
+			return nil, sdkerrors.Wrap(err, "subtract old vote from role tally")
+		}
+	}
+	if err := s.voteTable.Delete(ctx, &oldVote); err != nil {
+		return nil, sdkerrors.Wrap(err, "delete old vote")
+	}
+	if err := s.proposalTable.Save(ctx, req.ProposalId.Uint64(), &proposal); err != nil {
 		return nil, err
 	}
 
+	if err := s.doVote(ctx, req.ProposalId, req.Voter, req.Choice, req.Option, req.Metadata, nil); err != nil {
+		return nil, err
+	}
+
+	_, err = s.voteChangeTable.Create(ctx, &group.VoteChange{
+		ProposalId: req.ProposalId,
+		Voter:      req.Voter,
+		OldChoice:  oldVote.Choice,
+		NewChoice:  req.Choice,
+		ChangedAt:  *changedAt,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "store vote change")
+	}
+
+	return &group.MsgChangeVoteResponse{}, nil
+}
+
+// CommitVote stores a hidden vote commitment for a proposal. The commitment
+// is not counted until it is revealed using RevealVote. Commitments that weren't
+// revealed are deleted once the proposal is finalized or archived.
+func (s serverImpl) CommitVote(ctx types.Context, req *group.MsgCommitVoteRequest) (*group.MsgCommitVoteResponse, error) {
+	id := req.ProposalId
+	voterAddr := req.Voter
+
 	blockTime, err := gogotypes.TimestampProto(ctx.BlockTime())
 	if err != nil {
 		return nil, err
 	}
+
+	_, _, electorate, err := s.getVotableProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	voter := group.GroupMember{GroupId: electorate.GroupId, Member: &group.Member{Address: voterAddr}}
+	if !s.groupMemberTable.Has(ctx, voter.NaturalKey()) {
+		return nil, sdkerrors.Wrapf(group.ErrNotGroupMember, "voter %s", voterAddr)
+	}
+	if s.voteTable.Has(ctx, group.VoteNaturalKey(id, voterAddr)) {
+		return nil, sdkerrors.Wrap(group.ErrDuplicate, "voted already")
+	}
+
+	// The ORM will return an error if the commitment already exists.
+	err = s.voteCommitmentTable.Create(ctx, &group.VoteCommitment{
+		ProposalId:  id,
+		Voter:       voterAddr,
+		CommitHash:  req.CommitHash,
+		SubmittedAt: *blockTime,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "store vote commitment")
+	}
+
+	return &group.MsgCommitVoteResponse{}, nil
+}
+
+// RevealVote reveals a previously committed vote. The vote is only counted
+// when the revealed choice and salt match the stored commitment.
+func (s serverImpl) RevealVote(ctx types.Context, req *group.MsgRevealVoteRequest) (*group.MsgRevealVoteResponse, error) {
+	if err := assertMetadataLength(req.Metadata, s.maxMetadataLength(ctx), "metadata"); err != nil {
+		return nil, err
+	}
+
+	commitment := group.VoteCommitment{ProposalId: req.ProposalId, Voter: req.Voter}
+	if err := s.voteCommitmentTable.GetOne(ctx, commitment.NaturalKey(), &commitment); err != nil {
+		return nil, sdkerrors.Wrap(err, "load vote commitment")
+	}
+	if !commitment.Matches(req.Choice, req.Salt) {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "vote does not match commitment")
+	}
+	if err := s.voteCommitmentTable.Delete(ctx, &commitment); err != nil {
+		return nil, sdkerrors.Wrap(err, "delete vote commitment")
+	}
+
+	if err := s.doVote(ctx, req.ProposalId, req.Voter, req.Choice, 0, req.Metadata, nil); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgRevealVoteResponse{}, nil
+}
+
+// getVotableProposal loads a proposal together with its group account and group
+// and makes sure that the proposal can still accept votes.
+func (s serverImpl) getVotableProposal(ctx types.Context, id group.ProposalID) (group.Proposal, group.GroupAccountInfo, group.GroupInfo, error) {
 	proposal, err := s.getProposal(ctx, id)
 	if err != nil {
-		return nil, err
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, err
 	}
 	// Ensure that we can still accept votes for this proposal.
 	if proposal.Status != group.ProposalStatusSubmitted {
-		return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal not open for voting")
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, sdkerrors.Wrap(group.ErrProposalFinal, "proposal not open for voting")
+	}
+	if proposal.Paused() {
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, sdkerrors.Wrap(group.ErrInvalid, "proposal is paused")
 	}
 	votingPeriodEnd, err := gogotypes.TimestampFromProto(&proposal.Timeout)
 	if err != nil {
-		return nil, err
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, err
 	}
 	if votingPeriodEnd.Before(ctx.BlockTime()) || votingPeriodEnd.Equal(ctx.BlockTime()) {
-		return nil, sdkerrors.Wrap(group.ErrExpired, "voting period has ended already")
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, sdkerrors.Wrap(group.ErrExpired, "voting period has ended already")
+	}
+	votingStart, err := proposal.VotingStart()
+	if err != nil {
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, err
+	}
+	if votingStart.After(ctx.BlockTime()) {
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, sdkerrors.Wrap(group.ErrInvalid, "voting period has not started yet")
 	}
 
 	var accountInfo group.GroupAccountInfo
@@ -411,29 +1267,63 @@ func (s serverImpl) Vote(ctx types.Context, req *group.MsgVoteRequest) (*group.M
 	// Ensure that group account hasn't been modified since the proposal submission.
 	address, err := sdk.AccAddressFromBech32(proposal.GroupAccount)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "group account")
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, sdkerrors.Wrap(err, "group account")
 	}
 	if err := s.groupAccountTable.GetOne(ctx, address.Bytes(), &accountInfo); err != nil {
-		return nil, sdkerrors.Wrap(err, "load group account")
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, sdkerrors.Wrap(err, "load group account")
 	}
 	if proposal.GroupAccountVersion != accountInfo.Version {
-		return nil, sdkerrors.Wrap(group.ErrModified, "group account was modified")
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, sdkerrors.Wrap(group.ErrModified, "group account was modified")
 	}
 
 	// Ensure that group hasn't been modified since the proposal submission.
 	electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
 	if err != nil {
-		return nil, err
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, err
 	}
 	if electorate.Version != proposal.GroupVersion {
-		return nil, sdkerrors.Wrap(group.ErrModified, "group was modified")
+		return group.Proposal{}, group.GroupAccountInfo{}, group.GroupInfo{}, sdkerrors.Wrap(group.ErrModified, "group was modified")
+	}
+
+	return proposal, accountInfo, electorate, nil
+}
+
+// doVote counts and stores a vote on an open proposal and runs the tally
+// to close the proposal early when possible.
+func (s serverImpl) doVote(ctx types.Context, id group.ProposalID, voterAddr string, choice group.Choice, option uint32, metadata, nonce []byte) error {
+	blockTime, err := gogotypes.TimestampProto(ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	proposal, accountInfo, electorate, err := s.getVotableProposal(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Proposals with an option set are voted on by option, all others by yes/no.
+	if proposal.OptionSet != nil {
+		if choice != group.Choice_CHOICE_OPTION && choice != group.Choice_CHOICE_ABSTAIN && choice != group.Choice_CHOICE_PRESENT {
+			return sdkerrors.Wrapf(group.ErrInvalid, "choice %s not supported for proposal with option set", choice)
+		}
+	} else if choice == group.Choice_CHOICE_OPTION {
+		return sdkerrors.Wrap(group.ErrInvalid, "proposal has no option set")
 	}
 
 	// Count and store votes.
-	voterAddr := req.Voter
 	voter := group.GroupMember{GroupId: electorate.GroupId, Member: &group.Member{Address: voterAddr}}
 	if err := s.groupMemberTable.GetOne(ctx, voter.NaturalKey(), &voter); err != nil {
-		return nil, sdkerrors.Wrapf(err, "address: %s", voterAddr)
+		return sdkerrors.Wrapf(err, "address: %s", voterAddr)
+	}
+	expired, err := voter.Member.Expired(ctx.BlockTime())
+	if err != nil {
+		return sdkerrors.Wrapf(err, "address: %s", voterAddr)
+	}
+	if expired {
+		return sdkerrors.Wrapf(group.ErrExpired, "membership of voter %s", voterAddr)
+	}
+	voterWeight, err := electorate.EffectiveWeight(*voter.Member)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "address: %s", voterAddr)
 	}
 	newVote := group.Vote{
 		ProposalId:  id,
@@ -441,47 +1331,69 @@ func (s serverImpl) Vote(ctx types.Context, req *group.MsgVoteRequest) (*group.M
 		Choice:      choice,
 		Metadata:    metadata,
 		SubmittedAt: *blockTime,
+		Nonce:       nonce,
+		Option:      option,
+		Weight:      math.DecimalString(voterWeight),
 	}
-	if err := proposal.VoteState.Add(newVote, voter.Member.Weight); err != nil {
-		return nil, sdkerrors.Wrap(err, "add new vote")
+	if err := proposal.VoteState.Add(newVote, newVote.Weight); err != nil {
+		return sdkerrors.Wrap(err, "add new vote")
+	}
+	if voter.Member.Role != "" {
+		if err := proposal.VoteState.AddRoleVote(voter.Member.Role, newVote, newVote.Weight); err != nil {
+			return sdkerrors.Wrap(err, "add new vote to role tally")
+		}
 	}
 
 	// The ORM will return an error if the vote already exists,
 	// making sure than a voter hasn't already voted.
 	if err := s.voteTable.Create(ctx, &newVote); err != nil {
-		return nil, sdkerrors.Wrap(err, "store vote")
+		return sdkerrors.Wrap(err, "store vote")
+	}
+	if err := s.recordActivity(ctx, electorate, voterAddr); err != nil {
+		return err
 	}
 
 	// Run tally with new votes to close early.
-	if err := doTally(ctx, &proposal, electorate, accountInfo); err != nil {
-		return nil, err
+	if err := s.doTally(ctx, id, &proposal, electorate, accountInfo); err != nil {
+		return err
 	}
-
-	if err = s.proposalTable.Save(ctx, id.Uint64(), &proposal); err != nil {
-		return nil, err
+	if err := s.pruneFinalizedVotes(ctx, id, &proposal, electorate); err != nil {
+		return err
 	}
 
-	// TODO: add event #215
-
-	return &group.MsgVoteResponse{}, nil
+	return s.proposalTable.Save(ctx, id.Uint64(), &proposal)
 }
 
 // doTally updates the proposal status and tally if necessary based on the group account's decision policy.
-func doTally(ctx types.Context, p *group.Proposal, electorate group.GroupInfo, accountInfo group.GroupAccountInfo) error {
-	policy := accountInfo.GetDecisionPolicy()
-	submittedAt, err := gogotypes.TimestampFromProto(&p.SubmittedAt)
+func (s serverImpl) doTally(ctx types.Context, id group.ProposalID, p *group.Proposal, electorate group.GroupInfo, accountInfo group.GroupAccountInfo) error {
+	// The result is persisted on the first finalization and never re-evaluated,
+	// so that later membership changes can't affect it.
+	if _, ok := p.CachedResult(); ok {
+		return nil
+	}
+	policy, err := s.proposalPolicy(*p, accountInfo)
 	if err != nil {
 		return err
 	}
-	switch result, err := policy.Allow(p.VoteState, electorate.TotalWeight, ctx.BlockTime().Sub(submittedAt)); {
-	case err != nil:
+	votingDuration, err := p.VotingDuration(ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	tally := p.VoteState
+	// Conviction grows with time, so it is recomputed from the votes on every tally.
+	if conviction, ok := policy.(*group.ConvictionDecisionPolicy); ok {
+		if tally, err = s.convictionTally(ctx, id, *p, electorate, *conviction); err != nil {
+			return sdkerrors.Wrap(err, "conviction tally")
+		}
+	}
+	status, result, reason, err := group.DeriveStatus(tally, policy, electorate.TotalWeight, votingDuration)
+	if err != nil {
 		return sdkerrors.Wrap(err, "policy execution")
-	case result.Allow && result.Final:
-		p.Result = group.ProposalResultAccepted
-		p.Status = group.ProposalStatusClosed
-	case !result.Allow && result.Final:
-		p.Result = group.ProposalResultRejected
-		p.Status = group.ProposalStatusClosed
+	}
+	if status != group.ProposalStatusSubmitted {
+		p.Status = status
+		p.Result = result
+		p.ResultReason = reason
 	}
 	return nil
 }
@@ -496,7 +1408,13 @@ func (s serverImpl) Exec(ctx types.Context, req *group.MsgExecRequest) (*group.M
 	}
 
 	if proposal.Status != group.ProposalStatusSubmitted && proposal.Status != group.ProposalStatusClosed {
-		return nil, sdkerrors.Wrapf(group.ErrInvalid, "not possible with proposal status %s", proposal.Status.String())
+		return nil, sdkerrors.Wrapf(group.ErrProposalFinal, "not possible with proposal status %s", proposal.Status.String())
+	}
+	if proposal.Paused() {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal is paused")
+	}
+	if proposal.ExecutorResult == group.ProposalExecutorResultPermanentFailure {
+		return nil, sdkerrors.Wrap(group.ErrProposalFinal, "proposal execution failed permanently")
 	}
 
 	var accountInfo group.GroupAccountInfo
@@ -519,7 +1437,11 @@ func (s serverImpl) Exec(ctx types.Context, req *group.MsgExecRequest) (*group.M
 		// Ensure that group account hasn't been modified before tally.
 		if proposal.GroupAccountVersion != accountInfo.Version {
 			proposal.Result = group.ProposalResultUnfinalized
+			proposal.ResultReason = group.ResultReasonGroupAccountModified
 			proposal.Status = group.ProposalStatusAborted
+			if err := s.deleteVoteCommitments(ctx, id); err != nil {
+				return nil, err
+			}
 			return storeUpdates()
 		}
 
@@ -531,31 +1453,25 @@ func (s serverImpl) Exec(ctx types.Context, req *group.MsgExecRequest) (*group.M
 		// Ensure that group hasn't been modified before tally.
 		if electorate.Version != proposal.GroupVersion {
 			proposal.Result = group.ProposalResultUnfinalized
+			proposal.ResultReason = group.ResultReasonGroupModified
 			proposal.Status = group.ProposalStatusAborted
+			if err := s.deleteVoteCommitments(ctx, id); err != nil {
+				return nil, err
+			}
 			return storeUpdates()
 		}
-		if err := doTally(ctx, &proposal, electorate, accountInfo); err != nil {
+		if err := s.doTally(ctx, id, &proposal, electorate, accountInfo); err != nil {
+			return nil, err
+		}
+		if err := s.pruneFinalizedVotes(ctx, id, &proposal, electorate); err != nil {
 			return nil, err
 		}
 	}
 
 	// Execute proposal payload.
 	if proposal.Status == group.ProposalStatusClosed && proposal.Result == group.ProposalResultAccepted && proposal.ExecutorResult != group.ProposalExecutorResultSuccess {
-		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
-		// Cashing context so that we don't update the store in case of failure.
-		ctx, flush := ctx.CacheContext()
-		address, err := sdk.AccAddressFromBech32(accountInfo.GroupAccount)
-		if err != nil {
-			return nil, sdkerrors.Wrap(err, "group account")
-		}
-		_, err = DoExecuteMsgs(ctx, s.router, address, proposal.GetMsgs())
-		if err != nil {
-			proposal.ExecutorResult = group.ProposalExecutorResultFailure
-			proposalType := reflect.TypeOf(proposal).String()
-			logger.Info("proposal execution failed", "cause", err, "type", proposalType, "proposalID", id)
-		} else {
-			proposal.ExecutorResult = group.ProposalExecutorResultSuccess
-			flush()
+		if err := s.execProposalMsgs(ctx, id, &proposal, accountInfo); err != nil {
+			return nil, err
 		}
 	}
 
@@ -569,6 +1485,214 @@ func (s serverImpl) Exec(ctx types.Context, req *group.MsgExecRequest) (*group.M
 	return res, nil
 }
 
+// execProposalMsgs executes the messages of an accepted proposal on behalf of
+// its group account and records the executor result on the proposal.
+func (s serverImpl) execProposalMsgs(ctx types.Context, id group.ProposalID, proposal *group.Proposal, accountInfo group.GroupAccountInfo) error {
+	logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
+	address, err := sdk.AccAddressFromBech32(accountInfo.GroupAccount)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+	sends := groupAccountSends(address, proposal.GetMsgs())
+	// Fail early with a descriptive cause when the group account can't cover its bank sends.
+	if err := s.ensureSufficientBalance(ctx.Context, address, sends); err != nil {
+		if !sdkerrors.ErrInsufficientFunds.Is(err) {
+			return err
+		}
+		// Funds may be added later so the proposal stays executable.
+		proposal.ExecutorResult = group.ProposalExecutorResultFailure
+		logger.Info("proposal execution failed", "cause", err, "proposalID", id)
+		return nil
+	}
+	spend, err := s.spendWithinLimit(ctx, accountInfo, sends)
+	if err != nil {
+		return err
+	}
+	// Cashing context so that we don't update the store in case of failure.
+	cacheCtx, flush := ctx.CacheContext()
+	_, err = DoExecuteMsgs(cacheCtx, s.router, address, proposal.GetMsgs())
+	if err == nil && len(proposal.MemberUpdates) != 0 {
+		err = s.applyMemberUpdates(types.Context{Context: cacheCtx}, accountInfo, proposal.MemberUpdates)
+	}
+	if err != nil {
+		proposal.ExecutorResult = group.ProposalExecutorResultFailure
+		proposalType := reflect.TypeOf(*proposal).String()
+		logger.Info("proposal execution failed", "cause", err, "type", proposalType, "proposalID", id)
+		return nil
+	}
+	proposal.ExecutorResult = group.ProposalExecutorResultSuccess
+	flush()
+	if spend != nil {
+		return s.saveGroupAccountSpend(ctx, spend)
+	}
+	return nil
+}
+
+// applyMemberUpdates updates the members of the group of the group account on the
+// execution of a membership proposal. The update fails if the decision policy of
+// any group account of the group can't be satisfied by the updated group.
+func (s serverImpl) applyMemberUpdates(ctx types.Context, accountInfo group.GroupAccountInfo, updates []group.Member) error {
+	g, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return err
+	}
+	if err := s.updateGroupMembers(ctx, &g, updates); err != nil {
+		return sdkerrors.Wrap(err, "members updated")
+	}
+	if err := s.validateGroupAccountPolicies(ctx, g); err != nil {
+		return err
+	}
+	groupIDStr := util.Uint64ToBase58Check(g.GroupId.Uint64())
+	return ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroup{GroupId: groupIDStr})
+}
+
+// AdminOverride lets the group account admin execute or cancel a submitted
+// proposal without a vote. Every override is recorded as an EventAdminOverride.
+func (s serverImpl) AdminOverride(ctx types.Context, req *group.MsgAdminOverrideRequest) (*group.MsgAdminOverrideResponse, error) {
+	id := req.ProposalId
+
+	proposal, accountInfo, err := s.getSubmittedProposalForAdmin(ctx, id, req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case group.OverrideAction_OVERRIDE_ACTION_EXECUTE:
+		proposal.Status = group.ProposalStatusClosed
+		proposal.Result = group.ProposalResultAccepted
+		proposal.ResultReason = group.ResultReasonAdminExecuted
+		if err := s.execProposalMsgs(ctx, id, &proposal, accountInfo); err != nil {
+			return nil, err
+		}
+	case group.OverrideAction_OVERRIDE_ACTION_CANCEL:
+		proposal.Status = group.ProposalStatusAborted
+		proposal.Result = group.ProposalResultUnfinalized
+		proposal.ResultReason = group.ResultReasonAdminCancelled
+	default:
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "override action %s", req.Action)
+	}
+	electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group")
+	}
+	if err := s.pruneFinalizedVotes(ctx, id, &proposal, electorate); err != nil {
+		return nil, err
+	}
+
+	if err := s.proposalTable.Save(ctx, id.Uint64(), &proposal); err != nil {
+		return nil, err
+	}
+
+	blockTime, err := gogotypes.TimestampProto(ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+	err = ctx.EventManager().EmitTypedEvent(&group.EventAdminOverride{
+		ProposalId: id.Uint64(),
+		Admin:      req.Admin,
+		Action:     req.Action,
+		Timestamp:  *blockTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgAdminOverrideResponse{}, nil
+}
+
+// PauseProposal lets the group account admin pause a submitted proposal. Votes
+// and execution are rejected and the voting duration doesn't advance while the
+// proposal is paused.
+func (s serverImpl) PauseProposal(ctx types.Context, req *group.MsgPauseProposalRequest) (*group.MsgPauseProposalResponse, error) {
+	proposal, _, err := s.getSubmittedProposalForAdmin(ctx, req.ProposalId, req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Paused() {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal is paused already")
+	}
+	timeout, err := gogotypes.TimestampFromProto(&proposal.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	if !ctx.BlockTime().Before(timeout) {
+		return nil, sdkerrors.Wrap(group.ErrExpired, "voting period has ended already")
+	}
+
+	pausedAt, err := gogotypes.TimestampProto(ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+	proposal.PausedAt = pausedAt
+	if err := s.proposalTable.Save(ctx, req.ProposalId.Uint64(), &proposal); err != nil {
+		return nil, err
+	}
+	return &group.MsgPauseProposalResponse{}, nil
+}
+
+// ResumeProposal lets the group account admin resume a paused proposal. The
+// proposal timeout is shifted by the time the proposal was paused for.
+func (s serverImpl) ResumeProposal(ctx types.Context, req *group.MsgResumeProposalRequest) (*group.MsgResumeProposalResponse, error) {
+	proposal, _, err := s.getSubmittedProposalForAdmin(ctx, req.ProposalId, req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if !proposal.Paused() {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal is not paused")
+	}
+
+	pausedAt, err := gogotypes.TimestampFromProto(proposal.PausedAt)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := gogotypes.TimestampFromProto(&proposal.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	pausedDuration, err := gogotypes.DurationFromProto(&proposal.PausedDuration)
+	if err != nil {
+		return nil, err
+	}
+	pause := ctx.BlockTime().Sub(pausedAt)
+
+	newTimeout, err := gogotypes.TimestampProto(timeout.Add(pause))
+	if err != nil {
+		return nil, err
+	}
+	proposal.Timeout = *newTimeout
+	proposal.PausedDuration = *gogotypes.DurationProto(pausedDuration + pause)
+	proposal.PausedAt = nil
+	if err := s.proposalTable.Save(ctx, req.ProposalId.Uint64(), &proposal); err != nil {
+		return nil, err
+	}
+	return &group.MsgResumeProposalResponse{}, nil
+}
+
+// getSubmittedProposalForAdmin loads a submitted proposal and its group account
+// and checks that admin is the group account admin.
+func (s serverImpl) getSubmittedProposalForAdmin(ctx types.Context, id group.ProposalID, admin string) (group.Proposal, group.GroupAccountInfo, error) {
+	proposal, err := s.getProposal(ctx, id)
+	if err != nil {
+		return group.Proposal{}, group.GroupAccountInfo{}, err
+	}
+	if proposal.Status != group.ProposalStatusSubmitted {
+		return group.Proposal{}, group.GroupAccountInfo{}, sdkerrors.Wrapf(group.ErrProposalFinal, "not possible with proposal status %s", proposal.Status.String())
+	}
+
+	address, err := sdk.AccAddressFromBech32(proposal.GroupAccount)
+	if err != nil {
+		return group.Proposal{}, group.GroupAccountInfo{}, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, address)
+	if err != nil {
+		return group.Proposal{}, group.GroupAccountInfo{}, sdkerrors.Wrap(err, "load group account")
+	}
+	if accountInfo.Admin != admin {
+		return group.Proposal{}, group.GroupAccountInfo{}, sdkerrors.Wrap(group.ErrUnauthorized, "not group account admin")
+	}
+	return proposal, accountInfo, nil
+}
+
 type authNGroupReq interface {
 	GetGroupID() group.ID
 	GetAdmin() string
@@ -622,6 +1746,192 @@ func (s serverImpl) maxMetadataLength(ctx types.Context) int {
 	return group.MaxMetadataLength
 }
 
+// revokeVotes subtracts the votes of the removed member from the tallies of the
+// open proposals of the group's accounts and deletes these votes. Each vote is
+// subtracted with the weight it was counted with, the given weight is only used
+// for votes stored without their weight.
+func (s serverImpl) revokeVotes(ctx types.Context, g group.GroupInfo, member group.Member, weight *apd.Decimal) error {
+	weightStr := math.DecimalString(weight)
+	return s.updateOpenVotes(ctx, g, member.Address, func(vote group.Vote, proposal *group.Proposal) error {
+		voteWeight := vote.Weight
+		if voteWeight == "" {
+			voteWeight = weightStr
+		}
+		if err := proposal.VoteState.Sub(vote, voteWeight); err != nil {
+			return err
+		}
+		if member.Role != "" {
+			if err := proposal.VoteState.SubRoleVote(member.Role, vote, voteWeight); err != nil {
+				return err
+			}
+		}
+		if err := s.voteTable.Delete(ctx, &vote); err != nil {
+			return sdkerrors.Wrap(err, "delete vote")
+		}
+		return nil
+	})
+}
+
+// updateOpenVotes calls update with each vote of the voter on an open proposal of
+// the group's accounts and saves the proposal afterwards.
+func (s serverImpl) updateOpenVotes(ctx types.Context, g group.GroupInfo, voter string, update func(vote group.Vote, proposal *group.Proposal) error) error {
+	addr, err := sdk.AccAddressFromBech32(voter)
+	if err != nil {
+		return err
+	}
+	it, err := s.voteByVoterIndex.Get(ctx, addr.Bytes())
+	if err != nil {
+		return err
+	}
+	var votes []group.Vote
+	_, err = orm.ReadAll(it, &votes)
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range votes {
+		proposal, err := s.getProposal(ctx, vote.ProposalId)
+		if err != nil {
+			return err
+		}
+		if proposal.Status != group.ProposalStatusSubmitted {
+			continue
+		}
+		accountAddr, err := sdk.AccAddressFromBech32(proposal.GroupAccount)
+		if err != nil {
+			return sdkerrors.Wrap(err, "group account")
+		}
+		accountInfo, err := s.getGroupAccountInfo(ctx, accountAddr)
+		if err != nil {
+			return err
+		}
+		if accountInfo.GroupId != g.GroupId {
+			continue
+		}
+
+		if err := update(vote, &proposal); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d", vote.ProposalId)
+		}
+		if err := s.proposalTable.Save(ctx, vote.ProposalId.Uint64(), &proposal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assertMinMembersForProposals returns an error if the group has fewer members
+// than required to submit proposals.
+func (s serverImpl) assertMinMembersForProposals(ctx types.Context, groupID group.ID) error {
+	if s.minMembersForProposals == 0 {
+		return nil
+	}
+	it, err := s.groupMemberByGroupIndex.Get(ctx, groupID.Uint64())
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var count uint64
+	for ; count < s.minMembersForProposals; count++ {
+		_, err := it.LoadNext(&group.GroupMember{})
+		if orm.ErrIteratorDone.Is(err) {
+			return sdkerrors.Wrapf(group.ErrInvalid, "group has %d members, at least %d required to submit proposals", count, s.minMembersForProposals)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assertMaxOpenProposals returns an error if the group already has the maximum
+// number of open proposals.
+func (s serverImpl) assertMaxOpenProposals(ctx types.Context, groupID group.ID) error {
+	if s.maxOpenProposals == 0 {
+		return nil
+	}
+	count, err := s.countOpenProposals(ctx, groupID, s.maxOpenProposals)
+	if err != nil {
+		return err
+	}
+	if count >= s.maxOpenProposals {
+		return sdkerrors.Wrapf(group.ErrMaxLimit, "group has %d open proposals, at most %d allowed", count, s.maxOpenProposals)
+	}
+	return nil
+}
+
+// assertWeightPrecision returns an error if the weight has more decimal places
+// than the configured weight precision.
+func (s serverImpl) assertWeightPrecision(weight *apd.Decimal) error {
+	if s.weightPrecision == 0 {
+		return nil
+	}
+	if n := math.NumDecimalPlaces(weight); n > s.weightPrecision {
+		return sdkerrors.Wrapf(group.ErrInvalid, "weight %s has %d decimal places, at most %d allowed", math.DecimalString(weight), n, s.weightPrecision)
+	}
+	return nil
+}
+
+// assertDecisionPolicyTypeAllowed returns an error if the decision policy type URL
+// isn't one of the allowed decision policy types.
+func (s serverImpl) assertDecisionPolicyTypeAllowed(typeURL string) error {
+	if len(s.allowedDecisionPolicyTypes) == 0 {
+		return nil
+	}
+	for _, allowed := range s.allowedDecisionPolicyTypes {
+		if typeURL == allowed {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(group.ErrInvalid, "decision policy type %s not allowed", typeURL)
+}
+
+// assertTimeoutGranularity returns an error if the timeout of the decision policy
+// isn't a multiple of the timeout granularity.
+func (s serverImpl) assertTimeoutGranularity(policy group.DecisionPolicy) error {
+	if s.timeoutGranularity == 0 {
+		return nil
+	}
+	timeout := policy.GetTimeout()
+	d, err := gogotypes.DurationFromProto(&timeout)
+	if err != nil {
+		return sdkerrors.Wrap(err, "timeout")
+	}
+	if d%s.timeoutGranularity != 0 {
+		return sdkerrors.Wrapf(group.ErrInvalid, "timeout %s is not a multiple of %s", d, s.timeoutGranularity)
+	}
+	return nil
+}
+
+// assertGroupCanReachThreshold returns an error if the decision policy is a threshold
+// policy and the group has no weight, as a positive threshold could never be met.
+func assertGroupCanReachThreshold(g group.GroupInfo, policy group.DecisionPolicy) error {
+	if _, ok := policy.(*group.ThresholdDecisionPolicy); !ok {
+		return nil
+	}
+	totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+	if totalWeight.IsZero() {
+		return sdkerrors.Wrap(group.ErrInvalidThreshold, "threshold policy on a group with zero total weight")
+	}
+	return nil
+}
+
+// assertGroupName returns an error if group names are required and the given
+// group metadata, which holds the group's name, is empty or blank. It is checked
+// where groups are created, imported or renamed rather than in
+// GroupInfo.ValidateBasic: the requirement is a setting of the module, and the
+// ORM validates every saved group, so unnamed groups which existed before the
+// setting was turned on could no longer be updated otherwise.
+func (s serverImpl) assertGroupName(metadata []byte) error {
+	if s.requireGroupName && len(bytes.TrimSpace(metadata)) == 0 {
+		return sdkerrors.Wrap(group.ErrEmpty, "group name")
+	}
+	return nil
+}
+
 // assertMetadataLength returns an error if given metadata length
 // is greater than a fixed maxMetadataLength.
 func assertMetadataLength(metadata []byte, maxMetadataLength int, description string) error {
@@ -0,0 +1,437 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestAmendProposal(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+	s.proposalEditingWindow = time.Minute
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member := sdk.AccAddress([]byte("member-address-_____")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: member, Weight: "1"}, {Address: admin, Weight: "1"}},
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 600})))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+
+	createProposal := func() group.ProposalID {
+		res, err := s.CreateProposal(ctxAt(0), &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{member},
+			Metadata:     []byte("original"),
+		})
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+	amend := func(ctx types.Context, id group.ProposalID, proposer string) (*group.MsgAmendProposalResponse, error) {
+		req := &group.MsgAmendProposalRequest{ProposalId: id, Proposer: proposer, Metadata: []byte("amended")}
+		require.NoError(t, req.SetMsgs([]sdk.Msg{&testdata.TestMsg{Signers: []string{accountRes.GroupAccount}}}))
+		return s.AmendProposal(ctx, req)
+	}
+
+	t.Run("within the editing window", func(t *testing.T) {
+		id := createProposal()
+		res, err := amend(ctxAt(30*time.Second), id, member)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), res.Revision)
+		res, err = amend(ctxAt(59*time.Second), id, member)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), res.Revision)
+
+		p, err := s.getProposal(ctxAt(0), id)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), p.Revision)
+		assert.Equal(t, []byte("amended"), p.Metadata)
+		require.Len(t, p.GetMsgs(), 1)
+	})
+	t.Run("by a non proposer", func(t *testing.T) {
+		id := createProposal()
+		_, err := amend(ctxAt(0), id, admin)
+		assert.True(t, group.ErrUnauthorized.Is(err))
+	})
+	t.Run("after a vote", func(t *testing.T) {
+		id := createProposal()
+		_, err := s.Vote(ctxAt(time.Second), &group.MsgVoteRequest{ProposalId: id, Voter: member, Choice: group.Choice_CHOICE_YES})
+		require.NoError(t, err)
+		_, err = amend(ctxAt(2*time.Second), id, member)
+		assert.True(t, group.ErrInvalid.Is(err))
+
+		p, err := s.getProposal(ctxAt(0), id)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), p.Revision)
+		assert.Equal(t, []byte("original"), p.Metadata)
+	})
+	t.Run("after the editing window", func(t *testing.T) {
+		id := createProposal()
+		_, err := amend(ctxAt(time.Minute), id, member)
+		assert.True(t, group.ErrExpired.Is(err))
+	})
+	t.Run("disabled", func(t *testing.T) {
+		id := createProposal()
+		s.proposalEditingWindow = 0
+		defer func() { s.proposalEditingWindow = time.Minute }()
+		_, err := amend(ctxAt(0), id, member)
+		assert.True(t, group.ErrInvalid.Is(err))
+	})
+}
+
+func TestFastTrackProposal(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+	s.fastTrackWindow = time.Minute
+	s.fastTrackPercentage = "0.75"
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	members := []string{
+		sdk.AccAddress([]byte("member-address-1____")).String(),
+		sdk.AccAddress([]byte("member-address-2____")).String(),
+		sdk.AccAddress([]byte("member-address-3____")).String(),
+		sdk.AccAddress([]byte("member-address-4____")).String(),
+	}
+	groupReq := &group.MsgCreateGroupRequest{Admin: admin}
+	for _, m := range members {
+		groupReq.Members = append(groupReq.Members, group.Member{Address: m, Weight: "1"})
+	}
+	groupRes, err := s.CreateGroup(ctxAt(0), groupReq)
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 600})))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+
+	createProposal := func(fastTrack bool) group.ProposalID {
+		res, err := s.CreateProposal(ctxAt(0), &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{members[0]},
+			FastTrack:    fastTrack,
+		})
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+	voteYes := func(id group.ProposalID, voters ...string) {
+		for _, voter := range voters {
+			_, err := s.Vote(ctxAt(time.Second), &group.MsgVoteRequest{ProposalId: id, Voter: voter, Choice: group.Choice_CHOICE_YES})
+			require.NoError(t, err)
+		}
+	}
+	tallyAt := func(d time.Duration, id group.ProposalID) group.Proposal {
+		p, err := s.getProposal(ctxAt(0), id)
+		require.NoError(t, err)
+		accountAddress, err := sdk.AccAddressFromBech32(accountRes.GroupAccount)
+		require.NoError(t, err)
+		accountInfo, err := s.getGroupAccountInfo(ctxAt(0), accountAddress)
+		require.NoError(t, err)
+		electorate, err := s.getGroupInfo(ctxAt(0), groupRes.GroupId)
+		require.NoError(t, err)
+		require.NoError(t, s.doTally(ctxAt(d), id, &p, electorate, accountInfo))
+		return p
+	}
+
+	t.Run("fast track uses the short timeout", func(t *testing.T) {
+		id := createProposal(true)
+		p, err := s.getProposal(ctxAt(0), id)
+		require.NoError(t, err)
+		assert.True(t, p.FastTrack)
+		assert.Equal(t, gogotypes.Timestamp{Seconds: 1060}, p.Timeout)
+
+		// Two yes votes would pass the threshold of the group account but not the
+		// fast-track percentage, and the proposal expires after the fast-track window.
+		voteYes(id, members[0], members[1])
+		p = tallyAt(time.Second, id)
+		assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+		p = tallyAt(time.Minute, id)
+		assert.Equal(t, group.ProposalStatusClosed, p.Status)
+		assert.Equal(t, group.ProposalResultRejected, p.Result)
+		assert.Equal(t, group.ResultReasonPercentageNotReachable, p.ResultReason)
+	})
+	t.Run("fast track accepted with elevated bar", func(t *testing.T) {
+		id := createProposal(true)
+		voteYes(id, members[0], members[1], members[2])
+		p, err := s.getProposal(ctxAt(0), id)
+		require.NoError(t, err)
+		assert.Equal(t, group.ProposalStatusClosed, p.Status)
+		assert.Equal(t, group.ProposalResultAccepted, p.Result)
+		assert.Equal(t, group.ResultReasonPercentageReached, p.ResultReason)
+	})
+	t.Run("normal proposal unaffected", func(t *testing.T) {
+		id := createProposal(false)
+		p, err := s.getProposal(ctxAt(0), id)
+		require.NoError(t, err)
+		assert.False(t, p.FastTrack)
+		assert.Equal(t, gogotypes.Timestamp{Seconds: 1600}, p.Timeout)
+
+		voteYes(id, members[0], members[1])
+		p, err = s.getProposal(ctxAt(0), id)
+		require.NoError(t, err)
+		assert.Equal(t, group.ProposalStatusClosed, p.Status)
+		assert.Equal(t, group.ProposalResultAccepted, p.Result)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		s.fastTrackWindow = 0
+		defer func() { s.fastTrackWindow = time.Minute }()
+		_, err := s.CreateProposal(ctxAt(0), &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{members[0]},
+			FastTrack:    true,
+		})
+		assert.True(t, group.ErrInvalid.Is(err))
+	})
+}
+
+func TestDecisionPolicyOverride(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	members := []string{
+		admin,
+		sdk.AccAddress([]byte("member-address-1____")).String(),
+		sdk.AccAddress([]byte("member-address-2____")).String(),
+		sdk.AccAddress([]byte("member-address-3____")).String(),
+	}
+	groupReq := &group.MsgCreateGroupRequest{Admin: admin}
+	for _, m := range members {
+		groupReq.Members = append(groupReq.Members, group.Member{Address: m, Weight: "1"})
+	}
+	groupRes, err := s.CreateGroup(ctx, groupReq)
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 600})))
+	accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+
+	createProposal := func(proposer string, override group.DecisionPolicy) (group.ProposalID, error) {
+		req := &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{proposer},
+		}
+		if override != nil {
+			require.NoError(t, req.SetDecisionPolicy(override))
+		}
+		res, err := s.CreateProposal(ctx, req)
+		if err != nil {
+			return 0, err
+		}
+		return res.ProposalId, nil
+	}
+	voteYes := func(id group.ProposalID, voters ...string) group.Proposal {
+		for _, voter := range voters {
+			_, err := s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: voter, Choice: group.Choice_CHOICE_YES})
+			require.NoError(t, err)
+		}
+		p, err := s.getProposal(ctx, id)
+		require.NoError(t, err)
+		return p
+	}
+
+	defaultID, err := createProposal(members[1], nil)
+	require.NoError(t, err)
+	strictID, err := createProposal(admin, group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 600}))
+	require.NoError(t, err)
+
+	// the default policy accepts with 2 yes votes
+	p := voteYes(defaultID, members[0], members[1])
+	assert.Nil(t, p.GetDecisionPolicy())
+	assert.Equal(t, group.ProposalResultAccepted, p.Result)
+
+	// the stricter override requires a third yes vote
+	p = voteYes(strictID, members[0], members[1])
+	require.NotNil(t, p.GetDecisionPolicy())
+	assert.Equal(t, "3", p.GetDecisionPolicy().(*group.ThresholdDecisionPolicy).Threshold)
+	assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+	p = voteYes(strictID, members[2])
+	assert.Equal(t, group.ProposalStatusClosed, p.Status)
+	assert.Equal(t, group.ProposalResultAccepted, p.Result)
+
+	t.Run("override requires the admin as proposer", func(t *testing.T) {
+		_, err := createProposal(members[1], group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 600}))
+		assert.True(t, sdkerrors.ErrUnauthorized.Is(err))
+	})
+	t.Run("override validated against the group", func(t *testing.T) {
+		_, err := createProposal(admin, group.NewThresholdDecisionPolicy("5", gogotypes.Duration{Seconds: 600}))
+		assert.True(t, group.ErrInvalidThreshold.Is(err))
+	})
+}
+
+func TestMinVetoVoters(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	whale := sdk.AccAddress([]byte("whale-address-______")).String()
+	members := []string{
+		sdk.AccAddress([]byte("member-address-1____")).String(),
+		sdk.AccAddress([]byte("member-address-2____")).String(),
+		sdk.AccAddress([]byte("member-address-3____")).String(),
+	}
+	groupReq := &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: whale, Weight: "5"}},
+	}
+	for _, m := range members {
+		groupReq.Members = append(groupReq.Members, group.Member{Address: m, Weight: "1"})
+	}
+	groupRes, err := s.CreateGroup(ctx, groupReq)
+	require.NoError(t, err)
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold:     "3",
+		Timeout:       gogotypes.Duration{Seconds: 600},
+		VetoThreshold: "2",
+		MinVetoVoters: 2,
+	}
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(policy))
+	accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+
+	createProposal := func() group.ProposalID {
+		res, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{members[0]},
+		})
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+	vote := func(id group.ProposalID, choice group.Choice, voters ...string) group.Proposal {
+		for _, voter := range voters {
+			_, err := s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: voter, Choice: choice})
+			require.NoError(t, err)
+		}
+		p, err := s.getProposal(ctx, id)
+		require.NoError(t, err)
+		return p
+	}
+
+	t.Run("whale alone can't veto", func(t *testing.T) {
+		id := createProposal()
+		p := vote(id, group.Choice_CHOICE_VETO, whale)
+		assert.Equal(t, "5", p.VoteState.VetoCount)
+		assert.Equal(t, uint64(1), p.VoteState.VetoVoters)
+		assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+
+		p = vote(id, group.Choice_CHOICE_YES, members...)
+		assert.Equal(t, group.ProposalStatusClosed, p.Status)
+		assert.Equal(t, group.ProposalResultAccepted, p.Result)
+	})
+	t.Run("veto takes effect with min veto voters", func(t *testing.T) {
+		id := createProposal()
+		p := vote(id, group.Choice_CHOICE_VETO, members[0])
+		assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+
+		p = vote(id, group.Choice_CHOICE_VETO, members[1])
+		assert.Equal(t, uint64(2), p.VoteState.VetoVoters)
+		assert.Equal(t, group.ProposalStatusClosed, p.Status)
+		assert.Equal(t, group.ProposalResultRejected, p.Result)
+		assert.Equal(t, group.ResultReasonVetoed, p.ResultReason)
+	})
+}
+
+func TestVetoAfterThresholdReached(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	members := []string{
+		sdk.AccAddress([]byte("member-address-1____")).String(),
+		sdk.AccAddress([]byte("member-address-2____")).String(),
+		sdk.AccAddress([]byte("member-address-3____")).String(),
+		sdk.AccAddress([]byte("member-address-4____")).String(),
+	}
+	groupReq := &group.MsgCreateGroupRequest{Admin: admin}
+	for _, m := range members {
+		groupReq.Members = append(groupReq.Members, group.Member{Address: m, Weight: "1"})
+	}
+	groupRes, err := s.CreateGroup(ctx, groupReq)
+	require.NoError(t, err)
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold:     "2",
+		Timeout:       gogotypes.Duration{Seconds: 600},
+		VetoThreshold: "2",
+	}
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(policy))
+	accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+	proposalRes, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{members[0]},
+	})
+	require.NoError(t, err)
+	id := proposalRes.ProposalId
+	vote := func(choice group.Choice, voters ...string) group.Proposal {
+		for _, voter := range voters {
+			_, err := s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: voter, Choice: choice})
+			require.NoError(t, err)
+		}
+		p, err := s.getProposal(ctx, id)
+		require.NoError(t, err)
+		return p
+	}
+
+	// the threshold is reached but the remaining members can still veto
+	p := vote(group.Choice_CHOICE_YES, members[0], members[1])
+	assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+
+	p = vote(group.Choice_CHOICE_VETO, members[2], members[3])
+	assert.Equal(t, group.ProposalStatusClosed, p.Status)
+	assert.Equal(t, group.ProposalResultRejected, p.Result)
+	assert.Equal(t, group.ResultReasonVetoed, p.ResultReason)
+}
+
+func TestRequireGroupName(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member := sdk.AccAddress([]byte("member-address-_____")).String()
+	createGroup := func(metadata []byte) (*group.MsgCreateGroupResponse, error) {
+		return s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+			Admin:    admin,
+			Members:  []group.Member{{Address: member, Weight: "1"}},
+			Metadata: metadata,
+		})
+	}
+
+	// groups without name are accepted by default
+	unnamed, err := createGroup(nil)
+	require.NoError(t, err)
+
+	s.requireGroupName = true
+	for _, metadata := range [][]byte{nil, []byte(" \t\n")} {
+		_, err = createGroup(metadata)
+		require.Error(t, err)
+		assert.True(t, group.ErrEmpty.Is(err), err)
+	}
+	named, err := createGroup([]byte("Forest stewards"))
+	require.NoError(t, err)
+
+	_, err = s.UpdateGroupMetadata(ctx, &group.MsgUpdateGroupMetadataRequest{Admin: admin, GroupId: named.GroupId})
+	require.Error(t, err)
+	assert.True(t, group.ErrEmpty.Is(err), err)
+
+	// existing groups without name can be given one
+	_, err = s.UpdateGroupMetadata(ctx, &group.MsgUpdateGroupMetadataRequest{Admin: admin, GroupId: unnamed.GroupId, Metadata: []byte("Soil carbon")})
+	require.NoError(t, err)
+	g, err := s.getGroupInfo(ctx, unnamed.GroupId)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("Soil carbon"), g.Metadata)
+}
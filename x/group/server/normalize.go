@@ -0,0 +1,71 @@
+package server
+
+import (
+	"github.com/cockroachdb/apd/v2"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/math"
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// normalizedWeightPrecision is the number of decimal places of normalized weights
+// when the module doesn't restrict the weight precision.
+const normalizedWeightPrecision = 6
+
+// normalizeWeights scales the stored weights of the members of a group with
+// normalized weights, keeping their proportions, so that they sum up exactly to
+// the group's normalized total, and sets the group total weight accordingly.
+// The group itself isn't saved. It is a no-op for other groups.
+func (s serverImpl) normalizeWeights(ctx types.Context, g *group.GroupInfo) error {
+	if !g.NormalizedWeights {
+		return nil
+	}
+	it, err := s.groupMemberByGroupIndex.Get(ctx, g.GroupId.Uint64())
+	if err != nil {
+		return err
+	}
+	var members []*group.GroupMember
+	if _, err := orm.ReadAll(it, &members); err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		g.TotalWeight = "0"
+		return nil
+	}
+
+	total, err := math.ParsePositiveDecimal(g.NormalizedTotal)
+	if err != nil {
+		return sdkerrors.Wrap(err, "normalized total")
+	}
+	shares := make([]*apd.Decimal, len(members))
+	for i, m := range members {
+		if shares[i], err = math.ParseNonNegativeDecimal(m.Member.Weight); err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Member.Address)
+		}
+	}
+	precision := s.weightPrecision
+	if precision == 0 {
+		precision = normalizedWeightPrecision
+	}
+	weights, err := math.SplitLargestRemainder(total, shares, precision)
+	if err != nil {
+		return sdkerrors.Wrap(err, "normalize weights")
+	}
+	for i, m := range members {
+		if weights[i].IsZero() {
+			return sdkerrors.Wrapf(group.ErrInvalid, "normalized weight of member %s is zero", m.Member.Address)
+		}
+		weight := math.CanonicalDecimalString(weights[i])
+		if weight == m.Member.Weight {
+			continue
+		}
+		m.Member.Weight = weight
+		if err := s.groupMemberTable.Save(ctx, m); err != nil {
+			return sdkerrors.Wrap(err, "save member")
+		}
+	}
+	g.TotalWeight = math.DecimalString(total)
+	return nil
+}
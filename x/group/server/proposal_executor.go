@@ -1,11 +1,22 @@
 package server
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/group"
 )
 
+const allBalancesQueryPath = "/cosmos.bank.v1beta1.Query/AllBalances"
+
 // ensureMsgAuthZ checks that if a message requires signers that all of them are equal to the given group account.
 func ensureMsgAuthZ(msgs []sdk.Msg, groupAccount sdk.AccAddress) error {
 	for i := range msgs {
@@ -40,3 +51,133 @@ func DoExecuteMsgs(ctx sdk.Context, router sdk.Router, groupAccount sdk.AccAddre
 	}
 	return results, nil
 }
+
+// groupAccountSends sums up the amounts of all bank sends and multi-send inputs from the
+// group account in msgs.
+func groupAccountSends(groupAccount sdk.AccAddress, msgs []sdk.Msg) sdk.Coins {
+	var spent sdk.Coins
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *banktypes.MsgSend:
+			if m.FromAddress == groupAccount.String() {
+				spent = spent.Add(m.Amount...)
+			}
+		case *banktypes.MsgMultiSend:
+			for _, in := range m.Inputs {
+				if in.Address == groupAccount.String() {
+					spent = spent.Add(in.Coins...)
+				}
+			}
+		}
+	}
+	return spent
+}
+
+// ensureSufficientBalance checks that the group account holds enough funds for the given
+// bank sends, so that a proposal execution can't fail on insufficient funds.
+func (s serverImpl) ensureSufficientBalance(ctx sdk.Context, groupAccount sdk.AccAddress, spent sdk.Coins) error {
+	if spent.Empty() {
+		return nil
+	}
+
+	balances, err := s.getAllBalances(ctx, groupAccount)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, coin := range spent {
+		if available := balances.AmountOf(coin.Denom); available.LT(coin.Amount) {
+			missing = append(missing, fmt.Sprintf("%s: required %s, available %s", coin.Denom, coin.Amount, available))
+		}
+	}
+	if len(missing) != 0 {
+		return errors.Wrapf(errors.ErrInsufficientFunds, "group account %s: %s", groupAccount, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// getAllBalances queries the bank module for all balances of the given account.
+func (s serverImpl) getAllBalances(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	handler := s.queryRouter.Route(allBalancesQueryPath)
+	if handler == nil {
+		return nil, errors.Wrapf(group.ErrInvalid, "no query handler found for %q", allBalancesQueryPath)
+	}
+	bz, err := s.cdc.MarshalBinaryBare(&banktypes.QueryAllBalancesRequest{Address: addr.String()})
+	if err != nil {
+		return nil, err
+	}
+	res, err := handler(ctx, abci.RequestQuery{Data: bz})
+	if err != nil {
+		return nil, errors.Wrap(err, "query balances")
+	}
+	var balances banktypes.QueryAllBalancesResponse
+	if err := s.cdc.UnmarshalBinaryBare(res.Value, &balances); err != nil {
+		return nil, err
+	}
+	return balances.Balances, nil
+}
+
+// spendWithinLimit checks that the given bank sends fit into the remaining spend limit of the
+// group account for the current window. A new window starts with the first spend after the
+// previous window has elapsed. It returns the updated spend record to be stored once the sends
+// were executed, or nil when the group account has no spend limit.
+func (s serverImpl) spendWithinLimit(ctx types.Context, accountInfo group.GroupAccountInfo, sends sdk.Coins) (*group.GroupAccountSpend, error) {
+	if accountInfo.SpendLimit.Empty() || sends.Empty() {
+		return nil, nil
+	}
+	period, err := gogotypes.DurationFromProto(accountInfo.SpendPeriod)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(accountInfo.GroupAccount)
+	if err != nil {
+		return nil, errors.Wrap(err, "group account")
+	}
+
+	var spend group.GroupAccountSpend
+	err = s.groupAccountSpendTable.GetOne(ctx, address.Bytes(), &spend)
+	switch {
+	case orm.ErrNotFound.Is(err):
+		spend = group.GroupAccountSpend{GroupAccount: accountInfo.GroupAccount}
+	case err != nil:
+		return nil, errors.Wrap(err, "load group account spend")
+	}
+	windowStart, err := gogotypes.TimestampFromProto(&spend.WindowStart)
+	if err != nil {
+		return nil, err
+	}
+	if !ctx.BlockTime().Before(windowStart.Add(period)) {
+		blockTime, err := gogotypes.TimestampProto(ctx.BlockTime())
+		if err != nil {
+			return nil, err
+		}
+		spend.WindowStart = *blockTime
+		spend.Spent = nil
+	}
+
+	spent := spend.Spent.Add(sends...)
+	var exceeded []string
+	for _, limit := range accountInfo.SpendLimit {
+		if amount := spent.AmountOf(limit.Denom); amount.GT(limit.Amount) {
+			remaining := limit.Amount.Sub(spend.Spent.AmountOf(limit.Denom))
+			exceeded = append(exceeded, fmt.Sprintf("%s: required %s, remaining %s", limit.Denom, sends.AmountOf(limit.Denom), remaining))
+		}
+	}
+	if len(exceeded) != 0 {
+		return nil, errors.Wrapf(group.ErrMaxLimit, "spend limit of group account %s: %s", address, strings.Join(exceeded, ", "))
+	}
+	spend.Spent = spent
+	return &spend, nil
+}
+
+// saveGroupAccountSpend stores the spend record of a group account.
+func (s serverImpl) saveGroupAccountSpend(ctx types.Context, spend *group.GroupAccountSpend) error {
+	address, err := sdk.AccAddressFromBech32(spend.GroupAccount)
+	if err != nil {
+		return errors.Wrap(err, "group account")
+	}
+	if s.groupAccountSpendTable.Has(ctx, address.Bytes()) {
+		return s.groupAccountSpendTable.Save(ctx, spend)
+	}
+	return s.groupAccountSpendTable.Create(ctx, spend)
+}
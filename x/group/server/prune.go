@@ -0,0 +1,220 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// forEachDoneProposal calls fn with each proposal that is done, see proposalDone,
+// and returns the number of proposals fn was called with. The result of expired
+// proposals is tallied before fn is called.
+func (s serverImpl) forEachDoneProposal(ctx types.Context, fn func(id group.ProposalID, p group.Proposal) error) (int, error) {
+	var count int
+	for _, status := range []group.Proposal_Status{group.ProposalStatusSubmitted, group.ProposalStatusClosed, group.ProposalStatusAborted} {
+		it, err := s.proposalByStatusIndex.Get(ctx, uint64(status))
+		if err != nil {
+			return count, err
+		}
+		var proposals []group.Proposal
+		rowIDs, err := orm.ReadAll(it, &proposals)
+		if err != nil {
+			return count, err
+		}
+		for i := range proposals {
+			id := group.ProposalID(orm.DecodeSequence(rowIDs[i]))
+			done, err := s.proposalDone(ctx, id, &proposals[i])
+			if err != nil {
+				return count, sdkerrors.Wrapf(err, "proposal %d", id)
+			}
+			if !done {
+				continue
+			}
+			if err := fn(id, proposals[i]); err != nil {
+				return count, sdkerrors.Wrapf(err, "proposal %d", id)
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// proposalDone returns whether the proposal is done. A proposal is done when it was
+// aborted, rejected or successfully executed, or when its voting period ended
+// without it being accepted. Accepted proposals that weren't executed successfully
+// yet and paused proposals aren't done.
+func (s serverImpl) proposalDone(ctx types.Context, id group.ProposalID, p *group.Proposal) (bool, error) {
+	switch p.Status {
+	case group.ProposalStatusAborted:
+		return true, nil
+	case group.ProposalStatusClosed:
+		return p.Result != group.ProposalResultAccepted || p.ExecutorResult == group.ProposalExecutorResultSuccess ||
+			p.ExecutorResult == group.ProposalExecutorResultPermanentFailure, nil
+	case group.ProposalStatusSubmitted:
+		expired, err := proposalExpired(ctx, *p)
+		if err != nil || !expired {
+			return false, err
+		}
+		// The result of an expired proposal is only known on tally.
+		accountAddr, err := sdk.AccAddressFromBech32(p.GroupAccount)
+		if err != nil {
+			return false, sdkerrors.Wrap(err, "group account")
+		}
+		accountInfo, err := s.getGroupAccountInfo(ctx, accountAddr)
+		if err != nil {
+			return false, err
+		}
+		electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+		if err != nil {
+			return false, err
+		}
+		if p.GroupAccountVersion != accountInfo.Version || p.GroupVersion != electorate.Version {
+			return true, nil
+		}
+		if err := s.doTally(ctx, id, p, electorate, accountInfo); err != nil {
+			return false, err
+		}
+		return p.Result != group.ProposalResultAccepted, nil
+	}
+	return false, nil
+}
+
+// proposalExpired returns whether the voting period of the unpaused proposal
+// ended at the block time.
+func proposalExpired(ctx types.Context, p group.Proposal) (bool, error) {
+	if p.Paused() {
+		return false, nil
+	}
+	timeout, err := gogotypes.TimestampFromProto(&p.Timeout)
+	if err != nil {
+		return false, err
+	}
+	return !ctx.BlockTime().Before(timeout), nil
+}
+
+func (s serverImpl) deleteProposal(ctx types.Context, id group.ProposalID) error {
+	it, err := s.voteByProposalIndex.Get(ctx, id.Uint64())
+	if err != nil {
+		return err
+	}
+	var votes []group.Vote
+	if _, err := orm.ReadAll(it, &votes); err != nil {
+		return err
+	}
+	for i := range votes {
+		if err := s.voteTable.Delete(ctx, &votes[i]); err != nil {
+			return sdkerrors.Wrap(err, "delete vote")
+		}
+	}
+	if err := s.deleteVoteCommitments(ctx, id); err != nil {
+		return err
+	}
+	return s.proposalTable.Delete(ctx, id.Uint64())
+}
+
+// deleteVoteCommitments deletes the vote commitments on the proposal that were
+// never revealed.
+func (s serverImpl) deleteVoteCommitments(ctx types.Context, id group.ProposalID) error {
+	it, err := s.voteCommitmentTable.PrefixScan(ctx, id.Bytes(), (id + 1).Bytes())
+	if err != nil {
+		return err
+	}
+	var commitments []group.VoteCommitment
+	if _, err := orm.ReadAll(it, &commitments); err != nil {
+		return err
+	}
+	for i := range commitments {
+		if err := s.voteCommitmentTable.Delete(ctx, &commitments[i]); err != nil {
+			return sdkerrors.Wrap(err, "delete vote commitment")
+		}
+	}
+	return nil
+}
+
+// pruneFinalizedVotes deletes the unrevealed vote commitments on a finalized proposal,
+// as they can't be revealed anymore. On a closed proposal of a group with vote pruning,
+// it also deletes the individual votes and records their voters on the proposal. The
+// tally of the proposal is kept as is. The caller must save the proposal.
+func (s serverImpl) pruneFinalizedVotes(ctx types.Context, id group.ProposalID, p *group.Proposal, g group.GroupInfo) error {
+	if p.Status == group.ProposalStatusSubmitted {
+		return nil
+	}
+	if err := s.deleteVoteCommitments(ctx, id); err != nil {
+		return err
+	}
+	if !g.PruneVotes || p.Status != group.ProposalStatusClosed {
+		return nil
+	}
+	it, err := s.voteByProposalIndex.Get(ctx, id.Uint64())
+	if err != nil {
+		return err
+	}
+	var votes []group.Vote
+	if _, err := orm.ReadAll(it, &votes); err != nil {
+		return err
+	}
+	for i := range votes {
+		if err := s.voteTable.Delete(ctx, &votes[i]); err != nil {
+			return sdkerrors.Wrap(err, "delete vote")
+		}
+		p.PrunedVoters = append(p.PrunedVoters, votes[i].Voter)
+	}
+	return nil
+}
+
+// countOpenProposals returns the number of submitted proposals to the group
+// accounts of the group whose voting period didn't end yet, counting up to limit.
+// Paused proposals count as open.
+func (s serverImpl) countOpenProposals(ctx types.Context, groupID group.ID, limit uint64) (uint64, error) {
+	accountIt, err := s.groupAccountByGroupIndex.Get(ctx, groupID.Uint64())
+	if err != nil {
+		return 0, err
+	}
+	var accounts []*group.GroupAccountInfo
+	if _, err := orm.ReadAll(accountIt, &accounts); err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	for _, account := range accounts {
+		addr, err := sdk.AccAddressFromBech32(account.GroupAccount)
+		if err != nil {
+			return 0, sdkerrors.Wrap(err, "group account")
+		}
+		it, err := s.proposalByGroupAccountIndex.Get(ctx, addr.Bytes())
+		if err != nil {
+			return 0, err
+		}
+		for count < limit {
+			var p group.Proposal
+			_, err := it.LoadNext(&p)
+			if orm.ErrIteratorDone.Is(err) {
+				break
+			}
+			if err != nil {
+				it.Close()
+				return 0, err
+			}
+			if p.Status != group.ProposalStatusSubmitted {
+				continue
+			}
+			expired, err := proposalExpired(ctx, p)
+			if err != nil {
+				it.Close()
+				return 0, err
+			}
+			if !expired {
+				count++
+			}
+		}
+		it.Close()
+		if count >= limit {
+			break
+		}
+	}
+	return count, nil
+}
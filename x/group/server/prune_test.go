@@ -0,0 +1,217 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestMaxOpenProposals(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+	s.maxOpenProposals = 1
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member := sdk.AccAddress([]byte("member-address-_____")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: member, Weight: "1"}},
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+
+	createProposal := func(ctx types.Context) (group.ProposalID, error) {
+		res, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{member},
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.ProposalId, nil
+	}
+
+	expiringID, err := createProposal(ctxAt(0))
+	require.NoError(t, err)
+
+	// submitting at the cap is rejected
+	_, err = createProposal(ctxAt(0))
+	require.Error(t, err)
+	assert.True(t, group.ErrMaxLimit.Is(err))
+
+	_, err = createProposal(ctxAt(5 * time.Second))
+	require.Error(t, err)
+	assert.True(t, group.ErrMaxLimit.Is(err))
+
+	// a proposal whose voting period ended frees its slot even before it is
+	// finalized at the end of the block, and isn't deleted
+	acceptedID, err := createProposal(ctxAt(10 * time.Second))
+	require.NoError(t, err)
+	p, err := s.getProposal(ctxAt(10*time.Second), expiringID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+
+	// an accepted proposal frees the slot and is kept with its result
+	_, err = s.Vote(ctxAt(11*time.Second), &group.MsgVoteRequest{ProposalId: acceptedID, Voter: member, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+	_, err = createProposal(ctxAt(11 * time.Second))
+	require.NoError(t, err)
+
+	// finished proposals are kept at the end of the block
+	s.EndBlock(ctxAt(time.Hour).Context)
+	p, err = s.getProposal(ctxAt(time.Hour), expiringID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalResultRejected, p.Result)
+	p, err = s.getProposal(ctxAt(time.Hour), acceptedID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalResultAccepted, p.Result)
+}
+
+func TestPruneVotesOnFinalization(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member-address-1____"))
+	member2 := sdk.AccAddress([]byte("member-address-2____"))
+	nonVoter := sdk.AccAddress([]byte("member-address-3____"))
+
+	createProposal := func(pruneVotes bool) group.ProposalID {
+		groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+			Admin: admin,
+			Members: []group.Member{
+				{Address: member1.String(), Weight: "1"},
+				{Address: member2.String(), Weight: "1"},
+				{Address: nonVoter.String(), Weight: "1"},
+			},
+			PruneVotes: pruneVotes,
+		})
+		require.NoError(t, err)
+		accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+		require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 10})))
+		accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+		require.NoError(t, err)
+		proposalRes, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{member1.String()},
+		})
+		require.NoError(t, err)
+		return proposalRes.ProposalId
+	}
+	vote := func(id group.ProposalID, voter sdk.AccAddress) {
+		_, err := s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: voter.String(), Choice: group.Choice_CHOICE_YES})
+		require.NoError(t, err)
+	}
+	expTally := group.Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"}
+
+	prunedID := createProposal(true)
+	vote(prunedID, member1)
+	// votes are kept while the proposal is open
+	_, found, err := s.MemberVote(ctx, prunedID, member1)
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	vote(prunedID, member2)
+	p, err := s.getProposal(ctx, prunedID)
+	require.NoError(t, err)
+	result, _ := p.GetProposalResult()
+	assert.Equal(t, group.ProposalResultAccepted, result)
+	assert.Equal(t, expTally, p.VoteState)
+	assert.Equal(t, []string{member1.String(), member2.String()}, p.PrunedVoters)
+	for _, member := range []sdk.AccAddress{member1, member2} {
+		_, found, err := s.MemberVote(ctx, prunedID, member)
+		require.NoError(t, err)
+		assert.False(t, found)
+		voted, err := s.Voted(ctx, prunedID, member)
+		require.NoError(t, err)
+		assert.True(t, voted)
+	}
+	voted, err := s.Voted(ctx, prunedID, nonVoter)
+	require.NoError(t, err)
+	assert.False(t, voted)
+
+	// without the option votes are kept after finalization
+	keptID := createProposal(false)
+	vote(keptID, member1)
+	vote(keptID, member2)
+	p, err = s.getProposal(ctx, keptID)
+	require.NoError(t, err)
+	assert.Equal(t, expTally, p.VoteState)
+	assert.Empty(t, p.PrunedVoters)
+	_, found, err = s.MemberVote(ctx, keptID, member1)
+	require.NoError(t, err)
+	assert.True(t, found)
+}
+
+func TestDeleteVoteCommitments(t *testing.T) {
+	f := newTestFixture(t)
+	ctxAt, s := f.ctxAt, f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member1-address-____")).String()
+	member2 := sdk.AccAddress([]byte("member2-address-____")).String()
+
+	groupRes, err := s.CreateGroup(ctxAt(0), &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: member1, Weight: "1"}, {Address: member2, Weight: "1"}},
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.CreateGroupAccount(ctxAt(0), accountReq)
+	require.NoError(t, err)
+
+	// committedProposal creates a proposal with an unrevealed vote commitment of member2.
+	committedProposal := func(ctx types.Context) group.ProposalID {
+		res, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{member1},
+		})
+		require.NoError(t, err)
+		_, err = s.CommitVote(ctx, &group.MsgCommitVoteRequest{
+			ProposalId: res.ProposalId,
+			Voter:      member2,
+			CommitHash: group.VoteCommitmentHash(res.ProposalId, member2, group.Choice_CHOICE_NO, []byte("salt")),
+		})
+		require.NoError(t, err)
+		require.True(t, s.voteCommitmentTable.Has(ctx, group.VoteNaturalKey(res.ProposalId, member2)))
+		return res.ProposalId
+	}
+	decidedID := committedProposal(ctxAt(0))
+	expiredID := committedProposal(ctxAt(0))
+	archivedID := committedProposal(ctxAt(5 * time.Second))
+
+	// finalized by a vote
+	_, err = s.Vote(ctxAt(time.Second), &group.MsgVoteRequest{ProposalId: decidedID, Voter: member1, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+	p, err := s.getProposal(ctxAt(time.Second), decidedID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusClosed, p.Status)
+	assert.False(t, s.voteCommitmentTable.Has(ctxAt(time.Second), group.VoteNaturalKey(decidedID, member2)))
+	assert.True(t, s.voteCommitmentTable.Has(ctxAt(time.Second), group.VoteNaturalKey(expiredID, member2)))
+
+	// finalized at the end of the voting period
+	finalized, err := s.FinalizeExpiredProposals(ctxAt(10 * time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{expiredID.Uint64()}, finalized)
+	assert.False(t, s.voteCommitmentTable.Has(ctxAt(10*time.Second), group.VoteNaturalKey(expiredID, member2)))
+	assert.True(t, s.voteCommitmentTable.Has(ctxAt(10*time.Second), group.VoteNaturalKey(archivedID, member2)))
+
+	// archived before finalization
+	_, err = s.ArchiveProposals(ctxAt(15 * time.Second))
+	require.NoError(t, err)
+	_, err = s.ArchivedProposal(ctxAt(15*time.Second), &group.QueryArchivedProposalRequest{ProposalId: archivedID})
+	require.NoError(t, err)
+	assert.False(t, s.voteCommitmentTable.Has(ctxAt(15*time.Second), group.VoteNaturalKey(archivedID, member2)))
+}
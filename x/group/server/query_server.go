@@ -1,9 +1,14 @@
 package server
 
 import (
+	"github.com/cockroachdb/apd/v2"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/regen-network/regen-ledger/math"
 	"github.com/regen-network/regen-ledger/orm"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/group"
@@ -41,6 +46,36 @@ func (s serverImpl) getGroupAccountInfo(ctx types.Context, accountAddress sdk.Ac
 	return obj, s.groupAccountTable.GetOne(ctx, accountAddress.Bytes(), &obj)
 }
 
+func (s serverImpl) GroupAccountDecisionPolicy(ctx types.Context, request *group.QueryGroupAccountDecisionPolicyRequest) (*group.QueryGroupAccountDecisionPolicyResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(request.GroupAccount)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.getGroupAccountPolicy(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	any, err := codectypes.NewAnyWithValue(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupAccountDecisionPolicyResponse{DecisionPolicy: any, PolicyType: policy.PolicyType()}, nil
+}
+
+// getGroupAccountPolicy returns the unpacked decision policy of the given group account.
+func (s serverImpl) getGroupAccountPolicy(ctx types.Context, accountAddress sdk.AccAddress) (group.DecisionPolicy, error) {
+	accountInfo, err := s.getGroupAccountInfo(ctx, accountAddress)
+	if err != nil {
+		return nil, err
+	}
+	policy := accountInfo.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "nil policy")
+	}
+	return policy, nil
+}
+
 func (s serverImpl) GroupMembers(ctx types.Context, request *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
 	it, err := s.getGroupMembers(ctx, request.GroupId, request.Pagination)
 	if err != nil {
@@ -63,6 +98,160 @@ func (s serverImpl) getGroupMembers(ctx types.Context, id group.ID, pageRequest
 	return s.groupMemberByGroupIndex.GetPaginated(ctx, id.Uint64(), pageRequest)
 }
 
+// EffectiveMembers returns the members of the group with both their nominal
+// weight and the effective weight that counts toward tallies.
+func (s serverImpl) EffectiveMembers(ctx types.Context, groupID group.ID) ([]group.MemberEffectiveWeight, error) {
+	g, err := s.getGroupInfo(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	it, err := s.groupMemberByGroupIndex.Get(ctx, groupID.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	var members []*group.GroupMember
+	if _, err := orm.ReadAll(it, &members); err != nil {
+		return nil, err
+	}
+	weights := make([]group.MemberEffectiveWeight, len(members))
+	for i, m := range members {
+		weight, err := g.EffectiveWeight(*m.Member)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "member %s", m.Member.Address)
+		}
+		weights[i] = group.MemberEffectiveWeight{Member: *m.Member, EffectiveWeight: math.DecimalString(weight)}
+	}
+	return weights, nil
+}
+
+// SuggestedThresholds returns thresholds for common shares of the current total
+// weight of the group, as guidance for admins setting up a threshold decision
+// policy. The majority is the smallest threshold above half of the total weight,
+// the two-thirds and the supermajority (three quarters) thresholds are the smallest
+// ones reaching these shares. Thresholds are rounded up to the decimal places of
+// the total weight, e.g. to whole weights for an integer total weight.
+func (s serverImpl) SuggestedThresholds(ctx types.Context, groupID group.ID) (majority, twoThirds, supermajority string, err error) {
+	g, err := s.getGroupInfo(ctx, groupID)
+	if err != nil {
+		return "", "", "", err
+	}
+	totalWeight, err := math.ParsePositiveDecimal(g.TotalWeight)
+	if err != nil {
+		return "", "", "", sdkerrors.Wrap(err, "total weight")
+	}
+	var reduced apd.Decimal
+	reduced.Reduce(totalWeight)
+	decimalPlaces := math.NumDecimalPlaces(&reduced)
+
+	// The majority is one unit of the smallest decimal place above half of the total.
+	half := apd.New(0, 0)
+	if err := math.Quo(half, totalWeight, apd.New(2, 0)); err != nil {
+		return "", "", "", err
+	}
+	if err := math.Floor(half, half, decimalPlaces); err != nil {
+		return "", "", "", err
+	}
+	if err := math.Add(half, half, apd.New(1, -int32(decimalPlaces))); err != nil {
+		return "", "", "", err
+	}
+	if twoThirds, err = thresholdShare(totalWeight, 2, 3, decimalPlaces); err != nil {
+		return "", "", "", err
+	}
+	if supermajority, err = thresholdShare(totalWeight, 3, 4, decimalPlaces); err != nil {
+		return "", "", "", err
+	}
+	return math.CanonicalDecimalString(half), twoThirds, supermajority, nil
+}
+
+// thresholdShare returns the share num/denom of the total weight rounded up to
+// decimalPlaces.
+func thresholdShare(totalWeight *apd.Decimal, num, denom int64, decimalPlaces uint32) (string, error) {
+	share := apd.New(0, 0)
+	if err := math.Mul(share, totalWeight, apd.New(num, 0)); err != nil {
+		return "", err
+	}
+	if err := math.Quo(share, share, apd.New(denom, 0)); err != nil {
+		return "", err
+	}
+	if err := math.Ceil(share, share, decimalPlaces); err != nil {
+		return "", err
+	}
+	return math.CanonicalDecimalString(share), nil
+}
+
+func (s serverImpl) WeightChangeImpact(ctx types.Context, request *group.QueryWeightChangeImpactRequest) (*group.QueryWeightChangeImpactResponse, error) {
+	member, err := sdk.AccAddressFromBech32(request.Member)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "member")
+	}
+	if err := s.checkWeightChangeImpact(ctx, request.GroupId, member, request.NewWeight); err != nil {
+		return nil, err
+	}
+	return &group.QueryWeightChangeImpactResponse{}, nil
+}
+
+// checkWeightChangeImpact simulates the group total weight after changing the weight of the given member
+// to newWeight and validates the decision policies of all group accounts against it.
+func (s serverImpl) checkWeightChangeImpact(ctx types.Context, groupID group.ID, member sdk.AccAddress, newWeight string) error {
+	g, err := s.getGroupInfo(ctx, groupID)
+	if err != nil {
+		return sdkerrors.Wrap(err, "load group")
+	}
+	totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	if err != nil {
+		return err
+	}
+	// The member keeps its role, so the new weight is subject to the same role multiplier.
+	newMember := group.Member{Address: member.String(), Weight: newWeight}
+	var prevGroupMember group.GroupMember
+	switch err := s.groupMemberTable.GetOne(ctx, group.GroupMember{GroupId: groupID, Member: &group.Member{Address: member.String()}}.NaturalKey(), &prevGroupMember); {
+	case err == nil:
+		prevWeight, err := g.EffectiveWeight(*prevGroupMember.Member)
+		if err != nil {
+			return err
+		}
+		if err := math.SafeSub(totalWeight, totalWeight, prevWeight); err != nil {
+			return err
+		}
+		newMember.Role = prevGroupMember.Member.Role
+	case orm.ErrNotFound.Is(err):
+	default:
+		return sdkerrors.Wrap(err, "get group member")
+	}
+	weight, err := g.EffectiveWeight(newMember)
+	if err != nil {
+		return sdkerrors.Wrap(err, "new weight")
+	}
+	if err := math.Add(totalWeight, totalWeight, weight); err != nil {
+		return err
+	}
+	g.TotalWeight = math.DecimalString(totalWeight)
+	return s.validateGroupAccountPolicies(ctx, g)
+}
+
+// validateGroupAccountPolicies validates the decision policies of all group accounts
+// of the group against the given group info.
+func (s serverImpl) validateGroupAccountPolicies(ctx types.Context, g group.GroupInfo) error {
+	it, err := s.groupAccountByGroupIndex.Get(ctx, g.GroupId.Uint64())
+	if err != nil {
+		return err
+	}
+	var accounts []*group.GroupAccountInfo
+	if _, err := orm.ReadAll(it, &accounts); err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		policy := account.GetDecisionPolicy()
+		if policy == nil {
+			return sdkerrors.Wrapf(group.ErrEmpty, "nil policy for group account %s", account.GroupAccount)
+		}
+		if err := policy.Validate(g); err != nil {
+			return sdkerrors.Wrapf(err, "group account %s with total weight %s", account.GroupAccount, g.TotalWeight)
+		}
+	}
+	return nil
+}
+
 func (s serverImpl) GroupsByAdmin(ctx types.Context, request *group.QueryGroupsByAdminRequest) (*group.QueryGroupsByAdminResponse, error) {
 	addr, err := sdk.AccAddressFromBech32(request.Admin)
 	if err != nil {
@@ -111,6 +300,103 @@ func (s serverImpl) getGroupAccountsByGroup(ctx types.Context, id group.ID, page
 	return s.groupAccountByGroupIndex.GetPaginated(ctx, id.Uint64(), pageRequest)
 }
 
+func (s serverImpl) FindDuplicateAccounts(ctx types.Context, request *group.QueryFindDuplicateAccountsRequest) (*group.QueryFindDuplicateAccountsResponse, error) {
+	it, err := s.groupAccountByGroupIndex.Get(ctx, request.GroupId.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	var accounts []*group.GroupAccountInfo
+	if _, err := orm.ReadAll(it, &accounts); err != nil {
+		return nil, err
+	}
+
+	// Group accounts by admin and decision policy, keeping the order of first appearance.
+	var keys []string
+	byKey := make(map[string][]string)
+	for _, a := range accounts {
+		if a.DecisionPolicy == nil {
+			continue
+		}
+		key := a.Admin + "/" + a.DecisionPolicy.TypeUrl + "/" + string(a.DecisionPolicy.Value)
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], a.GroupAccount)
+	}
+
+	var duplicates []group.DuplicateGroupAccounts
+	for _, key := range keys {
+		if len(byKey[key]) > 1 {
+			duplicates = append(duplicates, group.DuplicateGroupAccounts{GroupAccounts: byKey[key]})
+		}
+	}
+	return &group.QueryFindDuplicateAccountsResponse{Duplicates: duplicates}, nil
+}
+
+func (s serverImpl) TotalNetworkVotingWeight(ctx types.Context, request *group.QueryTotalNetworkVotingWeightRequest) (*group.QueryTotalNetworkVotingWeightResponse, error) {
+	groupIt, err := s.groupTable.PrefixScan(ctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer groupIt.Close()
+
+	sum := apd.New(0, 0)
+	for {
+		var groupInfo group.GroupInfo
+		_, err := groupIt.LoadNext(&groupInfo)
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		totalWeight, err := math.ParseNonNegativeDecimal(groupInfo.TotalWeight)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "group %d total weight", groupInfo.GroupId)
+		}
+		if err := math.Add(sum, sum, totalWeight); err != nil {
+			return nil, err
+		}
+	}
+	return &group.QueryTotalNetworkVotingWeightResponse{TotalWeight: math.DecimalString(sum)}, nil
+}
+
+// UnsatisfiablePolicies returns the addresses of all group accounts whose decision
+// policy fails validation against their group's current state, e.g. a threshold
+// greater than the group's total weight after members were removed, so that
+// proposals of the account can never be accepted.
+func (s serverImpl) UnsatisfiablePolicies(ctx types.Context, request *group.QueryUnsatisfiablePoliciesRequest) (*group.QueryUnsatisfiablePoliciesResponse, error) {
+	it, err := s.groupAccountTable.PrefixScan(ctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var accounts []*group.GroupAccountInfo
+	if _, err := orm.ReadAll(it, &accounts); err != nil {
+		return nil, err
+	}
+
+	groups := make(map[group.ID]group.GroupInfo)
+	var unsatisfiable []string
+	for _, a := range accounts {
+		g, ok := groups[a.GroupId]
+		if !ok {
+			if g, err = s.getGroupInfo(ctx, a.GroupId); err != nil {
+				return nil, sdkerrors.Wrapf(err, "group account %s", a.GroupAccount)
+			}
+			groups[a.GroupId] = g
+		}
+		policy := a.GetDecisionPolicy()
+		if policy == nil {
+			return nil, sdkerrors.Wrapf(group.ErrEmpty, "decision policy of group account %s", a.GroupAccount)
+		}
+		if policy.Validate(g) == nil {
+			continue
+		}
+		unsatisfiable = append(unsatisfiable, a.GroupAccount)
+	}
+	return &group.QueryUnsatisfiablePoliciesResponse{GroupAccounts: unsatisfiable}, nil
+}
+
 func (s serverImpl) GroupAccountsByAdmin(ctx types.Context, request *group.QueryGroupAccountsByAdminRequest) (*group.QueryGroupAccountsByAdminResponse, error) {
 	addr, err := sdk.AccAddressFromBech32(request.Admin)
 	if err != nil {
@@ -143,7 +429,155 @@ func (s serverImpl) Proposal(ctx types.Context, request *group.QueryProposalRequ
 		return nil, err
 	}
 
-	return &group.QueryProposalResponse{Proposal: &proposal}, nil
+	accountAddress, err := sdk.AccAddressFromBech32(proposal.GroupAccount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, accountAddress)
+	if err != nil {
+		return nil, err
+	}
+	electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &group.QueryProposalResponse{Proposal: &proposal}
+	totalWeight, err := math.ParseNonNegativeDecimal(electorate.TotalWeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group total weight")
+	}
+	if !totalWeight.IsZero() {
+		decisiveness, err := proposal.VoteState.Decisiveness(electorate.TotalWeight)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "decisiveness")
+		}
+		res.Decisiveness = math.DecimalString(&decisiveness.Decimal)
+	}
+	return res, nil
+}
+
+func (s serverImpl) GetNextProposalID(ctx types.Context, request *group.QueryGetNextProposalIDRequest) (*group.QueryGetNextProposalIDResponse, error) {
+	return &group.QueryGetNextProposalIDResponse{
+		ProposalId: group.ProposalID(s.proposalTable.Sequence().PeekNextVal(ctx)),
+	}, nil
+}
+
+// ArchivedProposal returns a proposal that was moved to the archived proposal table.
+func (s serverImpl) ArchivedProposal(ctx types.Context, request *group.QueryArchivedProposalRequest) (*group.QueryArchivedProposalResponse, error) {
+	var p group.Proposal
+	if err := s.archivedProposalTable.GetOne(ctx, request.ProposalId.Bytes(), &p); err != nil {
+		return nil, sdkerrors.Wrap(err, "load archived proposal")
+	}
+	return &group.QueryArchivedProposalResponse{Proposal: &p}, nil
+}
+
+// SimulateOutcome evaluates the group account's decision policy on the current tally of a
+// proposal as if it was evaluated at the requested time. Nothing is stored.
+func (s serverImpl) SimulateOutcome(ctx types.Context, request *group.QuerySimulateOutcomeRequest) (*group.QuerySimulateOutcomeResponse, error) {
+	proposal, err := s.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if result, ok := proposal.CachedResult(); ok {
+		return &group.QuerySimulateOutcomeResponse{Allow: result.Allow, Final: result.Final, Reason: result.Reason}, nil
+	}
+	accountAddress, err := sdk.AccAddressFromBech32(proposal.GroupAccount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, accountAddress)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.proposalPolicy(proposal, accountInfo)
+	if err != nil {
+		return nil, err
+	}
+	electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	atTime, err := gogotypes.TimestampFromProto(&request.AtTime)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "at time")
+	}
+	votingDuration, err := proposal.VotingDuration(atTime)
+	if err != nil {
+		return nil, err
+	}
+	result, err := policy.Allow(proposal.VoteState, electorate.TotalWeight, votingDuration)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "policy execution")
+	}
+	return &group.QuerySimulateOutcomeResponse{Allow: result.Allow, Final: result.Final, Reason: result.Reason}, nil
+}
+
+// PreviewVoteImpact returns the decision policy result of the proposal before and
+// after a hypothetical vote of the voter with the given choice, weighted like a
+// real vote. The vote is applied to a copy of the tally and nothing is stored.
+// Option votes can't be previewed.
+func (s serverImpl) PreviewVoteImpact(ctx types.Context, proposalID group.ProposalID, voter sdk.AccAddress, choice group.Choice) (before, after group.DecisionPolicyResult, err error) {
+	if choice == group.Choice_CHOICE_UNSPECIFIED || choice == group.Choice_CHOICE_OPTION {
+		return before, after, sdkerrors.Wrapf(group.ErrInvalid, "choice %s not supported", choice)
+	}
+	proposal, accountInfo, electorate, err := s.getVotableProposal(ctx, proposalID)
+	if err != nil {
+		return before, after, err
+	}
+	if s.voteTable.Has(ctx, group.VoteNaturalKey(proposalID, voter.String())) {
+		return before, after, sdkerrors.Wrap(group.ErrDuplicate, "voted already")
+	}
+	member := group.GroupMember{GroupId: electorate.GroupId, Member: &group.Member{Address: voter.String()}}
+	if err := s.groupMemberTable.GetOne(ctx, member.NaturalKey(), &member); err != nil {
+		return before, after, sdkerrors.Wrapf(err, "address: %s", voter)
+	}
+	weight, err := electorate.EffectiveWeight(*member.Member)
+	if err != nil {
+		return before, after, sdkerrors.Wrapf(err, "address: %s", voter)
+	}
+
+	tally := proposal.VoteState.Clone()
+	vote := group.Vote{ProposalId: proposalID, Voter: voter.String(), Choice: choice}
+	if err := tally.Add(vote, math.DecimalString(weight)); err != nil {
+		return before, after, sdkerrors.Wrap(err, "add vote")
+	}
+	if member.Member.Role != "" {
+		if err := tally.AddRoleVote(member.Member.Role, vote, math.DecimalString(weight)); err != nil {
+			return before, after, sdkerrors.Wrap(err, "add vote to role tally")
+		}
+	}
+
+	policy, err := s.proposalPolicy(proposal, accountInfo)
+	if err != nil {
+		return before, after, err
+	}
+	votingDuration, err := proposal.VotingDuration(ctx.BlockTime())
+	if err != nil {
+		return before, after, err
+	}
+	if before, err = policy.Allow(proposal.VoteState, electorate.TotalWeight, votingDuration); err != nil {
+		return before, after, sdkerrors.Wrap(err, "policy execution")
+	}
+	if after, err = policy.Allow(tally, electorate.TotalWeight, votingDuration); err != nil {
+		return before, after, sdkerrors.Wrap(err, "policy execution")
+	}
+	return before, after, nil
+}
+
+// EvaluatePolicy evaluates the given decision policy against a hypothetical tally.
+// It does not access the store.
+func (s serverImpl) EvaluatePolicy(_ types.Context, request *group.QueryEvaluatePolicyRequest) (*group.QueryEvaluatePolicyResponse, error) {
+	elapsed, err := gogotypes.DurationFromProto(&request.Elapsed)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "elapsed")
+	}
+	result, err := group.EvaluatePolicy(request.GetPolicy(), request.Tally, request.TotalPower, elapsed)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "policy execution")
+	}
+	return &group.QueryEvaluatePolicyResponse{Allow: result.Allow, Final: result.Final, Reason: result.Reason}, nil
 }
 
 func (s serverImpl) ProposalsByGroupAccount(ctx types.Context, request *group.QueryProposalsByGroupAccountRequest) (*group.QueryProposalsByGroupAccountResponse, error) {
@@ -172,6 +606,28 @@ func (s serverImpl) getProposalsByGroupAccount(ctx types.Context, account sdk.Ac
 	return s.proposalByGroupAccountIndex.GetPaginated(ctx, account.Bytes(), pageRequest)
 }
 
+func (s serverImpl) ProposalsByStatus(ctx types.Context, request *group.QueryProposalsByStatusRequest) (*group.QueryProposalsByStatusResponse, error) {
+	it, err := s.getProposalsByStatus(ctx, request.Status, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*group.Proposal
+	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalsByStatusResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (s serverImpl) getProposalsByStatus(ctx types.Context, status group.Proposal_Status, pageRequest *query.PageRequest) (orm.Iterator, error) {
+	return s.proposalByStatusIndex.GetPaginated(ctx, uint64(status), pageRequest)
+}
+
 func (s serverImpl) getProposal(ctx types.Context, id group.ProposalID) (group.Proposal, error) {
 	var p group.Proposal
 	if _, err := s.proposalTable.GetOne(ctx, id.Uint64(), &p); err != nil {
@@ -180,6 +636,41 @@ func (s serverImpl) getProposal(ctx types.Context, id group.ProposalID) (group.P
 	return p, nil
 }
 
+func (s serverImpl) BatchProposalTallies(ctx types.Context, request *group.QueryBatchProposalTalliesRequest) (*group.QueryBatchProposalTalliesResponse, error) {
+	tallies := make([]group.ProposalTally, 0, len(request.ProposalIds))
+	for _, id := range request.ProposalIds {
+		var p group.Proposal
+		_, err := s.proposalTable.GetOne(ctx, id.Uint64(), &p)
+		switch {
+		case orm.ErrNotFound.Is(err):
+			continue
+		case err != nil:
+			return nil, sdkerrors.Wrapf(err, "load proposal %d", id)
+		}
+		tallies = append(tallies, group.ProposalTally{ProposalId: id, Tally: p.VoteState})
+	}
+	return &group.QueryBatchProposalTalliesResponse{Tallies: tallies}, nil
+}
+
+// QuorumReached returns true when the current votes on the proposal meet the
+// quorum of the group account's decision policy, independent of whether the
+// proposal is passing. It returns an error if the policy has no quorum configured.
+func (s serverImpl) QuorumReached(ctx types.Context, proposalID group.ProposalID) (bool, error) {
+	p, err := s.getProposal(ctx, proposalID)
+	if err != nil {
+		return false, err
+	}
+	addr, err := sdk.AccAddressFromBech32(p.GroupAccount)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "group account")
+	}
+	policy, err := s.getGroupAccountPolicy(ctx, addr)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "load group account policy")
+	}
+	return group.QuorumReached(policy, p.VoteState)
+}
+
 func (s serverImpl) VoteByProposalVoter(ctx types.Context, request *group.QueryVoteByProposalVoterRequest) (*group.QueryVoteByProposalVoterResponse, error) {
 	addr, err := sdk.AccAddressFromBech32(request.Voter)
 	if err != nil {
@@ -194,6 +685,81 @@ func (s serverImpl) VoteByProposalVoter(ctx types.Context, request *group.QueryV
 	}, nil
 }
 
+// MemberVote returns the choice of the member's vote on the proposal and whether
+// the member voted at all. As votes can't be changed, this is the choice the
+// vote was cast with.
+func (s serverImpl) MemberVote(ctx types.Context, proposalID group.ProposalID, member sdk.AccAddress) (group.Choice, bool, error) {
+	vote, err := s.getVote(ctx, proposalID, member)
+	switch {
+	case orm.ErrNotFound.Is(err):
+		return group.Choice_CHOICE_UNSPECIFIED, false, nil
+	case err != nil:
+		return group.Choice_CHOICE_UNSPECIFIED, false, err
+	}
+	return vote.Choice, true, nil
+}
+
+// Voted returns true if the member voted on the proposal, including when the
+// individual vote was pruned at finalization.
+func (s serverImpl) Voted(ctx types.Context, proposalID group.ProposalID, member sdk.AccAddress) (bool, error) {
+	if _, found, err := s.MemberVote(ctx, proposalID, member); err != nil || found {
+		return found, err
+	}
+	p, err := s.getProposal(ctx, proposalID)
+	if err != nil {
+		return false, err
+	}
+	for _, voter := range p.PrunedVoters {
+		if voter == member.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ProposalSnapshot returns the members, with their weights, that the proposal is
+// tallied against and the group version of this membership. There is no separate
+// copy of the membership per proposal: votes are only accepted while the group
+// version is unchanged since the proposal submission, so the snapshot is the current
+// membership as long as the group still has the proposal's group version. Weight
+// decay doesn't change the group version, so the weights are the decayed ones.
+// Once the group was modified the snapshot isn't available anymore and ErrModified
+// is returned, as the proposal can't be tallied anymore either.
+func (s serverImpl) ProposalSnapshot(ctx types.Context, request *group.QueryProposalSnapshotRequest) (*group.QueryProposalSnapshotResponse, error) {
+	p, err := s.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(p.GroupAccount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if g.Version != p.GroupVersion {
+		return nil, sdkerrors.Wrapf(group.ErrModified, "group version %d, proposal group version %d", g.Version, p.GroupVersion)
+	}
+
+	it, err := s.groupMemberByGroupIndex.Get(ctx, g.GroupId.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	var members []group.GroupMember
+	if _, err := orm.ReadAll(it, &members); err != nil {
+		return nil, err
+	}
+	return &group.QueryProposalSnapshotResponse{
+		Members:      members,
+		GroupVersion: p.GroupVersion,
+	}, nil
+}
+
 func (s serverImpl) VotesByProposal(ctx types.Context, request *group.QueryVotesByProposalRequest) (*group.QueryVotesByProposalResponse, error) {
 	it, err := s.getVotesByProposal(ctx, request.ProposalId, request.Pagination)
 	if err != nil {
@@ -234,6 +800,72 @@ func (s serverImpl) VotesByVoter(ctx types.Context, request *group.QueryVotesByV
 	}, nil
 }
 
+func (s serverImpl) ChangedVotes(ctx types.Context, request *group.QueryChangedVotesRequest) (*group.QueryChangedVotesResponse, error) {
+	it, err := s.voteChangeByProposalIndex.GetPaginated(ctx, request.ProposalId.Uint64(), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*group.VoteChange
+	pageRes, err := orm.Paginate(it, request.Pagination, &changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryChangedVotesResponse{
+		VoteChanges: changes,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (s serverImpl) PendingVoters(ctx types.Context, request *group.QueryPendingVotersRequest) (*group.QueryPendingVotersResponse, error) {
+	proposalID := request.ProposalId
+	p, err := s.getProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != group.ProposalStatusSubmitted {
+		return nil, sdkerrors.Wrap(group.ErrProposalFinal, "proposal not open for voting")
+	}
+	addr, err := sdk.AccAddressFromBech32(p.GroupAccount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := s.getGroupMembers(ctx, accountInfo.GroupId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	pending := orm.IteratorFunc(func(dest codec.ProtoMarshaler) (orm.RowID, error) {
+		for {
+			rowID, err := it.LoadNext(dest)
+			if err != nil {
+				return nil, err
+			}
+			key := group.VoteNaturalKey(proposalID, dest.(*group.GroupMember).Member.Address)
+			if !s.voteTable.Has(ctx, key) && !s.voteCommitmentTable.Has(ctx, key) {
+				return rowID, nil
+			}
+		}
+	})
+
+	var members []*group.GroupMember
+	pageRes, err := orm.Paginate(pending, request.Pagination, &members)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryPendingVotersResponse{
+		Members:    members,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (s serverImpl) getVote(ctx types.Context, id group.ProposalID, voter sdk.AccAddress) (group.Vote, error) {
 	var v group.Vote
 	return v, s.voteTable.GetOne(ctx, group.Vote{ProposalId: id, Voter: voter.String()}.NaturalKey(), &v)
@@ -0,0 +1,581 @@
+package server
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestMemberVote(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	voter := sdk.AccAddress([]byte("voter-address-______"))
+	revoter := sdk.AccAddress([]byte("revoter-address-____"))
+	nonVoter := sdk.AccAddress([]byte("non-voter-address-__"))
+
+	vote := group.Vote{ProposalId: 1, Voter: voter.String(), Choice: group.Choice_CHOICE_YES, SubmittedAt: gogotypes.Timestamp{Seconds: 1}}
+	require.NoError(t, s.voteTable.Create(ctx, &vote))
+	revote := group.Vote{ProposalId: 1, Voter: revoter.String(), Choice: group.Choice_CHOICE_NO, SubmittedAt: gogotypes.Timestamp{Seconds: 1}}
+	require.NoError(t, s.voteTable.Create(ctx, &revote))
+	// a vote cast again after the previous one was revoked
+	require.NoError(t, s.voteTable.Delete(ctx, &revote))
+	revote.Choice = group.Choice_CHOICE_ABSTAIN
+	revote.SubmittedAt = gogotypes.Timestamp{Seconds: 2}
+	require.NoError(t, s.voteTable.Create(ctx, &revote))
+
+	specs := map[string]struct {
+		proposalID group.ProposalID
+		member     sdk.AccAddress
+		expChoice  group.Choice
+		expFound   bool
+	}{
+		"voted": {
+			proposalID: 1,
+			member:     voter,
+			expChoice:  group.Choice_CHOICE_YES,
+			expFound:   true,
+		},
+		"not voted": {
+			proposalID: 1,
+			member:     nonVoter,
+		},
+		"voted on other proposal": {
+			proposalID: 2,
+			member:     voter,
+		},
+		"voted again": {
+			proposalID: 1,
+			member:     revoter,
+			expChoice:  group.Choice_CHOICE_ABSTAIN,
+			expFound:   true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			choice, found, err := s.MemberVote(ctx, spec.proposalID, spec.member)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expFound, found)
+			assert.Equal(t, spec.expChoice, choice)
+		})
+	}
+}
+
+func TestBatchProposalTallies(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	groupAccount := sdk.AccAddress([]byte("group-account-______"))
+	proposer := sdk.AccAddress([]byte("proposer-address-___"))
+	createProposal := func(tally group.Tally) group.ProposalID {
+		id, err := s.proposalTable.Create(ctx, &group.Proposal{
+			GroupAccount:        groupAccount.String(),
+			Proposers:           []string{proposer.String()},
+			SubmittedAt:         gogotypes.Timestamp{Seconds: 1},
+			GroupVersion:        1,
+			GroupAccountVersion: 1,
+			Status:              group.ProposalStatusSubmitted,
+			Result:              group.ProposalResultUnfinalized,
+			ExecutorResult:      group.ProposalExecutorResultNotRun,
+			VoteState:           tally,
+			Timeout:             gogotypes.Timestamp{Seconds: 10},
+		})
+		require.NoError(t, err)
+		return group.ProposalID(id)
+	}
+	first := createProposal(group.Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"})
+	second := createProposal(group.Tally{YesCount: "0", NoCount: "2", AbstainCount: "1", VetoCount: "0"})
+
+	res, err := s.BatchProposalTallies(ctx, &group.QueryBatchProposalTalliesRequest{ProposalIds: []group.ProposalID{first, 9999, second, 0}})
+	require.NoError(t, err)
+	assert.Equal(t, []group.ProposalTally{
+		{ProposalId: first, Tally: group.Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"}},
+		{ProposalId: second, Tally: group.Tally{YesCount: "0", NoCount: "2", AbstainCount: "1", VetoCount: "0"}},
+	}, res.Tallies)
+
+	res, err = s.BatchProposalTallies(ctx, &group.QueryBatchProposalTalliesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, res.Tallies)
+}
+
+func TestQuorumReached(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member-address-1____")).String()
+	member2 := sdk.AccAddress([]byte("member-address-2____")).String()
+	member3 := sdk.AccAddress([]byte("member-address-3____")).String()
+
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: member1, Weight: "1"},
+			{Address: member2, Weight: "1"},
+			{Address: member3, Weight: "1"},
+		},
+	})
+	require.NoError(t, err)
+	createProposal := func(policy group.DecisionPolicy) group.ProposalID {
+		accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+		require.NoError(t, accountReq.SetDecisionPolicy(policy))
+		accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+		require.NoError(t, err)
+		proposalRes, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{member1},
+		})
+		require.NoError(t, err)
+		return proposalRes.ProposalId
+	}
+	quorumPolicy := &group.ThresholdDecisionPolicy{Threshold: "3", Quorum: "2", Timeout: gogotypes.Duration{Seconds: 10}}
+	metID := createProposal(quorumPolicy)
+	notMetID := createProposal(quorumPolicy)
+	noQuorumID := createProposal(group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 10}))
+
+	vote := func(id group.ProposalID, voter string, choice group.Choice) {
+		_, err := s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: voter, Choice: choice})
+		require.NoError(t, err)
+	}
+	// the quorum is met although the proposal isn't passing
+	vote(metID, member1, group.Choice_CHOICE_YES)
+	vote(metID, member2, group.Choice_CHOICE_NO)
+	vote(notMetID, member1, group.Choice_CHOICE_YES)
+	vote(noQuorumID, member1, group.Choice_CHOICE_YES)
+
+	specs := map[string]struct {
+		proposalID group.ProposalID
+		expReached bool
+		expErr     bool
+	}{
+		"quorum met": {
+			proposalID: metID,
+			expReached: true,
+		},
+		"quorum not met": {
+			proposalID: notMetID,
+		},
+		"policy without quorum": {
+			proposalID: noQuorumID,
+			expErr:     true,
+		},
+		"unknown proposal": {
+			proposalID: 9999,
+			expErr:     true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			reached, err := s.QuorumReached(ctx, spec.proposalID)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expReached, reached)
+		})
+	}
+}
+
+func TestSortMembers(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	var members []group.Member
+	for _, b := range []byte{0xff, 0x01, 0x7f, 0x80, 0x00, 0x42} {
+		addr := sdk.AccAddress(append([]byte("member-address-____"), b))
+		members = append(members, group.Member{Address: addr.String(), Weight: "1"})
+	}
+	for i := 0; i < 2; i++ {
+		_, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{Admin: admin, Members: members})
+		require.NoError(t, err)
+	}
+
+	it, err := s.groupMemberTable.PrefixScan(ctx, nil, nil)
+	require.NoError(t, err)
+	var stored []*group.GroupMember
+	_, err = orm.ReadAll(it, &stored)
+	require.NoError(t, err)
+	require.Len(t, stored, 2*len(members))
+	expected := make([]group.GroupMember, len(stored))
+	for i := range stored {
+		expected[i] = *stored[i]
+	}
+
+	shuffled := make([]group.GroupMember, len(expected))
+	copy(shuffled, expected)
+	rand.New(rand.NewSource(1)).Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	require.NotEqual(t, expected, shuffled)
+
+	group.SortMembers(shuffled)
+	assert.Equal(t, expected, shuffled)
+	for i := 1; i < len(shuffled); i++ {
+		assert.True(t, shuffled[i-1].Less(shuffled[i]))
+		assert.False(t, shuffled[i].Less(shuffled[i-1]))
+	}
+}
+
+func TestEffectiveMembers(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member-address-1____")).String()
+	member2 := sdk.AccAddress([]byte("member-address-2____")).String()
+
+	members := []group.Member{
+		{Address: member1, Weight: "1.5", Role: "council"},
+		{Address: member2, Weight: "3"},
+	}
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:           admin,
+		Members:         members,
+		RoleMultipliers: []group.RoleMultiplier{{Role: "council", Multiplier: "2"}},
+	})
+	require.NoError(t, err)
+
+	weights, err := s.EffectiveMembers(ctx, groupRes.GroupId)
+	require.NoError(t, err)
+	exp := []group.MemberEffectiveWeight{
+		{Member: members[0], EffectiveWeight: "3.0"},
+		{Member: members[1], EffectiveWeight: "3"},
+	}
+	assert.Equal(t, exp, weights)
+
+	_, err = s.EffectiveMembers(ctx, groupRes.GroupId+1)
+	assert.True(t, orm.ErrNotFound.Is(err))
+}
+
+func TestSuggestedThresholds(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member-address-1____")).String()
+	member2 := sdk.AccAddress([]byte("member-address-2____")).String()
+
+	specs := map[string]struct {
+		weights                                     []string
+		expMajority, expTwoThirds, expSuperMajority string
+	}{
+		"odd total":        {weights: []string{"4", "5"}, expMajority: "5", expTwoThirds: "6", expSuperMajority: "7"},
+		"even total":       {weights: []string{"4", "6"}, expMajority: "6", expTwoThirds: "7", expSuperMajority: "8"},
+		"single unit":      {weights: []string{"1"}, expMajority: "1", expTwoThirds: "1", expSuperMajority: "1"},
+		"fractional total": {weights: []string{"1", "1.5"}, expMajority: "1.3", expTwoThirds: "1.7", expSuperMajority: "1.9"},
+		"trailing zeros":   {weights: []string{"4.50", "4.50"}, expMajority: "5", expTwoThirds: "6", expSuperMajority: "7"},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			req := &group.MsgCreateGroupRequest{Admin: admin}
+			for i, weight := range spec.weights {
+				req.Members = append(req.Members, group.Member{Address: []string{member1, member2}[i], Weight: weight})
+			}
+			groupRes, err := s.CreateGroup(ctx, req)
+			require.NoError(t, err)
+
+			majority, twoThirds, superMajority, err := s.SuggestedThresholds(ctx, groupRes.GroupId)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expMajority, majority)
+			assert.Equal(t, spec.expTwoThirds, twoThirds)
+			assert.Equal(t, spec.expSuperMajority, superMajority)
+		})
+	}
+
+	_, _, _, err := s.SuggestedThresholds(ctx, 100)
+	assert.True(t, orm.ErrNotFound.Is(err))
+}
+
+func TestPreviewVoteImpact(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member-address-1____"))
+	member2 := sdk.AccAddress([]byte("member-address-2____"))
+	member3 := sdk.AccAddress([]byte("member-address-3____"))
+	nonMember := sdk.AccAddress([]byte("non-member-address__"))
+
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: member1.String(), Weight: "1"},
+			{Address: member2.String(), Weight: "1"},
+			{Address: member3.String(), Weight: "1"},
+		},
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+	proposalRes, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{member1.String()},
+	})
+	require.NoError(t, err)
+	id := proposalRes.ProposalId
+	_, err = s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: member1.String(), Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+
+	open := group.DecisionPolicyResult{Allow: false, Final: false}
+	specs := map[string]struct {
+		voter     sdk.AccAddress
+		choice    group.Choice
+		expBefore group.DecisionPolicyResult
+		expAfter  group.DecisionPolicyResult
+		expErr    *sdkerrors.Error
+	}{
+		"pivotal yes vote accepts": {
+			voter:     member2,
+			choice:    group.Choice_CHOICE_YES,
+			expBefore: open,
+			expAfter:  group.DecisionPolicyResult{Allow: true, Final: true, Reason: group.ResultReasonThresholdReached},
+		},
+		"non pivotal no vote": {
+			voter:     member2,
+			choice:    group.Choice_CHOICE_NO,
+			expBefore: open,
+			expAfter:  open,
+		},
+		"voted already": {
+			voter:  member1,
+			choice: group.Choice_CHOICE_NO,
+			expErr: group.ErrDuplicate,
+		},
+		"not a member": {
+			voter:  nonMember,
+			choice: group.Choice_CHOICE_YES,
+			expErr: orm.ErrNotFound,
+		},
+		"option choice": {
+			voter:  member2,
+			choice: group.Choice_CHOICE_OPTION,
+			expErr: group.ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			before, after, err := s.PreviewVoteImpact(ctx, id, spec.voter, spec.choice)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				assert.True(t, spec.expErr.Is(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expBefore, before)
+			assert.Equal(t, spec.expAfter, after)
+		})
+	}
+
+	// nothing is stored
+	p, err := s.getProposal(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusSubmitted, p.Status)
+	assert.Equal(t, "1", p.VoteState.YesCount)
+	assert.Equal(t, "0", p.VoteState.NoCount)
+	voted, err := s.Voted(ctx, id, member2)
+	require.NoError(t, err)
+	assert.False(t, voted)
+}
+
+func TestProposalSnapshot(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	members := []group.Member{
+		{Address: sdk.AccAddress([]byte("member-address-1____")).String(), Weight: "1"},
+		{Address: sdk.AccAddress([]byte("member-address-2____")).String(), Weight: "2"},
+	}
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{Admin: admin, Members: members})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+	proposalRes, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{members[0].Address},
+	})
+	require.NoError(t, err)
+	id := proposalRes.ProposalId
+
+	snapshot, err := s.ProposalSnapshot(ctx, &group.QueryProposalSnapshotRequest{ProposalId: id})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), snapshot.GroupVersion)
+	require.Len(t, snapshot.Members, 2)
+	got := make([]group.Member, len(snapshot.Members))
+	for i, m := range snapshot.Members {
+		assert.Equal(t, groupRes.GroupId, m.GroupId)
+		got[i] = *m.Member
+	}
+	assert.ElementsMatch(t, members, got)
+
+	// Votes don't change the snapshot.
+	_, err = s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: members[1].Address, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+	again, err := s.ProposalSnapshot(ctx, &group.QueryProposalSnapshotRequest{ProposalId: id})
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, again)
+
+	// The live membership changes are never reported as the snapshot of the proposal.
+	_, err = s.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         admin,
+		GroupId:       groupRes.GroupId,
+		MemberUpdates: []group.Member{{Address: members[0].Address, Weight: "5"}},
+	})
+	require.NoError(t, err)
+	_, err = s.ProposalSnapshot(ctx, &group.QueryProposalSnapshotRequest{ProposalId: id})
+	assert.True(t, group.ErrModified.Is(err))
+
+	_, err = s.ProposalSnapshot(ctx, &group.QueryProposalSnapshotRequest{ProposalId: id + 1})
+	assert.True(t, orm.ErrNotFound.Is(err))
+}
+
+func TestPendingVoters(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	members := []group.Member{
+		{Address: sdk.AccAddress([]byte("member-address-1____")).String(), Weight: "1"},
+		{Address: sdk.AccAddress([]byte("member-address-2____")).String(), Weight: "2"},
+		{Address: sdk.AccAddress([]byte("member-address-3____")).String(), Weight: "3"},
+		{Address: sdk.AccAddress([]byte("member-address-4____")).String(), Weight: "4"},
+	}
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{Admin: admin, Members: members})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("6", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+	proposalRes, err := s.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{members[0].Address},
+	})
+	require.NoError(t, err)
+	id := proposalRes.ProposalId
+
+	pendingVoters := func(pageRequest *query.PageRequest) ([]group.Member, *query.PageResponse) {
+		res, err := s.PendingVoters(ctx, &group.QueryPendingVotersRequest{ProposalId: id, Pagination: pageRequest})
+		require.NoError(t, err)
+		pending := make([]group.Member, len(res.Members))
+		for i, m := range res.Members {
+			assert.Equal(t, groupRes.GroupId, m.GroupId)
+			pending[i] = *m.Member
+		}
+		return pending, res.Pagination
+	}
+	pending, _ := pendingVoters(nil)
+	assert.ElementsMatch(t, members, pending)
+
+	// the list updates as votes come in, hidden votes included
+	_, err = s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: members[1].Address, Choice: group.Choice_CHOICE_YES})
+	require.NoError(t, err)
+	_, err = s.CommitVote(ctx, &group.MsgCommitVoteRequest{
+		ProposalId: id,
+		Voter:      members[2].Address,
+		CommitHash: group.VoteCommitmentHash(id, members[2].Address, group.Choice_CHOICE_NO, []byte("salt")),
+	})
+	require.NoError(t, err)
+	pending, pageRes := pendingVoters(nil)
+	assert.ElementsMatch(t, []group.Member{members[0], members[3]}, pending)
+	assert.Equal(t, uint64(2), pageRes.Total)
+
+	// paginated
+	firstPage, pageRes := pendingVoters(&query.PageRequest{Limit: 1})
+	require.Len(t, firstPage, 1)
+	require.NotNil(t, pageRes.NextKey)
+	secondPage, pageRes := pendingVoters(&query.PageRequest{Key: pageRes.NextKey, Limit: 1})
+	require.Len(t, secondPage, 1)
+	assert.Nil(t, pageRes.NextKey)
+	assert.ElementsMatch(t, pending, append(firstPage, secondPage...))
+
+	_, err = s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: members[0].Address, Choice: group.Choice_CHOICE_NO})
+	require.NoError(t, err)
+	pending, _ = pendingVoters(nil)
+	assert.Equal(t, []group.Member{members[3]}, pending)
+
+	// closed proposals have no pending voters
+	_, err = s.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: members[3].Address, Choice: group.Choice_CHOICE_NO})
+	require.NoError(t, err)
+	_, err = s.PendingVoters(ctx, &group.QueryPendingVotersRequest{ProposalId: id})
+	assert.True(t, group.ErrProposalFinal.Is(err))
+}
+
+func TestTotalNetworkVotingWeight(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	// no groups
+	res, err := s.TotalNetworkVotingWeight(ctx, &group.QueryTotalNetworkVotingWeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.TotalWeight)
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	for i, weight := range []string{"1", "2.5", "10.25"} {
+		groupInfo := &group.GroupInfo{GroupId: group.ID(i + 1), Admin: admin, Version: 1, TotalWeight: weight}
+		require.NoError(t, s.groupTable.Create(ctx, groupInfo.GroupId.Bytes(), groupInfo))
+	}
+
+	res, err = s.TotalNetworkVotingWeight(ctx, &group.QueryTotalNetworkVotingWeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "13.75", res.TotalWeight)
+}
+
+func TestUnsatisfiablePolicies(t *testing.T) {
+	f := newTestFixture(t)
+	ctx, s := f.ctx(), f.s
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member1 := sdk.AccAddress([]byte("member-address-1____")).String()
+	member2 := sdk.AccAddress([]byte("member-address-2____")).String()
+
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: member1, Weight: "1"},
+			{Address: member2, Weight: "1"},
+		},
+	})
+	require.NoError(t, err)
+	createAccount := func(threshold string) sdk.AccAddress {
+		accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+		require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy(threshold, gogotypes.Duration{Seconds: 10})))
+		accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+		require.NoError(t, err)
+		addr, err := sdk.AccAddressFromBech32(accountRes.GroupAccount)
+		require.NoError(t, err)
+		return addr
+	}
+	brokenAccount := createAccount("2")
+	createAccount("1")
+
+	res, err := s.UnsatisfiablePolicies(ctx, &group.QueryUnsatisfiablePoliciesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, res.GroupAccounts)
+
+	// removing a member leaves a total weight below the first account's threshold
+	_, err = s.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         admin,
+		GroupId:       groupRes.GroupId,
+		MemberUpdates: []group.Member{{Address: member2, Weight: "0"}},
+	})
+	require.NoError(t, err)
+
+	res, err = s.UnsatisfiablePolicies(ctx, &group.QueryUnsatisfiablePoliciesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{brokenAccount.String()}, res.GroupAccounts)
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// RetryExecutions retries the execution of accepted proposals whose execution
+// failed. The execution of a proposal is retried at most maxExecutionRetries times,
+// once per block, and the proposal is marked as permanently failed if the last
+// retry fails as well.
+func (s serverImpl) RetryExecutions(ctx types.Context) error {
+	it, err := s.proposalByStatusIndex.Get(ctx, uint64(group.ProposalStatusClosed))
+	if err != nil {
+		return err
+	}
+	var proposals []group.Proposal
+	rowIDs, err := orm.ReadAll(it, &proposals)
+	if err != nil {
+		return err
+	}
+	for i := range proposals {
+		p := proposals[i]
+		if p.Result != group.ProposalResultAccepted || p.ExecutorResult != group.ProposalExecutorResultFailure {
+			continue
+		}
+		id := group.ProposalID(orm.DecodeSequence(rowIDs[i]))
+		if err := s.retryExecution(ctx, id, &p); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d", id)
+		}
+	}
+	return nil
+}
+
+func (s serverImpl) retryExecution(ctx types.Context, id group.ProposalID, p *group.Proposal) error {
+	address, err := sdk.AccAddressFromBech32(p.GroupAccount)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+	var accountInfo group.GroupAccountInfo
+	if err := s.groupAccountTable.GetOne(ctx, address.Bytes(), &accountInfo); err != nil {
+		return sdkerrors.Wrap(err, "load group account")
+	}
+
+	p.ExecutionRetries++
+	// Errors of the checks before the execution, e.g. a spending limit that is
+	// exceeded, count as a failed execution here.
+	if err := s.execProposalMsgs(ctx, id, p, accountInfo); err != nil {
+		p.ExecutorResult = group.ProposalExecutorResultFailure
+		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
+		logger.Info("proposal execution failed", "cause", err, "proposalID", id)
+	}
+	if p.ExecutorResult == group.ProposalExecutorResultFailure && p.ExecutionRetries >= s.maxExecutionRetries {
+		p.ExecutorResult = group.ProposalExecutorResultPermanentFailure
+	}
+	return s.proposalTable.Save(ctx, id.Uint64(), p)
+}
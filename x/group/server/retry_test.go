@@ -0,0 +1,107 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestRetryExecutions(t *testing.T) {
+	f := newTestFixture(t)
+	sdkCtx, ctx := f.sdkCtx, f.ctx()
+
+	// The handler fails as long as failures are left, e.g. on funds which are
+	// missing at execution time.
+	var calls, failures int
+	router := baseapp.NewRouter().AddRoute(sdk.NewRoute("TestMsg", func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		calls++
+		if failures > 0 {
+			failures--
+			return nil, fmt.Errorf("transient failure")
+		}
+		return &sdk.Result{}, nil
+	}))
+
+	s := newServer(f.key, router, nil, f.cdc)
+	s.maxExecutionRetries = 2
+
+	admin := sdk.AccAddress([]byte("admin-address-______")).String()
+	member := sdk.AccAddress([]byte("member-address-_____")).String()
+
+	groupRes, err := s.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: member, Weight: "1"}},
+	})
+	require.NoError(t, err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupRes.GroupId}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 600})))
+	accountRes, err := s.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+
+	// acceptAndExec submits an accepted proposal and executes it once.
+	acceptAndExec := func() group.ProposalID {
+		req := &group.MsgCreateProposalRequest{GroupAccount: accountRes.GroupAccount, Proposers: []string{member}}
+		require.NoError(t, req.SetMsgs([]sdk.Msg{&testdata.TestMsg{Signers: []string{accountRes.GroupAccount}}}))
+		res, err := s.CreateProposal(ctx, req)
+		require.NoError(t, err)
+		_, err = s.Vote(ctx, &group.MsgVoteRequest{ProposalId: res.ProposalId, Voter: member, Choice: group.Choice_CHOICE_YES})
+		require.NoError(t, err)
+		_, err = s.Exec(ctx, &group.MsgExecRequest{ProposalId: res.ProposalId, Signer: member})
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+	getProposal := func(id group.ProposalID) group.Proposal {
+		p, err := s.getProposal(ctx, id)
+		require.NoError(t, err)
+		return p
+	}
+
+	t.Run("succeeds on third attempt", func(t *testing.T) {
+		calls, failures = 0, 2
+		id := acceptAndExec()
+		p := getProposal(id)
+		assert.Equal(t, group.ProposalExecutorResultFailure, p.ExecutorResult)
+
+		s.EndBlock(sdkCtx)
+		p = getProposal(id)
+		assert.Equal(t, group.ProposalExecutorResultFailure, p.ExecutorResult)
+		assert.Equal(t, uint64(1), p.ExecutionRetries)
+
+		s.EndBlock(sdkCtx)
+		p = getProposal(id)
+		assert.Equal(t, group.ProposalExecutorResultSuccess, p.ExecutorResult)
+		assert.Equal(t, uint64(2), p.ExecutionRetries)
+		assert.Equal(t, 3, calls)
+
+		// successful executions aren't retried
+		s.EndBlock(sdkCtx)
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("fails beyond max retries", func(t *testing.T) {
+		calls, failures = 0, 3
+		id := acceptAndExec()
+		s.EndBlock(sdkCtx)
+		s.EndBlock(sdkCtx)
+		p := getProposal(id)
+		assert.Equal(t, group.ProposalExecutorResultPermanentFailure, p.ExecutorResult)
+		assert.Equal(t, uint64(2), p.ExecutionRetries)
+		assert.Equal(t, 3, calls)
+
+		// no more automatic or manual executions
+		s.EndBlock(sdkCtx)
+		assert.Equal(t, 3, calls)
+		_, err := s.Exec(ctx, &group.MsgExecRequest{ProposalId: id, Signer: member})
+		assert.True(t, group.ErrProposalFinal.Is(err))
+		done, err := s.proposalDone(ctx, id, &p)
+		require.NoError(t, err)
+		assert.True(t, done)
+	})
+}
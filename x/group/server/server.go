@@ -1,12 +1,17 @@
 package server
 
 import (
+	"time"
+
 	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
 	servermodule "github.com/regen-network/regen-ledger/types/module/server"
 	"github.com/regen-network/regen-ledger/x/group"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
 )
 
 const (
@@ -19,6 +24,7 @@ const (
 	GroupMemberTablePrefix         byte = 0x10
 	GroupMemberByGroupIndexPrefix  byte = 0x11
 	GroupMemberByMemberIndexPrefix byte = 0x12
+	GroupMemberByExpiryIndexPrefix byte = 0x13
 
 	// Group Account Table
 	GroupAccountTablePrefix        byte = 0x20
@@ -31,16 +37,91 @@ const (
 	ProposalTableSeqPrefix            byte = 0x31
 	ProposalByGroupAccountIndexPrefix byte = 0x32
 	ProposalByProposerIndexPrefix     byte = 0x33
+	ProposalByStatusIndexPrefix       byte = 0x34
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Vote Commitment Table
+	VoteCommitmentTablePrefix byte = 0x50
+
+	// Group Account Spend Table
+	GroupAccountSpendTablePrefix byte = 0x60
+
+	// Group Invitation Table
+	GroupInvitationTablePrefix byte = 0x70
+
+	// Store Version
+	StoreVersionKey byte = 0x80
+
+	// Group Member Activity Table
+	GroupMemberActivityTablePrefix byte = 0x90
+
+	// Archived Proposal Table
+	ArchivedProposalTablePrefix byte = 0xA0
+
+	// Vote Change Table
+	VoteChangeTablePrefix           byte = 0xB0
+	VoteChangeTableSeqPrefix        byte = 0xB1
+	VoteChangeByProposalIndexPrefix byte = 0xB2
 )
 
 type serverImpl struct {
-	storeKey sdk.StoreKey
-	router   sdk.Router
+	storeKey    sdk.StoreKey
+	router      sdk.Router
+	queryRouter *baseapp.GRPCQueryRouter
+	cdc         codec.Marshaler
+
+	// groupValidator is an optional hook to reject group operations, it may be nil.
+	groupValidator group.GroupValidator
+
+	// allowedDecisionPolicyTypes are the type URLs of the decision policies group
+	// accounts can use, all types are allowed if empty.
+	allowedDecisionPolicyTypes []string
+
+	// minMembersForProposals is the minimum number of members a group must have
+	// before proposals can be submitted to its group accounts, no minimum if 0.
+	minMembersForProposals uint64
+
+	// weightPrecision is the maximum number of decimal places of member weights,
+	// including role multipliers, no limit if 0.
+	weightPrecision uint32
+
+	// allowAdminProposers allows the group admin to submit proposals without
+	// being a member of the group.
+	allowAdminProposers bool
+
+	// maxOpenProposals is the maximum number of submitted proposals a group can
+	// have at a time, no maximum if 0.
+	maxOpenProposals uint64
+
+	// timeoutGranularity is the duration decision policy timeouts must be a
+	// multiple of, timeouts aren't restricted if 0.
+	timeoutGranularity time.Duration
+
+	// proposalEditingWindow is the duration after submission in which proposals
+	// can be amended, amendments are disabled if 0.
+	proposalEditingWindow time.Duration
+
+	// fastTrackWindow is the timeout of fast-track proposals, which are accepted
+	// with a share of yes votes of fastTrackPercentage. Fast-track proposals are
+	// disabled if 0.
+	fastTrackWindow     time.Duration
+	fastTrackPercentage string
+
+	// maxExecutionRetries is the number of times the execution of a proposal that
+	// failed is retried at the end of a block, failed executions aren't retried if 0.
+	maxExecutionRetries uint64
+
+	// archiveProposals moves proposals that are done to the archived proposal table
+	// at the end of a block if set.
+	archiveProposals bool
+
+	// requireGroupName rejects groups with empty metadata, which holds the group's
+	// human-readable name, if set.
+	requireGroupName bool
 
 	// Group Table
 	groupSeq          orm.Sequence
@@ -51,6 +132,7 @@ type serverImpl struct {
 	groupMemberTable         orm.NaturalKeyTable
 	groupMemberByGroupIndex  orm.UInt64Index
 	groupMemberByMemberIndex orm.Index
+	groupMemberByExpiryIndex orm.Index
 
 	// Group Account Table
 	groupAccountSeq          orm.Sequence
@@ -59,18 +141,40 @@ type serverImpl struct {
 	groupAccountByAdminIndex orm.Index
 
 	// Proposal Table
+	// Proposal IDs are taken from the table sequence, they start at 1, are
+	// gap-free and never reused.
 	proposalTable               orm.AutoUInt64Table
 	proposalByGroupAccountIndex orm.Index
 	proposalByProposerIndex     orm.Index
+	proposalByStatusIndex       orm.UInt64Index
 
 	// Vote Table
 	voteTable           orm.NaturalKeyTable
 	voteByProposalIndex orm.UInt64Index
 	voteByVoterIndex    orm.Index
+
+	// Vote Commitment Table
+	voteCommitmentTable orm.NaturalKeyTable
+
+	// Group Account Spend Table
+	groupAccountSpendTable orm.NaturalKeyTable
+
+	// Group Invitation Table
+	groupInvitationTable orm.NaturalKeyTable
+
+	// Group Member Activity Table
+	groupMemberActivityTable orm.NaturalKeyTable
+
+	// Archived Proposal Table
+	archivedProposalTable orm.Table
+
+	// Vote Change Table
+	voteChangeTable           orm.AutoUInt64Table
+	voteChangeByProposalIndex orm.UInt64Index
 }
 
-func newServer(storeKey sdk.StoreKey, router sdk.Router, cdc codec.Marshaler) serverImpl {
-	s := serverImpl{storeKey: storeKey, router: router}
+func newServer(storeKey sdk.StoreKey, router sdk.Router, queryRouter *baseapp.GRPCQueryRouter, cdc codec.Marshaler) serverImpl {
+	s := serverImpl{storeKey: storeKey, router: router, queryRouter: queryRouter, cdc: cdc}
 
 	// Group Table
 	groupTableBuilder := orm.NewTableBuilder(GroupTablePrefix, storeKey, &group.GroupInfo{}, orm.FixLengthIndexKeys(orm.EncodedSeqLength), cdc)
@@ -98,6 +202,17 @@ func newServer(storeKey sdk.StoreKey, router sdk.Router, cdc codec.Marshaler) se
 		}
 		return []orm.RowID{addr.Bytes()}, nil
 	})
+	s.groupMemberByExpiryIndex = orm.NewIndex(groupMemberTableBuilder, GroupMemberByExpiryIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
+		expiresAt := val.(*group.GroupMember).Member.ExpiresAt
+		if expiresAt == nil {
+			return nil, nil
+		}
+		t, err := gogotypes.TimestampFromProto(expiresAt)
+		if err != nil {
+			return nil, err
+		}
+		return []orm.RowID{sdk.FormatTimeBytes(t)}, nil
+	})
 	s.groupMemberTable = groupMemberTableBuilder.Build()
 
 	// Group Account Table
@@ -140,6 +255,10 @@ func newServer(storeKey sdk.StoreKey, router sdk.Router, cdc codec.Marshaler) se
 		}
 		return r, nil
 	})
+	s.proposalByStatusIndex = orm.NewUInt64Index(proposalTableBuilder, ProposalByStatusIndexPrefix, func(value interface{}) ([]uint64, error) {
+		status := value.(*group.Proposal).Status
+		return []uint64{uint64(status)}, nil
+	})
 	s.proposalTable = proposalTableBuilder.Build()
 
 	// Vote Table
@@ -156,11 +275,104 @@ func newServer(storeKey sdk.StoreKey, router sdk.Router, cdc codec.Marshaler) se
 	})
 	s.voteTable = voteTableBuilder.Build()
 
+	// Vote Commitment Table
+	voteCommitmentTableBuilder := orm.NewNaturalKeyTableBuilder(VoteCommitmentTablePrefix, storeKey, &group.VoteCommitment{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	s.voteCommitmentTable = voteCommitmentTableBuilder.Build()
+
+	// Group Account Spend Table
+	groupAccountSpendTableBuilder := orm.NewNaturalKeyTableBuilder(GroupAccountSpendTablePrefix, storeKey, &group.GroupAccountSpend{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	s.groupAccountSpendTable = groupAccountSpendTableBuilder.Build()
+
+	// Group Invitation Table
+	groupInvitationTableBuilder := orm.NewNaturalKeyTableBuilder(GroupInvitationTablePrefix, storeKey, &group.GroupInvitation{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	s.groupInvitationTable = groupInvitationTableBuilder.Build()
+
+	// Group Member Activity Table
+	groupMemberActivityTableBuilder := orm.NewNaturalKeyTableBuilder(GroupMemberActivityTablePrefix, storeKey, &group.GroupMemberActivity{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	s.groupMemberActivityTable = groupMemberActivityTableBuilder.Build()
+
+	// Archived Proposal Table
+	archivedProposalTableBuilder := orm.NewTableBuilder(ArchivedProposalTablePrefix, storeKey, &group.Proposal{}, orm.FixLengthIndexKeys(orm.EncodedSeqLength), cdc)
+	s.archivedProposalTable = archivedProposalTableBuilder.Build()
+
+	// Vote Change Table
+	voteChangeTableBuilder := orm.NewAutoUInt64TableBuilder(VoteChangeTablePrefix, VoteChangeTableSeqPrefix, storeKey, &group.VoteChange{}, cdc)
+	s.voteChangeByProposalIndex = orm.NewUInt64Index(voteChangeTableBuilder, VoteChangeByProposalIndexPrefix, func(value interface{}) ([]uint64, error) {
+		return []uint64{uint64(value.(*group.VoteChange).ProposalId)}, nil
+	})
+	s.voteChangeTable = voteChangeTableBuilder.Build()
+
 	return s
 }
 
-func RegisterServices(configurator servermodule.Configurator) {
-	impl := newServer(configurator.ModuleKey(), configurator.Router(), configurator.Marshaler())
+// Config holds the settings of the group module, which are documented on the
+// fields of the same name of x/group/module.Module. The zero value disables all
+// optional features.
+type Config struct {
+	// GroupValidator is invoked when groups are created or members are added
+	// and may be nil.
+	GroupValidator             group.GroupValidator
+	AllowedDecisionPolicyTypes []string
+	MinMembersForProposals     uint64
+	WeightPrecision            uint32
+	AllowAdminProposers        bool
+	MaxOpenProposals           uint64
+	TimeoutGranularity         time.Duration
+	ProposalEditingWindow      time.Duration
+	FastTrackWindow            time.Duration
+	FastTrackPercentage        string
+	MaxExecutionRetries        uint64
+	ArchiveProposals           bool
+	RequireGroupName           bool
+}
+
+// RegisterServices registers the group Msg and Query services with the given settings.
+func RegisterServices(configurator servermodule.Configurator, config Config) {
+	impl := newServer(configurator.ModuleKey(), configurator.Router(), configurator.QueryRouter(), configurator.Marshaler())
+	impl.groupValidator = config.GroupValidator
+	impl.allowedDecisionPolicyTypes = config.AllowedDecisionPolicyTypes
+	impl.minMembersForProposals = config.MinMembersForProposals
+	impl.weightPrecision = config.WeightPrecision
+	impl.allowAdminProposers = config.AllowAdminProposers
+	impl.maxOpenProposals = config.MaxOpenProposals
+	impl.timeoutGranularity = config.TimeoutGranularity
+	impl.proposalEditingWindow = config.ProposalEditingWindow
+	impl.fastTrackWindow = config.FastTrackWindow
+	impl.fastTrackPercentage = config.FastTrackPercentage
+	impl.maxExecutionRetries = config.MaxExecutionRetries
+	impl.archiveProposals = config.ArchiveProposals
+	impl.requireGroupName = config.RequireGroupName
 	group.RegisterMsgServer(configurator.MsgServer(), impl)
 	group.RegisterQueryServer(configurator.QueryServer(), impl)
+	configurator.RegisterInvariantsHandler(impl.RegisterInvariants)
+	configurator.RegisterEndBlocker(impl.EndBlock)
+	configurator.RegisterMigrationHandler(impl.Migrate)
+	configurator.RegisterGenesisHandlers(impl.InitGenesis, impl.ExportGenesis)
+}
+
+// EndBlock removes expired group members, decays the weights of inactive group
+// members, finalizes the proposals whose voting period ended, retries failed
+// proposal executions if enabled and archives the proposals that are done if
+// enabled.
+func (s serverImpl) EndBlock(ctx sdk.Context) {
+	c := types.Context{Context: ctx}
+	if _, err := s.ExpireMembers(c); err != nil {
+		panic(err)
+	}
+	if err := s.DecayWeights(c); err != nil {
+		panic(err)
+	}
+	if _, err := s.FinalizeExpiredProposals(c); err != nil {
+		panic(err)
+	}
+	if s.maxExecutionRetries != 0 {
+		if err := s.RetryExecutions(c); err != nil {
+			panic(err)
+		}
+	}
+	if s.archiveProposals {
+		if _, err := s.ArchiveProposals(c); err != nil {
+			panic(err)
+		}
+	}
 }
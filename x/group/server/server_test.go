@@ -1,12 +1,20 @@
 package server_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/module"
 	"github.com/regen-network/regen-ledger/types/module/server"
+	"github.com/regen-network/regen-ledger/x/group"
 	groupmodule "github.com/regen-network/regen-ledger/x/group/module"
 	"github.com/regen-network/regen-ledger/x/group/server/testsuite"
 )
@@ -20,3 +28,397 @@ func TestServer(t *testing.T) {
 
 	suite.Run(t, s)
 }
+
+func TestServerWithAllowAllGroupValidator(t *testing.T) {
+	ff := server.NewFixtureFactory(t, 6, []module.Module{
+		groupmodule.Module{GroupValidator: allowAllValidator{}},
+	})
+
+	s := testsuite.NewIntegrationTestSuite(ff)
+
+	suite.Run(t, s)
+}
+
+func TestGroupValidator(t *testing.T) {
+	validator := &rejectingValidator{}
+	ff := server.NewFixtureFactory(t, 3, []module.Module{
+		groupmodule.Module{GroupValidator: validator},
+	})
+	fixture := ff.Setup()
+	signers := fixture.Signers()
+	rejectedAdmin, admin, rejectedMember := signers[0].String(), signers[1].String(), signers[2].String()
+	validator.admin = rejectedAdmin
+	validator.member = rejectedMember
+
+	sdkCtx := fixture.Context().(types.Context).WithBlockTime(time.Now().UTC())
+	ctx := types.Context{Context: sdkCtx}
+	msgClient := group.NewMsgClient(fixture.TxConn())
+
+	// create group
+	_, err := msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   rejectedAdmin,
+		Members: []group.Member{{Address: admin, Weight: "1"}},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "admin not allowed")
+	res, err := msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: rejectedAdmin, Weight: "1"}},
+	})
+	require.NoError(t, err)
+	groupID := res.GroupId
+
+	// add members
+	_, err = msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         admin,
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: rejectedMember, Weight: "1"}},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "member not allowed")
+	_, err = msgClient.InviteMember(ctx, &group.MsgInviteMemberRequest{
+		Admin:   admin,
+		GroupId: groupID,
+		Member:  group.Member{Address: rejectedMember, Weight: "1"},
+	})
+	require.NoError(t, err)
+	_, err = msgClient.AcceptInvitation(ctx, &group.MsgAcceptInvitationRequest{
+		Invitee: rejectedMember,
+		GroupId: groupID,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "member not allowed")
+
+	// updating an existing member is not an addition
+	_, err = msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         admin,
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: rejectedAdmin, Weight: "2"}},
+	})
+	require.NoError(t, err)
+}
+
+func TestAllowedDecisionPolicyTypes(t *testing.T) {
+	thresholdType := "/" + proto.MessageName(&group.ThresholdDecisionPolicy{})
+	ff := server.NewFixtureFactory(t, 2, []module.Module{
+		groupmodule.Module{AllowedDecisionPolicyTypes: []string{thresholdType}},
+	})
+	fixture := ff.Setup()
+	signers := fixture.Signers()
+	admin, member := signers[0].String(), signers[1].String()
+
+	sdkCtx := fixture.Context().(types.Context).WithBlockTime(time.Now().UTC())
+	ctx := types.Context{Context: sdkCtx}
+	msgClient := group.NewMsgClient(fixture.TxConn())
+
+	res, err := msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: member, Weight: "1"}},
+	})
+	require.NoError(t, err)
+
+	createAccount := func(policy group.DecisionPolicy) (string, error) {
+		req := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: res.GroupId}
+		require.NoError(t, req.SetDecisionPolicy(policy))
+		res, err := msgClient.CreateGroupAccount(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		return res.GroupAccount, nil
+	}
+	updatePolicy := func(account string, policy group.DecisionPolicy) error {
+		req := &group.MsgUpdateGroupAccountDecisionPolicyRequest{Admin: admin, GroupAccount: account}
+		require.NoError(t, req.SetDecisionPolicy(policy))
+		_, err := msgClient.UpdateGroupAccountDecisionPolicy(ctx, req)
+		return err
+	}
+	percentage := group.NewPercentageDecisionPolicy("0.5", gogotypes.Duration{Seconds: 1}, false)
+
+	// forbidden policy type
+	_, err = createAccount(percentage)
+	require.Error(t, err)
+	require.True(t, group.ErrInvalid.Is(err))
+	require.Contains(t, err.Error(), "PercentageDecisionPolicy not allowed")
+
+	// permitted policy type
+	account, err := createAccount(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1}))
+	require.NoError(t, err)
+
+	err = updatePolicy(account, percentage)
+	require.Error(t, err)
+	require.True(t, group.ErrInvalid.Is(err))
+	require.NoError(t, updatePolicy(account, group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 2})))
+}
+
+func TestMinMembersForProposals(t *testing.T) {
+	ff := server.NewFixtureFactory(t, 4, []module.Module{
+		groupmodule.Module{MinMembersForProposals: 3},
+	})
+	fixture := ff.Setup()
+	signers := fixture.Signers()
+	admin, member1, member2, member3 := signers[0].String(), signers[1].String(), signers[2].String(), signers[3].String()
+
+	sdkCtx := fixture.Context().(types.Context).WithBlockTime(time.Now().UTC())
+	ctx := types.Context{Context: sdkCtx}
+	msgClient := group.NewMsgClient(fixture.TxConn())
+
+	res, err := msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: member1, Weight: "1"},
+			{Address: member2, Weight: "1"},
+		},
+	})
+	require.NoError(t, err)
+	groupID := res.GroupId
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: groupID}
+	require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1})))
+	accountRes, err := msgClient.CreateGroupAccount(ctx, accountReq)
+	require.NoError(t, err)
+
+	createProposal := func() error {
+		_, err := msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{member1},
+		})
+		return err
+	}
+
+	// below the minimum
+	err = createProposal()
+	require.Error(t, err)
+	require.True(t, group.ErrInvalid.Is(err))
+	require.Contains(t, err.Error(), "group has 2 members, at least 3 required")
+
+	// at the minimum
+	_, err = msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         admin,
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: member3, Weight: "1"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, createProposal())
+
+	// above the minimum
+	_, err = msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         admin,
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: admin, Weight: "1"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, createProposal())
+}
+
+func TestWeightPrecision(t *testing.T) {
+	ff := server.NewFixtureFactory(t, 4, []module.Module{
+		groupmodule.Module{WeightPrecision: 18},
+	})
+	fixture := ff.Setup()
+	signers := fixture.Signers()
+	admin, member1, member2, member3 := signers[0].String(), signers[1].String(), signers[2].String(), signers[3].String()
+
+	sdkCtx := fixture.Context().(types.Context).WithBlockTime(time.Now().UTC())
+	ctx := types.Context{Context: sdkCtx}
+	msgClient := group.NewMsgClient(fixture.TxConn())
+
+	// too many decimal places
+	_, err := msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: member1, Weight: "1.123456789012345678901"}},
+	})
+	require.Error(t, err)
+	require.True(t, group.ErrInvalid.Is(err))
+	require.Contains(t, err.Error(), "has 21 decimal places, at most 18 allowed")
+
+	// at the precision
+	res, err := msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: []group.Member{{Address: member1, Weight: "1.123456789012345678"}},
+	})
+	require.NoError(t, err)
+	groupID := res.GroupId
+
+	_, err = msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         admin,
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: member2, Weight: "0.0000000000000000001"}},
+	})
+	require.Error(t, err)
+	require.True(t, group.ErrInvalid.Is(err))
+
+	_, err = msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         admin,
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: member2, Weight: "0.000000000000000001"}},
+	})
+	require.NoError(t, err)
+
+	_, err = msgClient.InviteMember(ctx, &group.MsgInviteMemberRequest{
+		Admin:   admin,
+		GroupId: groupID,
+		Member:  group.Member{Address: member3, Weight: "2.1234567890123456789"},
+	})
+	require.Error(t, err)
+	require.True(t, group.ErrInvalid.Is(err))
+}
+
+func TestAllowAdminProposers(t *testing.T) {
+	specs := map[string]struct {
+		allowAdminProposers bool
+		proposer            int
+		expErr              bool
+	}{
+		"member proposer": {
+			proposer: 1,
+		},
+		"non-member proposer": {
+			proposer: 2,
+			expErr:   true,
+		},
+		"admin proposer": {
+			proposer: 0,
+			expErr:   true,
+		},
+		"admin proposer allowed": {
+			allowAdminProposers: true,
+			proposer:            0,
+		},
+		"non-member proposer with admin proposers allowed": {
+			allowAdminProposers: true,
+			proposer:            2,
+			expErr:              true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ff := server.NewFixtureFactory(t, 3, []module.Module{
+				groupmodule.Module{AllowAdminProposers: spec.allowAdminProposers},
+			})
+			fixture := ff.Setup()
+			signers := fixture.Signers()
+			admin, member := signers[0].String(), signers[1].String()
+
+			sdkCtx := fixture.Context().(types.Context).WithBlockTime(time.Now().UTC())
+			ctx := types.Context{Context: sdkCtx}
+			msgClient := group.NewMsgClient(fixture.TxConn())
+
+			res, err := msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+				Admin:   admin,
+				Members: []group.Member{{Address: member, Weight: "1"}},
+			})
+			require.NoError(t, err)
+			accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: res.GroupId}
+			require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1})))
+			accountRes, err := msgClient.CreateGroupAccount(ctx, accountReq)
+			require.NoError(t, err)
+
+			_, err = msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+				GroupAccount: accountRes.GroupAccount,
+				Proposers:    []string{signers[spec.proposer].String()},
+			})
+			if spec.expErr {
+				require.Error(t, err)
+				require.True(t, group.ErrNotGroupMember.Is(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTimeoutGranularity(t *testing.T) {
+	specs := map[string]struct {
+		timeoutGranularity time.Duration
+		timeout            gogotypes.Duration
+		expErr             bool
+	}{
+		"sub-second timeout by default": {
+			timeout: gogotypes.Duration{Seconds: 1, Nanos: 1},
+		},
+		"sub-second timeout in strict mode": {
+			timeoutGranularity: time.Second,
+			timeout:            gogotypes.Duration{Seconds: 1, Nanos: 1},
+			expErr:             true,
+		},
+		"whole seconds in strict mode": {
+			timeoutGranularity: time.Second,
+			timeout:            gogotypes.Duration{Seconds: 10},
+		},
+		"not a multiple of a coarser granularity": {
+			timeoutGranularity: time.Minute,
+			timeout:            gogotypes.Duration{Seconds: 90},
+			expErr:             true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ff := server.NewFixtureFactory(t, 2, []module.Module{
+				groupmodule.Module{TimeoutGranularity: spec.timeoutGranularity},
+			})
+			fixture := ff.Setup()
+			signers := fixture.Signers()
+			admin, member := signers[0].String(), signers[1].String()
+
+			sdkCtx := fixture.Context().(types.Context).WithBlockTime(time.Now().UTC())
+			ctx := types.Context{Context: sdkCtx}
+			msgClient := group.NewMsgClient(fixture.TxConn())
+
+			res, err := msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+				Admin:   admin,
+				Members: []group.Member{{Address: member, Weight: "1"}},
+			})
+			require.NoError(t, err)
+			accountReq := &group.MsgCreateGroupAccountRequest{Admin: admin, GroupId: res.GroupId}
+			require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 60})))
+			accountRes, err := msgClient.CreateGroupAccount(ctx, accountReq)
+			require.NoError(t, err)
+
+			accountReq.Metadata = []byte("second account")
+			require.NoError(t, accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", spec.timeout)))
+			_, createErr := msgClient.CreateGroupAccount(ctx, accountReq)
+
+			updateReq := &group.MsgUpdateGroupAccountDecisionPolicyRequest{Admin: admin, GroupAccount: accountRes.GroupAccount}
+			require.NoError(t, updateReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", spec.timeout)))
+			_, updateErr := msgClient.UpdateGroupAccountDecisionPolicy(ctx, updateReq)
+
+			for _, err := range []error{createErr, updateErr} {
+				if spec.expErr {
+					require.Error(t, err)
+					require.True(t, group.ErrInvalid.Is(err))
+					continue
+				}
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type allowAllValidator struct{}
+
+func (allowAllValidator) ValidateCreateGroup(sdk.Context, string, []group.Member) error {
+	return nil
+}
+
+func (allowAllValidator) ValidateAddMember(sdk.Context, group.ID, group.Member) error {
+	return nil
+}
+
+// rejectingValidator rejects groups created by admin and the addition of member.
+type rejectingValidator struct {
+	admin  string
+	member string
+}
+
+func (v *rejectingValidator) ValidateCreateGroup(_ sdk.Context, admin string, _ []group.Member) error {
+	if admin == v.admin {
+		return fmt.Errorf("admin not allowed: %s", admin)
+	}
+	return nil
+}
+
+func (v *rejectingValidator) ValidateAddMember(_ sdk.Context, _ group.ID, member group.Member) error {
+	if member.Address == v.member {
+		return fmt.Errorf("member not allowed: %s", member.Address)
+	}
+	return nil
+}
@@ -11,15 +11,20 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	"github.com/gogo/protobuf/proto"
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	bank "github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/regen-network/regen-ledger/orm"
 	"github.com/regen-network/regen-ledger/testutil/server"
 	"github.com/regen-network/regen-ledger/testutil/testdata"
 	"github.com/regen-network/regen-ledger/types"
@@ -81,6 +86,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		)
 
 		baseApp.Router().AddRoute(sdk.NewRoute(banktypes.ModuleName, bank.NewHandler(s.bankKeeper)))
+		banktypes.RegisterQueryServer(baseApp.GRPCQueryRouter(), s.bankKeeper)
 		baseApp.MountStore(tkey, sdk.StoreTypeTransient)
 		baseApp.MountStore(paramsKey, sdk.StoreTypeIAVL)
 		baseApp.MountStore(authKey, sdk.StoreTypeIAVL)
@@ -716,15 +722,171 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestMemberInvitation() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	invite := func(address, weight string) error {
+		_, err := s.msgClient.InviteMember(ctx, &group.MsgInviteMemberRequest{
+			Admin:   s.addr1.String(),
+			GroupId: groupID,
+			Member:  group.Member{Address: address, Weight: weight},
+		})
+		return err
+	}
+	loadGroup := func() group.GroupInfo {
+		res, err := s.queryClient.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		return *res.Info
+	}
+	loadMembers := func() []string {
+		res, err := s.queryClient.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		var members []string
+		for _, m := range res.Members {
+			members = append(members, m.Member.Address)
+		}
+		return members
+	}
+
+	// pending invitations don't count toward the total weight
+	s.Require().NoError(invite(s.addr3.String(), "2"))
+	s.Require().NoError(invite(s.addr4.String(), "3"))
+	s.Assert().Equal("1", loadGroup().TotalWeight)
+	s.Assert().Equal(uint64(1), loadGroup().Version)
+	s.Assert().ElementsMatch([]string{s.addr2.String()}, loadMembers())
+
+	// accept
+	_, err = s.msgClient.AcceptInvitation(ctx, &group.MsgAcceptInvitationRequest{Invitee: s.addr3.String(), GroupId: groupID})
+	s.Require().NoError(err)
+	s.Assert().Equal("3", loadGroup().TotalWeight)
+	s.Assert().Equal(uint64(2), loadGroup().Version)
+	s.Assert().ElementsMatch([]string{s.addr2.String(), s.addr3.String()}, loadMembers())
+
+	// decline
+	_, err = s.msgClient.DeclineInvitation(ctx, &group.MsgDeclineInvitationRequest{Invitee: s.addr4.String(), GroupId: groupID})
+	s.Require().NoError(err)
+	s.Assert().Equal("3", loadGroup().TotalWeight)
+	s.Assert().ElementsMatch([]string{s.addr2.String(), s.addr3.String()}, loadMembers())
+	_, err = s.msgClient.AcceptInvitation(ctx, &group.MsgAcceptInvitationRequest{Invitee: s.addr4.String(), GroupId: groupID})
+	s.Require().Error(err)
+	s.Assert().True(orm.ErrNotFound.Is(err))
+
+	// already a member or invited
+	err = invite(s.addr2.String(), "1")
+	s.Require().Error(err)
+	s.Assert().True(group.ErrDuplicate.Is(err))
+	err = invite(s.addr3.String(), "1")
+	s.Require().Error(err)
+	s.Assert().True(group.ErrDuplicate.Is(err))
+	s.Require().NoError(invite(s.addr5.String(), "1"))
+	err = invite(s.addr5.String(), "1")
+	s.Require().Error(err)
+	s.Assert().True(group.ErrDuplicate.Is(err))
+
+	// only the group admin can invite
+	_, err = s.msgClient.InviteMember(ctx, &group.MsgInviteMemberRequest{
+		Admin:   s.addr2.String(),
+		GroupId: groupID,
+		Member:  group.Member{Address: s.addr6.String(), Weight: "1"},
+	})
+	s.Require().Error(err)
+	s.Assert().True(sdkerrors.ErrUnauthorized.Is(err))
+}
+
+func (s *IntegrationTestSuite) TestWeightChangeImpact() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "2"},
+		},
+	})
+	s.Require().NoError(err)
+	myGroupID := groupRes.GroupId
+
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: myGroupID,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	_, err = s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	specs := map[string]struct {
+		member    string
+		newWeight string
+		expErr    bool
+	}{
+		"weight increase keeps policies valid": {
+			member:    s.addr2.String(),
+			newWeight: "2",
+		},
+		"new member keeps policies valid": {
+			member:    s.addr4.String(),
+			newWeight: "1",
+		},
+		"weight reduction makes threshold impossible": {
+			member:    s.addr3.String(),
+			newWeight: "0.5",
+			expErr:    true,
+		},
+		"member removal makes threshold impossible": {
+			member:    s.addr3.String(),
+			newWeight: "0",
+			expErr:    true,
+		},
+		"invalid weight": {
+			member:    s.addr2.String(),
+			newWeight: "-1",
+			expErr:    true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			_, err := s.queryClient.WeightChangeImpact(ctx, &group.QueryWeightChangeImpactRequest{
+				GroupId:   myGroupID,
+				Member:    spec.member,
+				NewWeight: spec.newWeight,
+			})
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCreateGroupAccount() {
 	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroupRequest{
 		Admin:    s.addr1.String(),
-		Members:  nil,
+		Members:  []group.Member{{Address: s.addr5.String(), Weight: "1"}},
 		Metadata: nil,
 	})
 	s.Require().NoError(err)
 	myGroupID := groupRes.GroupId
 
+	emptyGroupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroupRequest{
+		Admin:    s.addr1.String(),
+		Members:  nil,
+		Metadata: nil,
+	})
+	s.Require().NoError(err)
+	emptyGroupID := emptyGroupRes.GroupId
+
 	specs := map[string]struct {
 		req    *group.MsgCreateGroupAccountRequest
 		policy group.DecisionPolicy
@@ -752,6 +914,18 @@ func (s *IntegrationTestSuite) TestCreateGroupAccount() {
 				gogotypes.Duration{Seconds: 1},
 			),
 		},
+		"threshold policy on group with zero total weight": {
+			req: &group.MsgCreateGroupAccountRequest{
+				Admin:    s.addr1.String(),
+				Metadata: nil,
+				GroupId:  emptyGroupID,
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"1",
+				gogotypes.Duration{Seconds: 1},
+			),
+			expErr: true,
+		},
 		"group id does not exists": {
 			req: &group.MsgCreateGroupAccountRequest{
 				Admin:    s.addr1.String(),
@@ -823,7 +997,7 @@ func (s *IntegrationTestSuite) TestGroupAccountsByAdminOrGroup() {
 	admin := s.addr2
 	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroupRequest{
 		Admin:    admin.String(),
-		Members:  nil,
+		Members:  []group.Member{{Address: s.addr5.String(), Weight: "1"}},
 		Metadata: nil,
 	})
 	s.Require().NoError(err)
@@ -890,6 +1064,185 @@ func (s *IntegrationTestSuite) TestGroupAccountsByAdminOrGroup() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestFindDuplicateAccounts() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "2"}},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	createAccount := func(threshold string) string {
+		req := &group.MsgCreateGroupAccountRequest{
+			Admin:   s.addr1.String(),
+			GroupId: groupID,
+		}
+		err := req.SetDecisionPolicy(group.NewThresholdDecisionPolicy(threshold, gogotypes.Duration{Seconds: 1}))
+		s.Require().NoError(err)
+		res, err := s.msgClient.CreateGroupAccount(ctx, req)
+		s.Require().NoError(err)
+		return res.GroupAccount
+	}
+	findDuplicates := func() []group.DuplicateGroupAccounts {
+		res, err := s.queryClient.FindDuplicateAccounts(ctx, &group.QueryFindDuplicateAccountsRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		return res.Duplicates
+	}
+
+	first := createAccount("1")
+	createAccount("2")
+	s.Require().Empty(findDuplicates())
+
+	second := createAccount("1")
+	s.Require().Equal([]group.DuplicateGroupAccounts{
+		{GroupAccounts: []string{first, second}},
+	}, findDuplicates())
+}
+
+func (s *IntegrationTestSuite) TestReassignGroupAccount() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	createGroup := func(admin sdk.AccAddress, members ...group.Member) group.ID {
+		res, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+			Admin:   admin.String(),
+			Members: members,
+		})
+		s.Require().NoError(err)
+		return res.GroupId
+	}
+	oldGroupID := createGroup(s.addr1,
+		group.Member{Address: s.addr2.String(), Weight: "1"},
+		group.Member{Address: s.addr3.String(), Weight: "1"},
+	)
+	newGroupID := createGroup(s.addr1, group.Member{Address: s.addr4.String(), Weight: "3"})
+	smallGroupID := createGroup(s.addr1, group.Member{Address: s.addr5.String(), Weight: "1"})
+	otherAdminGroupID := createGroup(s.addr2, group.Member{Address: s.addr4.String(), Weight: "3"})
+
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: oldGroupID,
+	}
+	err := accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr := accountRes.GroupAccount
+
+	reassign := func(admin sdk.AccAddress, groupID group.ID) error {
+		_, err := s.msgClient.ReassignGroupAccount(ctx, &group.MsgReassignGroupAccountRequest{
+			Admin:        admin.String(),
+			GroupAccount: accountAddr,
+			NewGroupId:   groupID,
+		})
+		return err
+	}
+	accountsOf := func(groupID group.ID) []string {
+		res, err := s.queryClient.GroupAccountsByGroup(ctx, &group.QueryGroupAccountsByGroupRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		var addrs []string
+		for _, a := range res.GroupAccounts {
+			addrs = append(addrs, a.GroupAccount)
+		}
+		return addrs
+	}
+
+	// policy threshold is greater than the new group's total weight
+	err = reassign(s.addr1, smallGroupID)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalidThreshold.Is(err))
+
+	// only the admin of the account and new group can reassign
+	err = reassign(s.addr2, newGroupID)
+	s.Require().Error(err)
+	s.Assert().True(sdkerrors.ErrUnauthorized.Is(err))
+	err = reassign(s.addr1, otherAdminGroupID)
+	s.Require().Error(err)
+	s.Assert().True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// same group
+	err = reassign(s.addr1, oldGroupID)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err))
+
+	s.Require().Equal([]string{accountAddr}, accountsOf(oldGroupID))
+	s.Require().NoError(reassign(s.addr1, newGroupID))
+
+	res, err := s.queryClient.GroupAccountInfo(ctx, &group.QueryGroupAccountInfoRequest{GroupAccount: accountAddr})
+	s.Require().NoError(err)
+	s.Assert().Equal(newGroupID, res.Info.GroupId)
+	s.Assert().Equal(uint64(2), res.Info.Version)
+	s.Assert().Empty(accountsOf(oldGroupID))
+	s.Assert().Equal([]string{accountAddr}, accountsOf(newGroupID))
+
+	// proposals can now be submitted by members of the new group only
+	proposalReq := &group.MsgCreateProposalRequest{GroupAccount: accountAddr, Proposers: []string{s.addr2.String()}}
+	_, err = s.msgClient.CreateProposal(ctx, proposalReq)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrNotGroupMember.Is(err))
+	proposalReq.Proposers = []string{s.addr4.String()}
+	_, err = s.msgClient.CreateProposal(ctx, proposalReq)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestUpdateGroupAccountDecisionPolicy() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "2"}},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: s.addr1.String(), GroupId: groupRes.GroupId}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1})))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	updatePolicy := func(admin sdk.AccAddress, policy group.DecisionPolicy) error {
+		req := &group.MsgUpdateGroupAccountDecisionPolicyRequest{Admin: admin.String(), GroupAccount: accountRes.GroupAccount}
+		s.Require().NoError(req.SetDecisionPolicy(policy))
+		_, err := s.msgClient.UpdateGroupAccountDecisionPolicy(ctx, req)
+		return err
+	}
+
+	err = updatePolicy(s.addr2, group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 1}))
+	s.Require().Error(err)
+	s.Assert().True(sdkerrors.ErrUnauthorized.Is(err))
+	err = updatePolicy(s.addr1, group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 1}))
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalidThreshold.Is(err))
+
+	newPolicy := group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 5})
+	s.Require().NoError(updatePolicy(s.addr1, newPolicy))
+	res, err := s.queryClient.GroupAccountInfo(ctx, &group.QueryGroupAccountInfoRequest{GroupAccount: accountRes.GroupAccount})
+	s.Require().NoError(err)
+	s.Assert().Equal(uint64(2), res.Info.Version)
+	s.Assert().Equal(newPolicy, res.Info.GetDecisionPolicy())
+}
+
+func (s *IntegrationTestSuite) TestGroupAccountDecisionPolicy() {
+	res, err := s.queryClient.GroupAccountDecisionPolicy(s.ctx, &group.QueryGroupAccountDecisionPolicyRequest{
+		GroupAccount: s.groupAccountAddr.String(),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ThresholdPolicyType, res.PolicyType)
+
+	policy, ok := res.GetPolicy().(*group.ThresholdDecisionPolicy)
+	s.Require().True(ok)
+	s.Assert().Equal("1", policy.Threshold)
+	s.Assert().Equal(gogotypes.Duration{Seconds: 1}, policy.Timeout)
+
+	// unknown group account
+	_, err = s.queryClient.GroupAccountDecisionPolicy(s.ctx, &group.QueryGroupAccountDecisionPolicyRequest{
+		GroupAccount: s.addr5.String(),
+	})
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestCreateProposal() {
 	myGroupID := s.groupID
 
@@ -1051,6 +1404,85 @@ func (s *IntegrationTestSuite) TestCreateProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestProposalIDSequence() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+	proposers := []string{s.addr2.String()}
+
+	next, err := s.queryClient.GetNextProposalID(ctx, &group.QueryGetNextProposalIDRequest{})
+	s.Require().NoError(err)
+	first := createProposal(ctx, s, nil, proposers)
+	s.Assert().Equal(next.ProposalId, first)
+	second := createProposal(ctx, s, nil, proposers)
+	s.Assert().Equal(first+1, second)
+
+	// a failed proposal submission doesn't consume an ID
+	_, err = s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: s.groupAccountAddr.String(),
+		Proposers:    []string{s.addr4.String()},
+	})
+	s.Require().Error(err)
+	next, err = s.queryClient.GetNextProposalID(ctx, &group.QueryGetNextProposalIDRequest{})
+	s.Require().NoError(err)
+	s.Assert().Equal(second+1, next.ProposalId)
+	third := createProposal(ctx, s, nil, proposers)
+	s.Assert().Equal(second+1, third)
+}
+
+func (s *IntegrationTestSuite) TestProposalsByStatus() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+	proposers := []string{s.addr2.String()}
+
+	// countByStatus pages through all proposals with the given status
+	countByStatus := func(status group.Proposal_Status) int {
+		var count int
+		pageReq := &query.PageRequest{Limit: 1}
+		for {
+			res, err := s.queryClient.ProposalsByStatus(ctx, &group.QueryProposalsByStatusRequest{
+				Status:     status,
+				Pagination: pageReq,
+			})
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(len(res.Proposals), 1)
+			for _, p := range res.Proposals {
+				s.Assert().Equal(status, p.Status)
+				count++
+			}
+			if res.Pagination.NextKey == nil {
+				return count
+			}
+			pageReq = &query.PageRequest{Limit: 1, Key: res.Pagination.NextKey}
+		}
+	}
+	initialClosed := countByStatus(group.ProposalStatusClosed)
+	initialSubmitted := countByStatus(group.ProposalStatusSubmitted)
+	initialAborted := countByStatus(group.ProposalStatusAborted)
+
+	for i := 0; i < 3; i++ {
+		createProposalAndVote(ctx, s, nil, proposers, group.Choice_CHOICE_YES)
+	}
+	createProposal(ctx, s, nil, proposers)
+
+	// abort a proposal by modifying the group before tally
+	aborted := createProposal(ctx, s, nil, proposers)
+	_, err := s.msgClient.UpdateGroupMetadata(ctx, &group.MsgUpdateGroupMetadataRequest{
+		Admin:    s.addr1.String(),
+		GroupId:  s.groupID,
+		Metadata: []byte{1, 2, 3},
+	})
+	s.Require().NoError(err)
+	_, err = s.msgClient.Exec(ctx, &group.MsgExecRequest{Signer: s.addr1.String(), ProposalId: aborted})
+	s.Require().NoError(err)
+	res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: aborted})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalStatusAborted, res.Proposal.Status)
+
+	s.Assert().Equal(initialClosed+3, countByStatus(group.ProposalStatusClosed))
+	s.Assert().Equal(initialSubmitted+1, countByStatus(group.ProposalStatusSubmitted))
+	s.Assert().Equal(initialAborted+1, countByStatus(group.ProposalStatusAborted))
+}
+
 func (s *IntegrationTestSuite) TestVote() {
 	members := []group.Member{
 		{Address: s.addr2.String(), Weight: "1"},
@@ -1180,6 +1612,7 @@ func (s *IntegrationTestSuite) TestVote() {
 				NoCount:      "0",
 				AbstainCount: "0",
 				VetoCount:    "1",
+				VetoVoters:   1,
 			},
 			expProposalStatus: group.ProposalStatusSubmitted,
 			expResult:         group.ProposalResultUnfinalized,
@@ -1432,79 +1865,1376 @@ func (s *IntegrationTestSuite) TestVote() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestDoExecuteMsgs() {
-	msgSend := &banktypes.MsgSend{
-		FromAddress: s.groupAccountAddr.String(),
-		ToAddress:   s.addr2.String(),
-		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+func (s *IntegrationTestSuite) TestPluralityProposal() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "2"},
+			{Address: s.addr3.String(), Weight: "3"},
+			{Address: s.addr4.String(), Weight: "2"},
+		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
 	}
+	err = accountReq.SetDecisionPolicy(group.NewPluralityDecisionPolicy("4", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	pluralityAccount := accountRes.GroupAccount
+	optionSet := &group.OptionSet{Options: []string{"A", "B", "C"}}
 
-	unauthzMsgSend := &banktypes.MsgSend{
-		FromAddress: s.addr1.String(),
-		ToAddress:   s.addr2.String(),
-		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	// option set and plurality policy require each other
+	_, err = s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: pluralityAccount,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrEmpty.Is(err))
+	_, err = s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: s.groupAccountAddr.String(),
+		Proposers:    []string{s.addr2.String()},
+		OptionSet:    optionSet,
+	})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err))
+
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: pluralityAccount,
+		Proposers:    []string{s.addr2.String()},
+		OptionSet:    optionSet,
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	vote := func(voter sdk.AccAddress, choice group.Choice, option uint32) error {
+		_, err := s.msgClient.Vote(ctx, &group.MsgVoteRequest{
+			ProposalId: proposalID,
+			Voter:      voter.String(),
+			Choice:     choice,
+			Option:     option,
+		})
+		return err
+	}
+	getProposal := func() *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
 	}
 
-	specs := map[string]struct {
-		srcMsgs    []sdk.Msg
-		srcHandler sdk.Handler
-		expErr     bool
-	}{
-		"all good": {
-			srcMsgs: []sdk.Msg{msgSend},
-		},
-		"not authz by group account": {
-			srcMsgs: []sdk.Msg{unauthzMsgSend},
-			expErr:  true,
-		},
-		"mixed group account msgs": {
-			srcMsgs: []sdk.Msg{
-				msgSend,
-				unauthzMsgSend,
-			},
-			expErr: true,
-		},
-		"no handler": {
-			srcMsgs: []sdk.Msg{&testdata.MsgAuthenticated{Signers: []sdk.AccAddress{s.groupAccountAddr}}},
-			expErr:  true,
+	// yes/no votes and unknown options are rejected
+	err = vote(s.addr2, group.Choice_CHOICE_YES, 0)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err))
+	err = vote(s.addr2, group.Choice_CHOICE_OPTION, 3)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err))
+
+	s.Require().NoError(vote(s.addr2, group.Choice_CHOICE_OPTION, 0))
+	s.Require().NoError(vote(s.addr3, group.Choice_CHOICE_OPTION, 1))
+	proposal := getProposal()
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	s.Assert().Equal([]string{"2", "3", "0"}, proposal.VoteState.OptionCounts)
+
+	// B wins with 3 of 7 once all members voted
+	s.Require().NoError(vote(s.addr4, group.Choice_CHOICE_OPTION, 2))
+	proposal = getProposal()
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+	s.Assert().Equal([]string{"2", "3", "2"}, proposal.VoteState.OptionCounts)
+	winner, ok, err := proposal.VoteState.LeadingOption()
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Assert().Equal("B", proposal.OptionSet.Options[winner])
+
+	// plain proposals don't accept option votes
+	plainID := createProposal(ctx, s, nil, []string{s.addr2.String()})
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{
+		ProposalId: plainID,
+		Voter:      s.addr2.String(),
+		Choice:     group.Choice_CHOICE_OPTION,
+	})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err))
+}
+
+func (s *IntegrationTestSuite) TestBicameralProposal() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1", Role: "engineering"},
+			{Address: s.addr3.String(), Weight: "1", Role: "engineering"},
+			{Address: s.addr4.String(), Weight: "1", Role: "finance"},
+			{Address: s.addr5.String(), Weight: "1"},
 		},
-		"not panic on nil result": {
-			srcMsgs: []sdk.Msg{&testdata.MsgAuthenticated{Signers: []sdk.AccAddress{s.groupAccountAddr}}},
-			srcHandler: func(ctx sdk.Context, msg sdk.Msg) (result *sdk.Result, err error) {
-				return nil, nil
-			},
+		RoleMultipliers: []group.RoleMultiplier{
+			{Role: "engineering", Multiplier: "1"},
+			{Role: "finance", Multiplier: "1"},
 		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
 	}
-	for msg, spec := range specs {
-		spec := spec
-		s.Run(msg, func() {
-			ctx, _ := s.sdkCtx.CacheContext()
+	policy := group.NewBicameralDecisionPolicy(
+		group.Chamber{Role: "engineering", Threshold: "2"},
+		group.Chamber{Role: "finance", Threshold: "1"},
+		gogotypes.Duration{Seconds: 10},
+	)
+	s.Require().NoError(accountReq.SetDecisionPolicy(policy))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
 
-			var router sdk.Router
-			if spec.srcHandler != nil {
-				router = baseapp.NewRouter().AddRoute(sdk.NewRoute("MsgAuthenticated", spec.srcHandler))
-			} else {
-				router = baseapp.NewRouter().AddRoute(sdk.NewRoute(banktypes.ModuleName, bank.NewHandler(s.bankKeeper)))
-			}
-			_, err := groupserver.DoExecuteMsgs(ctx, router, s.groupAccountAddr, spec.srcMsgs)
-			if spec.expErr {
-				s.Require().Error(err)
-				return
-			}
-			s.Require().NoError(err)
+	createProposal := func() group.ProposalID {
+		res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{s.addr2.String()},
 		})
+		s.Require().NoError(err)
+		return res.ProposalId
 	}
-}
-
-func (s *IntegrationTestSuite) TestExecProposal() {
-	msgSend := &banktypes.MsgSend{
-		FromAddress: s.groupAccountAddr.String(),
-		ToAddress:   s.addr2.String(),
-		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	vote := func(id group.ProposalID, voter sdk.AccAddress, choice group.Choice) {
+		_, err := s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: voter.String(), Choice: choice})
+		s.Require().NoError(err)
+	}
+	getProposal := func(ctx context.Context, id group.ProposalID) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		return res.Proposal
 	}
-	proposers := []string{s.addr2.String()}
 
-	specs := map[string]struct {
+	// only the engineering chamber approves
+	engineeringOnly := createProposal()
+	vote(engineeringOnly, s.addr2, group.Choice_CHOICE_YES)
+	vote(engineeringOnly, s.addr3, group.Choice_CHOICE_YES)
+	vote(engineeringOnly, s.addr4, group.Choice_CHOICE_NO)
+	// votes of members without a chamber role don't count for any chamber
+	vote(engineeringOnly, s.addr5, group.Choice_CHOICE_YES)
+	proposal := getProposal(ctx, engineeringOnly)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	s.Assert().Equal("2", proposal.VoteState.RoleTally("engineering").YesCount)
+	s.Assert().Equal("1", proposal.VoteState.RoleTally("finance").NoCount)
+
+	timeoutCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(10 * time.Second))}
+	_, err = s.msgClient.Exec(timeoutCtx, &group.MsgExecRequest{ProposalId: engineeringOnly, Signer: s.addr1.String()})
+	s.Require().NoError(err)
+	proposal = getProposal(timeoutCtx, engineeringOnly)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultRejected, proposal.Result)
+
+	// both chambers approve
+	bothChambers := createProposal()
+	vote(bothChambers, s.addr2, group.Choice_CHOICE_YES)
+	vote(bothChambers, s.addr4, group.Choice_CHOICE_YES)
+	s.Assert().Equal(group.ProposalStatusSubmitted, getProposal(ctx, bothChambers).Status)
+	vote(bothChambers, s.addr3, group.Choice_CHOICE_YES)
+	proposal = getProposal(ctx, bothChambers)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+
+	// no proposals when a chamber role isn't defined by the group
+	accountReq = &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewBicameralDecisionPolicy(
+		group.Chamber{Role: "engineering", Threshold: "2"},
+		group.Chamber{Role: "legal", Threshold: "1"},
+		gogotypes.Duration{Seconds: 10},
+	)))
+	accountRes, err = s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	_, err = s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "unknown role legal")
+}
+
+func (s *IntegrationTestSuite) TestAbstainCountsTowardQuorum() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "1"},
+			{Address: s.addr5.String(), Weight: "1"},
+			{Address: s.addr6.String(), Weight: "1"},
+		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	policy := group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 10}).(*group.ThresholdDecisionPolicy)
+	policy.Quorum = "4"
+	s.Require().NoError(accountReq.SetDecisionPolicy(policy))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	createProposal := func() group.ProposalID {
+		res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{s.addr2.String()},
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(ctx context.Context, id group.ProposalID, voter sdk.AccAddress, choice group.Choice) {
+		_, err := s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: id, Voter: voter.String(), Choice: choice})
+		s.Require().NoError(err)
+	}
+	getProposal := func(ctx context.Context, id group.ProposalID) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+
+	withAbstains, yesOnly := createProposal(), createProposal()
+	for _, id := range []group.ProposalID{withAbstains, yesOnly} {
+		vote(ctx, id, s.addr2, group.Choice_CHOICE_YES)
+		vote(ctx, id, s.addr3, group.Choice_CHOICE_YES)
+		// threshold reached but quorum not
+		s.Assert().Equal(group.ProposalStatusSubmitted, getProposal(ctx, id).Status)
+	}
+
+	// abstains right before the timeout reach the quorum
+	lateCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(10*time.Second - time.Nanosecond))}
+	vote(lateCtx, withAbstains, s.addr4, group.Choice_CHOICE_ABSTAIN)
+	s.Assert().Equal(group.ProposalStatusSubmitted, getProposal(lateCtx, withAbstains).Status)
+	vote(lateCtx, withAbstains, s.addr5, group.Choice_CHOICE_ABSTAIN)
+	proposal := getProposal(lateCtx, withAbstains)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+
+	// without the abstains the quorum isn't reached before the timeout
+	timeoutCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(10 * time.Second))}
+	_, err = s.msgClient.Exec(timeoutCtx, &group.MsgExecRequest{ProposalId: yesOnly, Signer: s.addr1.String()})
+	s.Require().NoError(err)
+	proposal = getProposal(timeoutCtx, yesOnly)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultRejected, proposal.Result)
+}
+
+func (s *IntegrationTestSuite) TestVotePresent() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "1"},
+		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	policy := group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 10}).(*group.ThresholdDecisionPolicy)
+	policy.Quorum = "2"
+	s.Require().NoError(accountReq.SetDecisionPolicy(policy))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr3.String(), Choice: group.Choice_CHOICE_PRESENT})
+	s.Require().NoError(err)
+
+	// the present vote is recorded
+	voteRes, err := s.queryClient.VoteByProposalVoter(ctx, &group.QueryVoteByProposalVoterRequest{ProposalId: proposalID, Voter: s.addr3.String()})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.Choice_CHOICE_PRESENT, voteRes.Vote.Choice)
+	votesRes, err := s.queryClient.VotesByProposal(ctx, &group.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Len(votesRes.Votes, 2)
+
+	// but neither counted in the tally nor toward the quorum
+	proposalQueryRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	proposal := proposalQueryRes.Proposal
+	s.Assert().Equal(group.Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"}, proposal.VoteState)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+
+	// an abstain vote reaches the quorum
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr4.String(), Choice: group.Choice_CHOICE_ABSTAIN})
+	s.Require().NoError(err)
+	proposalQueryRes, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusClosed, proposalQueryRes.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposalQueryRes.Proposal.Result)
+}
+
+func (s *IntegrationTestSuite) TestSimulateOutcome() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "2"},
+		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: s.addr1.String(), GroupId: groupRes.GroupId}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+
+	specs := map[string]struct {
+		atTime   time.Time
+		expAllow bool
+		expFinal bool
+	}{
+		"before timeout": {
+			atTime: s.blockTime.Add(5 * time.Second),
+		},
+		"at timeout": {
+			atTime:   s.blockTime.Add(10 * time.Second),
+			expFinal: true,
+		},
+		"after timeout": {
+			atTime:   s.blockTime.Add(time.Hour),
+			expFinal: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			atTime, err := gogotypes.TimestampProto(spec.atTime)
+			s.Require().NoError(err)
+			res, err := s.queryClient.SimulateOutcome(ctx, &group.QuerySimulateOutcomeRequest{ProposalId: proposalID, AtTime: *atTime})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expAllow, res.Allow)
+			s.Assert().Equal(spec.expFinal, res.Final)
+		})
+	}
+
+	// the simulation doesn't change the proposal
+	proposalQueryRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposalQueryRes.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultUnfinalized, proposalQueryRes.Proposal.Result)
+
+	_, err = s.queryClient.SimulateOutcome(ctx, &group.QuerySimulateOutcomeRequest{ProposalId: 9999, AtTime: proposalQueryRes.Proposal.Timeout})
+	s.Require().Error(err)
+	s.Assert().True(orm.ErrNotFound.Is(err))
+}
+
+func (s *IntegrationTestSuite) TestEvaluatePolicy() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	specs := map[string]struct {
+		srcPolicy     *group.ThresholdDecisionPolicy
+		srcTally      group.Tally
+		srcTotalPower string
+		srcElapsed    time.Duration
+		expResult     group.DecisionPolicyResult
+	}{
+		"accept when yes count greater than threshold": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "1", Timeout: gogotypes.Duration{Seconds: 1}},
+			srcTally:      group.Tally{YesCount: "2"},
+			srcTotalPower: "3",
+			srcElapsed:    time.Millisecond,
+			expResult:     group.DecisionPolicyResult{Allow: true, Final: true, Reason: group.ResultReasonThresholdReached},
+		},
+		"accept when yes count equal to threshold": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "1", Timeout: gogotypes.Duration{Seconds: 1}},
+			srcTally:      group.Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			srcElapsed:    time.Millisecond,
+			expResult:     group.DecisionPolicyResult{Allow: true, Final: true, Reason: group.ResultReasonThresholdReached},
+		},
+		"not final before voting started": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "1", Timeout: gogotypes.Duration{Seconds: 1}},
+			srcTally:      group.Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			srcElapsed:    -time.Millisecond,
+			expResult:     group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject when yes count lower to threshold": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "1", Timeout: gogotypes.Duration{Seconds: 1}},
+			srcTally:      group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			srcElapsed:    time.Millisecond,
+			expResult:     group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final when remaining votes can't cross threshold": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "2", Timeout: gogotypes.Duration{Seconds: 1}},
+			srcTally:      group.Tally{YesCount: "0", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			srcElapsed:    time.Millisecond,
+			expResult:     group.DecisionPolicyResult{Allow: false, Final: true, Reason: group.ResultReasonThresholdNotReachable},
+		},
+		"expired when on timeout": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "1", Timeout: gogotypes.Duration{Seconds: 1}},
+			srcTally:      group.Tally{YesCount: "2"},
+			srcTotalPower: "3",
+			srcElapsed:    time.Second,
+			expResult:     group.DecisionPolicyResult{Allow: false, Final: true, Reason: group.ResultReasonExpired},
+		},
+		"abstain has no impact": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "1", Timeout: gogotypes.Duration{Seconds: 1}},
+			srcTally:      group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "1", VetoCount: "0"},
+			srcTotalPower: "3",
+			srcElapsed:    time.Millisecond,
+			expResult:     group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final when quorum can't be reached": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "2", Timeout: gogotypes.Duration{Seconds: 1}, Quorum: "5"},
+			srcTally:      group.Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "4",
+			srcElapsed:    time.Millisecond,
+			expResult:     group.DecisionPolicyResult{Allow: false, Final: true, Reason: group.ResultReasonQuorumNotReachable},
+		},
+		"veto reject beats threshold accept": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "2", Timeout: gogotypes.Duration{Seconds: 1}, Quorum: "3", VetoThreshold: "1"},
+			srcTally:      group.Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower: "4",
+			srcElapsed:    time.Millisecond,
+			expResult:     group.DecisionPolicyResult{Allow: false, Final: true, Reason: group.ResultReasonVetoed},
+		},
+		"heavy veto flips outcome": {
+			srcPolicy:     &group.ThresholdDecisionPolicy{Threshold: "2", Timeout: gogotypes.Duration{Seconds: 1}, VetoDampingFactor: "0.5"},
+			srcTally:      group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "3"},
+			srcTotalPower: "6",
+			srcElapsed:    time.Millisecond,
+			expResult:     group.DecisionPolicyResult{Allow: false, Final: true, Reason: group.ResultReasonThresholdNotReachable},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			req, err := group.NewQueryEvaluatePolicyRequest(spec.srcPolicy, spec.srcTally, spec.srcTotalPower, spec.srcElapsed)
+			s.Require().NoError(err)
+			res, err := s.queryClient.EvaluatePolicy(ctx, req)
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expResult, group.DecisionPolicyResult{Allow: res.Allow, Final: res.Final, Reason: res.Reason})
+		})
+	}
+
+	// invalid tally
+	req, err := group.NewQueryEvaluatePolicyRequest(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1}), group.Tally{YesCount: "-1"}, "3", time.Millisecond)
+	s.Require().NoError(err)
+	_, err = s.queryClient.EvaluatePolicy(ctx, req)
+	s.Require().Error(err)
+
+	// empty policy
+	_, err = s.queryClient.EvaluatePolicy(ctx, &group.QueryEvaluatePolicyRequest{TotalPower: "3"})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrEmpty.Is(err))
+}
+
+func (s *IntegrationTestSuite) TestCachedFinalResult() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "1"},
+		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewPercentageDecisionPolicy("0.5", gogotypes.Duration{Seconds: 10}, false)))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	// half of the total weight votes yes, which finalizes the proposal
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+	proposalQueryRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalStatusClosed, proposalQueryRes.Proposal.Status)
+	s.Require().Equal(group.ProposalResultAccepted, proposalQueryRes.Proposal.Result)
+	s.Assert().Equal("0.5", proposalQueryRes.Decisiveness)
+
+	// with the increased total weight a re-evaluation would reject the proposal
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         s.addr1.String(),
+		GroupId:       groupRes.GroupId,
+		MemberUpdates: []group.Member{{Address: s.addr4.String(), Weight: "10"}},
+	})
+	s.Require().NoError(err)
+
+	timeout := proposalQueryRes.Proposal.Timeout
+	res, err := s.queryClient.SimulateOutcome(ctx, &group.QuerySimulateOutcomeRequest{ProposalId: proposalID, AtTime: timeout})
+	s.Require().NoError(err)
+	s.Assert().True(res.Allow)
+	s.Assert().True(res.Final)
+
+	timeoutCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(10 * time.Second))}
+	_, err = s.msgClient.Exec(timeoutCtx, &group.MsgExecRequest{ProposalId: proposalID, Signer: s.addr1.String()})
+	s.Require().NoError(err)
+	proposalQueryRes, err = s.queryClient.Proposal(timeoutCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusClosed, proposalQueryRes.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposalQueryRes.Proposal.Result)
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, proposalQueryRes.Proposal.ExecutorResult)
+}
+
+func (s *IntegrationTestSuite) TestProposalResultReason() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "2"},
+		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	s.Require().NoError(accountReq.SetDecisionPolicy(&group.ThresholdDecisionPolicy{
+		Threshold:     "2",
+		Timeout:       gogotypes.Duration{Seconds: 10},
+		VetoThreshold: "1",
+	}))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	createProposal := func() group.ProposalID {
+		proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{s.addr2.String()},
+		})
+		s.Require().NoError(err)
+		return proposalRes.ProposalId
+	}
+
+	// a single veto vote finalizes the proposal as rejected
+	vetoedID := createProposal()
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: vetoedID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_VETO})
+	s.Require().NoError(err)
+	proposalQueryRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: vetoedID})
+	s.Require().NoError(err)
+	result, reason := proposalQueryRes.Proposal.GetProposalResult()
+	s.Assert().Equal(group.ProposalResultRejected, result)
+	s.Assert().Equal(group.ResultReasonVetoed, reason)
+
+	// without votes the proposal is rejected when executed after the timeout
+	expiredID := createProposal()
+	proposalQueryRes, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: expiredID})
+	s.Require().NoError(err)
+	result, reason = proposalQueryRes.Proposal.GetProposalResult()
+	s.Assert().Equal(group.ProposalResultUnfinalized, result)
+	s.Assert().Empty(reason)
+
+	timeoutCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(10 * time.Second))}
+	simulateRes, err := s.queryClient.SimulateOutcome(timeoutCtx, &group.QuerySimulateOutcomeRequest{
+		ProposalId: expiredID,
+		AtTime:     proposalQueryRes.Proposal.Timeout,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ResultReasonExpired, simulateRes.Reason)
+
+	_, err = s.msgClient.Exec(timeoutCtx, &group.MsgExecRequest{ProposalId: expiredID, Signer: s.addr1.String()})
+	s.Require().NoError(err)
+	proposalQueryRes, err = s.queryClient.Proposal(timeoutCtx, &group.QueryProposalRequest{ProposalId: expiredID})
+	s.Require().NoError(err)
+	result, reason = proposalQueryRes.Proposal.GetProposalResult()
+	s.Assert().Equal(group.ProposalResultRejected, result)
+	s.Assert().Equal(group.ResultReasonExpired, reason)
+}
+
+func (s *IntegrationTestSuite) TestRevokeOnRemoval() {
+	specs := map[string]struct {
+		revokeOnRemoval bool
+		expYesCount     string
+		expVotes        int
+		expDecidable    bool
+	}{
+		"revoke on removal": {
+			revokeOnRemoval: true,
+			expYesCount:     "0",
+			expVotes:        1,
+			expDecidable:    true,
+		},
+		"keep votes on removal": {
+			revokeOnRemoval: false,
+			expYesCount:     "1",
+			expVotes:        2,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			ctx := types.Context{Context: sdkCtx}
+
+			groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+				Admin: s.addr1.String(),
+				Members: []group.Member{
+					{Address: s.addr2.String(), Weight: "1"},
+					{Address: s.addr3.String(), Weight: "2"},
+					{Address: s.addr4.String(), Weight: "3"},
+				},
+				RevokeOnRemoval: spec.revokeOnRemoval,
+			})
+			s.Require().NoError(err)
+			accountReq := &group.MsgCreateGroupAccountRequest{
+				Admin:   s.addr1.String(),
+				GroupId: groupRes.GroupId,
+			}
+			s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 10})))
+			accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+			s.Require().NoError(err)
+			proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+				GroupAccount: accountRes.GroupAccount,
+				Proposers:    []string{s.addr2.String()},
+			})
+			s.Require().NoError(err)
+			proposalID := proposalRes.ProposalId
+
+			_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+			s.Require().NoError(err)
+			_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr3.String(), Choice: group.Choice_CHOICE_NO})
+			s.Require().NoError(err)
+
+			// remove the member who voted yes
+			_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+				Admin:         s.addr1.String(),
+				GroupId:       groupRes.GroupId,
+				MemberUpdates: []group.Member{{Address: s.addr2.String(), Weight: "0"}},
+			})
+			s.Require().NoError(err)
+
+			proposalQueryRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Assert().Equal(group.ProposalStatusSubmitted, proposalQueryRes.Proposal.Status)
+			s.Assert().Equal(spec.expYesCount, proposalQueryRes.Proposal.VoteState.YesCount)
+			s.Assert().Equal("2", proposalQueryRes.Proposal.VoteState.NoCount)
+
+			votesRes, err := s.queryClient.VotesByProposal(ctx, &group.QueryVotesByProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Assert().Len(votesRes.Votes, spec.expVotes)
+
+			// the proposal can only still be decided if the removal revoked the votes,
+			// other removals modify the group
+			_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr4.String(), Choice: group.Choice_CHOICE_YES})
+			if !spec.expDecidable {
+				s.Require().Error(err)
+				s.Assert().True(group.ErrModified.Is(err), err)
+				return
+			}
+			s.Require().NoError(err)
+			proposalQueryRes, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Assert().Equal(group.ProposalStatusClosed, proposalQueryRes.Proposal.Status)
+			s.Assert().Equal(group.ProposalResultAccepted, proposalQueryRes.Proposal.Result)
+			s.Assert().Equal("3", proposalQueryRes.Proposal.VoteState.YesCount)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCouncilSeats() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+		Seats:   3,
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	// fill all seats
+	for _, addr := range []sdk.AccAddress{s.addr3, s.addr4} {
+		_, err = s.msgClient.AssignSeat(ctx, &group.MsgAssignSeatRequest{Admin: s.addr1.String(), GroupId: groupID, Member: addr.String()})
+		s.Require().NoError(err)
+	}
+	groupInfoRes, err := s.queryClient.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Assert().Equal("3", groupInfoRes.Info.TotalWeight)
+
+	// a member can't hold two seats
+	_, err = s.msgClient.AssignSeat(ctx, &group.MsgAssignSeatRequest{Admin: s.addr1.String(), GroupId: groupID, Member: s.addr3.String()})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrDuplicate.Is(err))
+
+	// over capacity adds are rejected
+	_, err = s.msgClient.AssignSeat(ctx, &group.MsgAssignSeatRequest{Admin: s.addr1.String(), GroupId: groupID, Member: s.addr5.String()})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrMaxLimit.Is(err))
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         s.addr1.String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: s.addr5.String(), Weight: "1"}},
+	})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrMaxLimit.Is(err))
+
+	// seats can't be weighted
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         s.addr1.String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: s.addr2.String(), Weight: "2"}},
+	})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err))
+
+	// a majority of equal seats accepts a proposal
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: s.addr1.String(), GroupId: groupID}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+	votes := []struct {
+		voter  sdk.AccAddress
+		choice group.Choice
+	}{
+		{s.addr4, group.Choice_CHOICE_NO},
+		{s.addr2, group.Choice_CHOICE_YES},
+		{s.addr3, group.Choice_CHOICE_YES},
+	}
+	for _, v := range votes {
+		_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: v.voter.String(), Choice: v.choice})
+		s.Require().NoError(err)
+	}
+	proposalQueryRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusClosed, proposalQueryRes.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposalQueryRes.Proposal.Result)
+	s.Assert().Equal("2", proposalQueryRes.Proposal.VoteState.YesCount)
+	s.Assert().Equal("1", proposalQueryRes.Proposal.VoteState.NoCount)
+
+	// a vacated seat can be assigned again
+	_, err = s.msgClient.VacateSeat(ctx, &group.MsgVacateSeatRequest{Admin: s.addr1.String(), GroupId: groupID, Member: s.addr4.String()})
+	s.Require().NoError(err)
+	_, err = s.msgClient.VacateSeat(ctx, &group.MsgVacateSeatRequest{Admin: s.addr1.String(), GroupId: groupID, Member: s.addr4.String()})
+	s.Require().Error(err)
+	_, err = s.msgClient.AssignSeat(ctx, &group.MsgAssignSeatRequest{Admin: s.addr1.String(), GroupId: groupID, Member: s.addr5.String()})
+	s.Require().NoError(err)
+
+	// seats are only assigned in council groups
+	_, err = s.msgClient.AssignSeat(ctx, &group.MsgAssignSeatRequest{Admin: s.addr1.String(), GroupId: s.groupID, Member: s.addr5.String()})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err))
+}
+
+func (s *IntegrationTestSuite) TestPauseProposal() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+	atTime := func(d time.Duration) types.Context {
+		return types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(d))}
+	}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "2"},
+		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	// only the group account admin can pause
+	_, err = s.msgClient.PauseProposal(atTime(2*time.Second), &group.MsgPauseProposalRequest{Admin: s.addr2.String(), ProposalId: proposalID})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrUnauthorized.Is(err))
+
+	_, err = s.msgClient.PauseProposal(atTime(2*time.Second), &group.MsgPauseProposalRequest{Admin: s.addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+	_, err = s.msgClient.PauseProposal(atTime(3*time.Second), &group.MsgPauseProposalRequest{Admin: s.addr1.String(), ProposalId: proposalID})
+	s.Require().Error(err)
+
+	// votes and execution are rejected while paused
+	_, err = s.msgClient.Vote(atTime(3*time.Second), &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "proposal is paused")
+	_, err = s.msgClient.Exec(atTime(3*time.Second), &group.MsgExecRequest{ProposalId: proposalID, Signer: s.addr1.String()})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "proposal is paused")
+
+	// the voting duration doesn't advance while paused
+	proposalQueryRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().True(proposalQueryRes.Proposal.Paused())
+	votingDuration, err := proposalQueryRes.Proposal.VotingDuration(s.blockTime.Add(20 * time.Second))
+	s.Require().NoError(err)
+	s.Assert().Equal(2*time.Second, votingDuration)
+
+	_, err = s.msgClient.ResumeProposal(atTime(7*time.Second), &group.MsgResumeProposalRequest{Admin: s.addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+	_, err = s.msgClient.ResumeProposal(atTime(7*time.Second), &group.MsgResumeProposalRequest{Admin: s.addr1.String(), ProposalId: proposalID})
+	s.Require().Error(err)
+
+	// the deadline shifted by the paused duration
+	proposalQueryRes, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	proposal := proposalQueryRes.Proposal
+	s.Assert().False(proposal.Paused())
+	s.Assert().Equal(gogotypes.Duration{Seconds: 5}, proposal.PausedDuration)
+	timeout, err := gogotypes.TimestampFromProto(&proposal.Timeout)
+	s.Require().NoError(err)
+	s.Assert().True(s.blockTime.Add(15*time.Second).Equal(timeout), timeout)
+
+	// votes are accepted after the original deadline
+	_, err = s.msgClient.Vote(atTime(14*time.Second), &group.MsgVoteRequest{ProposalId: proposalID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+	simulateAt, err := gogotypes.TimestampProto(s.blockTime.Add(14 * time.Second))
+	s.Require().NoError(err)
+	simulateRes, err := s.queryClient.SimulateOutcome(ctx, &group.QuerySimulateOutcomeRequest{
+		ProposalId: proposalID,
+		AtTime:     *simulateAt,
+	})
+	s.Require().NoError(err)
+	s.Assert().False(simulateRes.Final)
+
+	// and the proposal times out at the shifted deadline
+	_, err = s.msgClient.Exec(atTime(15*time.Second), &group.MsgExecRequest{ProposalId: proposalID, Signer: s.addr1.String()})
+	s.Require().NoError(err)
+	proposalQueryRes, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalResultRejected, proposalQueryRes.Proposal.Result)
+	s.Assert().Equal(group.ResultReasonExpired, proposalQueryRes.Proposal.ResultReason)
+}
+
+func (s *IntegrationTestSuite) TestVotesByVoterHistory() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "2"},
+		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("3", gogotypes.Duration{Seconds: 1})))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	var proposalIDs []group.ProposalID
+	for i := 0; i < 2; i++ {
+		proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{s.addr2.String()},
+		})
+		s.Require().NoError(err)
+		proposalIDs = append(proposalIDs, proposalRes.ProposalId)
+	}
+	history := func() []*group.Vote {
+		res, err := s.queryClient.VotesByVoter(ctx, &group.QueryVotesByVoterRequest{Voter: s.addr2.String()})
+		s.Require().NoError(err)
+		return res.Votes
+	}
+	s.Require().Empty(history())
+
+	first := &group.MsgVoteRequest{ProposalId: proposalIDs[0], Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES, Nonce: []byte("nonce")}
+	_, err = s.msgClient.Vote(ctx, first)
+	s.Require().NoError(err)
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalIDs[1], Voter: s.addr2.String(), Choice: group.Choice_CHOICE_NO})
+	s.Require().NoError(err)
+	// votes of other members aren't part of the history
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalIDs[0], Voter: s.addr3.String(), Choice: group.Choice_CHOICE_NO})
+	s.Require().NoError(err)
+
+	votes := history()
+	s.Require().Len(votes, 2)
+	s.Assert().Equal(proposalIDs[0], votes[0].ProposalId)
+	s.Assert().Equal(group.Choice_CHOICE_YES, votes[0].Choice)
+	s.Assert().Equal(proposalIDs[1], votes[1].ProposalId)
+	s.Assert().Equal(group.Choice_CHOICE_NO, votes[1].Choice)
+
+	// resubmitting a vote doesn't duplicate the history entry
+	_, err = s.msgClient.Vote(ctx, first)
+	s.Require().NoError(err)
+	// and a different vote on the same proposal is rejected
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalIDs[0], Voter: s.addr2.String(), Choice: group.Choice_CHOICE_NO})
+	s.Require().Error(err)
+	s.Assert().Equal(votes, history())
+
+	// paginated
+	res, err := s.queryClient.VotesByVoter(ctx, &group.QueryVotesByVoterRequest{
+		Voter:      s.addr2.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Votes, 1)
+	s.Assert().Equal(votes[0], res.Votes[0])
+	s.Assert().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (s *IntegrationTestSuite) TestVoteWithNonce() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "2"},
+		},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().NoError(err)
+	myProposalID := proposalRes.ProposalId
+
+	req := &group.MsgVoteRequest{
+		ProposalId: myProposalID,
+		Voter:      s.addr2.String(),
+		Choice:     group.Choice_CHOICE_YES,
+		Nonce:      []byte("nonce"),
+	}
+	_, err = s.msgClient.Vote(ctx, req)
+	s.Require().NoError(err)
+
+	// resubmission with the same nonce is a no-op
+	_, err = s.msgClient.Vote(ctx, req)
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: myProposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.Tally{
+		YesCount:     "1",
+		NoCount:      "0",
+		AbstainCount: "0",
+		VetoCount:    "0",
+	}, res.Proposal.VoteState)
+	s.Assert().Equal(group.ProposalStatusSubmitted, res.Proposal.Status)
+
+	// same nonce with a different choice
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{
+		ProposalId: myProposalID,
+		Voter:      s.addr2.String(),
+		Choice:     group.Choice_CHOICE_NO,
+		Nonce:      []byte("nonce"),
+	})
+	s.Require().Error(err)
+
+	// other nonce
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{
+		ProposalId: myProposalID,
+		Voter:      s.addr2.String(),
+		Choice:     group.Choice_CHOICE_YES,
+		Nonce:      []byte("other nonce"),
+	})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestCommitRevealVote() {
+	salt := []byte("my secret salt")
+	voter := s.addr2.String()
+
+	specs := map[string]struct {
+		choice            group.Choice
+		commitHash        func(id group.ProposalID) []byte
+		commitVoter       string
+		revealSalt        []byte
+		expCommitErr      bool
+		expRevealErr      bool
+		expVoteState      group.Tally
+		expProposalStatus group.Proposal_Status
+		expResult         group.Proposal_Result
+	}{
+		"reveal matching vote": {
+			choice: group.Choice_CHOICE_YES,
+			commitHash: func(id group.ProposalID) []byte {
+				return group.VoteCommitmentHash(id, voter, group.Choice_CHOICE_YES, salt)
+			},
+			commitVoter: voter,
+			revealSalt:  salt,
+			expVoteState: group.Tally{
+				YesCount:     "1",
+				NoCount:      "0",
+				AbstainCount: "0",
+				VetoCount:    "0",
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expResult:         group.ProposalResultAccepted,
+		},
+		"reveal with wrong salt": {
+			choice: group.Choice_CHOICE_YES,
+			commitHash: func(id group.ProposalID) []byte {
+				return group.VoteCommitmentHash(id, voter, group.Choice_CHOICE_YES, salt)
+			},
+			commitVoter:  voter,
+			revealSalt:   []byte("wrong salt"),
+			expRevealErr: true,
+		},
+		"reveal with other choice": {
+			choice: group.Choice_CHOICE_NO,
+			commitHash: func(id group.ProposalID) []byte {
+				return group.VoteCommitmentHash(id, voter, group.Choice_CHOICE_YES, salt)
+			},
+			commitVoter:  voter,
+			revealSalt:   salt,
+			expRevealErr: true,
+		},
+		"commit by non member": {
+			commitHash: func(id group.ProposalID) []byte {
+				return group.VoteCommitmentHash(id, s.addr3.String(), group.Choice_CHOICE_YES, salt)
+			},
+			commitVoter:  s.addr3.String(),
+			expCommitErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			ctx := types.Context{Context: sdkCtx}
+
+			myProposalID := createProposal(ctx, s, nil, []string{voter})
+
+			_, err := s.msgClient.CommitVote(ctx, &group.MsgCommitVoteRequest{
+				ProposalId: myProposalID,
+				Voter:      spec.commitVoter,
+				CommitHash: spec.commitHash(myProposalID),
+			})
+			if spec.expCommitErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, err = s.msgClient.RevealVote(ctx, &group.MsgRevealVoteRequest{
+				ProposalId: myProposalID,
+				Voter:      voter,
+				Choice:     spec.choice,
+				Salt:       spec.revealSalt,
+			})
+			if spec.expRevealErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// vote was stored
+			res, err := s.queryClient.VoteByProposalVoter(ctx, &group.QueryVoteByProposalVoterRequest{
+				ProposalId: myProposalID,
+				Voter:      voter,
+			})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.choice, res.Vote.Choice)
+
+			// and proposal is updated
+			proposalRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{
+				ProposalId: myProposalID,
+			})
+			s.Require().NoError(err)
+			proposal := proposalRes.Proposal
+			s.Assert().Equal(spec.expVoteState, proposal.VoteState)
+			s.Assert().Equal(spec.expResult, proposal.Result)
+			s.Assert().Equal(spec.expProposalStatus, proposal.Status)
+		})
+	}
+
+	s.Run("commit twice", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		ctx := types.Context{Context: sdkCtx}
+
+		myProposalID := createProposal(ctx, s, nil, []string{voter})
+		req := &group.MsgCommitVoteRequest{
+			ProposalId: myProposalID,
+			Voter:      voter,
+			CommitHash: group.VoteCommitmentHash(myProposalID, voter, group.Choice_CHOICE_YES, salt),
+		}
+		_, err := s.msgClient.CommitVote(ctx, req)
+		s.Require().NoError(err)
+		_, err = s.msgClient.CommitVote(ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("unrevealed commitment is not counted", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		ctx := types.Context{Context: sdkCtx}
+
+		myProposalID := createProposal(ctx, s, nil, []string{voter})
+		_, err := s.msgClient.CommitVote(ctx, &group.MsgCommitVoteRequest{
+			ProposalId: myProposalID,
+			Voter:      voter,
+			CommitHash: group.VoteCommitmentHash(myProposalID, voter, group.Choice_CHOICE_YES, salt),
+		})
+		s.Require().NoError(err)
+
+		sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(time.Second))
+		ctx = types.Context{Context: sdkCtx}
+		_, err = s.msgClient.Exec(ctx, &group.MsgExecRequest{Signer: s.addr1.String(), ProposalId: myProposalID})
+		s.Require().NoError(err)
+
+		proposalRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: myProposalID})
+		s.Require().NoError(err)
+		proposal := proposalRes.Proposal
+		s.Assert().Equal(group.Tally{
+			YesCount:     "0",
+			NoCount:      "0",
+			AbstainCount: "0",
+			VetoCount:    "0",
+		}, proposal.VoteState)
+		s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+		s.Assert().Equal(group.ProposalResultRejected, proposal.Result)
+
+		// and can't be revealed anymore
+		_, err = s.msgClient.RevealVote(ctx, &group.MsgRevealVoteRequest{
+			ProposalId: myProposalID,
+			Voter:      voter,
+			Choice:     group.Choice_CHOICE_YES,
+			Salt:       salt,
+		})
+		s.Require().Error(err)
+	})
+}
+
+func (s *IntegrationTestSuite) TestVoteOnScheduledProposal() {
+	votingStart := s.blockTime.Add(time.Hour)
+	votingStartTime, err := gogotypes.TimestampProto(votingStart)
+	s.Require().NoError(err)
+
+	createScheduledProposal := func(ctx context.Context) group.ProposalID {
+		res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount:    s.groupAccountAddr.String(),
+			Proposers:       []string{s.addr2.String()},
+			VotingStartTime: votingStartTime,
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	specs := map[string]struct {
+		srcBlockTime      time.Time
+		expErr            bool
+		expProposalStatus group.Proposal_Status
+		expResult         group.Proposal_Result
+	}{
+		"vote before voting start": {
+			srcBlockTime: s.blockTime,
+			expErr:       true,
+		},
+		"vote at voting start": {
+			srcBlockTime:      votingStart,
+			expProposalStatus: group.ProposalStatusClosed,
+			expResult:         group.ProposalResultAccepted,
+		},
+		"vote after submission timeout but before voting timeout": {
+			srcBlockTime:      votingStart.Add(time.Second).Add(-time.Millisecond),
+			expProposalStatus: group.ProposalStatusClosed,
+			expResult:         group.ProposalResultAccepted,
+		},
+		"vote after voting timeout": {
+			srcBlockTime: votingStart.Add(time.Second),
+			expErr:       true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			ctx := types.Context{Context: sdkCtx}
+			myProposalID := createScheduledProposal(ctx)
+
+			// timeout is measured from the voting start
+			proposalRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: myProposalID})
+			s.Require().NoError(err)
+			timeout, err := gogotypes.TimestampFromProto(&proposalRes.Proposal.Timeout)
+			s.Require().NoError(err)
+			s.Assert().Equal(votingStart.Add(time.Second), timeout)
+
+			ctx = types.Context{Context: sdkCtx.WithBlockTime(spec.srcBlockTime)}
+			_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{
+				ProposalId: myProposalID,
+				Voter:      s.addr2.String(),
+				Choice:     group.Choice_CHOICE_YES,
+			})
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			proposalRes, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: myProposalID})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expProposalStatus, proposalRes.Proposal.Status)
+			s.Assert().Equal(spec.expResult, proposalRes.Proposal.Result)
+		})
+	}
+
+	s.Run("not tallied before voting start", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		ctx := types.Context{Context: sdkCtx}
+		myProposalID := createScheduledProposal(ctx)
+
+		// the submission based timeout has passed but voting hasn't started yet
+		ctx = types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(time.Minute))}
+		_, err := s.msgClient.Exec(ctx, &group.MsgExecRequest{Signer: s.addr1.String(), ProposalId: myProposalID})
+		s.Require().NoError(err)
+
+		proposalRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: myProposalID})
+		s.Require().NoError(err)
+		s.Assert().Equal(group.ProposalStatusSubmitted, proposalRes.Proposal.Status)
+		s.Assert().Equal(group.ProposalResultUnfinalized, proposalRes.Proposal.Result)
+	})
+
+	s.Run("voting start in the past", func() {
+		past, err := gogotypes.TimestampProto(s.blockTime.Add(-time.Second))
+		s.Require().NoError(err)
+		_, err = s.msgClient.CreateProposal(s.ctx, &group.MsgCreateProposalRequest{
+			GroupAccount:    s.groupAccountAddr.String(),
+			Proposers:       []string{s.addr2.String()},
+			VotingStartTime: past,
+		})
+		s.Require().Error(err)
+	})
+}
+
+func (s *IntegrationTestSuite) TestDoExecuteMsgs() {
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	unauthzMsgSend := &banktypes.MsgSend{
+		FromAddress: s.addr1.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	specs := map[string]struct {
+		srcMsgs    []sdk.Msg
+		srcHandler sdk.Handler
+		expErr     bool
+	}{
+		"all good": {
+			srcMsgs: []sdk.Msg{msgSend},
+		},
+		"not authz by group account": {
+			srcMsgs: []sdk.Msg{unauthzMsgSend},
+			expErr:  true,
+		},
+		"mixed group account msgs": {
+			srcMsgs: []sdk.Msg{
+				msgSend,
+				unauthzMsgSend,
+			},
+			expErr: true,
+		},
+		"no handler": {
+			srcMsgs: []sdk.Msg{&testdata.MsgAuthenticated{Signers: []sdk.AccAddress{s.groupAccountAddr}}},
+			expErr:  true,
+		},
+		"not panic on nil result": {
+			srcMsgs: []sdk.Msg{&testdata.MsgAuthenticated{Signers: []sdk.AccAddress{s.groupAccountAddr}}},
+			srcHandler: func(ctx sdk.Context, msg sdk.Msg) (result *sdk.Result, err error) {
+				return nil, nil
+			},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			ctx, _ := s.sdkCtx.CacheContext()
+
+			var router sdk.Router
+			if spec.srcHandler != nil {
+				router = baseapp.NewRouter().AddRoute(sdk.NewRoute("MsgAuthenticated", spec.srcHandler))
+			} else {
+				router = baseapp.NewRouter().AddRoute(sdk.NewRoute(banktypes.ModuleName, bank.NewHandler(s.bankKeeper)))
+			}
+			_, err := groupserver.DoExecuteMsgs(ctx, router, s.groupAccountAddr, spec.srcMsgs)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestExecProposal() {
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposers := []string{s.addr2.String()}
+
+	specs := map[string]struct {
 		srcBlockTime      time.Time
 		setupProposal     func(ctx context.Context) group.ProposalID
 		expErr            bool
@@ -1709,6 +3439,305 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestExecProposalInsufficientFunds() {
+	var logs bytes.Buffer
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	sdkCtx = sdkCtx.WithLogger(log.NewTMLogger(log.NewSyncWriter(&logs)))
+	ctx := types.Context{Context: sdkCtx}
+
+	coins := sdk.Coins{sdk.NewInt64Coin("other", 1), sdk.NewInt64Coin("test", 5000)}
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: s.groupAccountAddr.String(),
+			ToAddress:   s.addr2.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 6000)},
+		},
+		&banktypes.MsgMultiSend{
+			Inputs:  []banktypes.Input{banktypes.NewInput(s.groupAccountAddr, coins)},
+			Outputs: []banktypes.Output{banktypes.NewOutput(s.addr3, coins)},
+		},
+	}
+	myProposalID := createProposalAndVote(ctx, s, msgs, []string{s.addr2.String()}, group.Choice_CHOICE_YES)
+
+	_, err := s.msgClient.Exec(ctx, &group.MsgExecRequest{Signer: s.addr1.String(), ProposalId: myProposalID})
+	s.Require().NoError(err)
+	s.Assert().Contains(logs.String(), "other: required 1, available 0")
+	s.Assert().Contains(logs.String(), "test: required 11000, available 10000")
+
+	// proposal stays accepted with the failed execution recorded
+	res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: myProposalID})
+	s.Require().NoError(err)
+	proposal := res.Proposal
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+	s.Assert().Equal(group.ProposalExecutorResultFailure, proposal.ExecutorResult)
+
+	// and no funds were moved
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("test", 10000)}, s.bankKeeper.GetAllBalances(sdkCtx, s.groupAccountAddr))
+}
+
+func (s *IntegrationTestSuite) TestAdminOverride() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	ctx := types.Context{Context: sdkCtx}
+	proposers := []string{s.addr2.String()}
+
+	msgs := []sdk.Msg{&banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr3.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}}
+	toExecute := createProposal(ctx, s, msgs, proposers)
+	toCancel := createProposal(ctx, s, msgs, proposers)
+
+	overrideEvents := func() []*group.EventAdminOverride {
+		var evts []*group.EventAdminOverride
+		for _, e := range sdkCtx.EventManager().ABCIEvents() {
+			if e.Type != proto.MessageName(&group.EventAdminOverride{}) {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(e)
+			s.Require().NoError(err)
+			evts = append(evts, msg.(*group.EventAdminOverride))
+		}
+		return evts
+	}
+
+	// non admin is rejected
+	_, err := s.msgClient.AdminOverride(ctx, &group.MsgAdminOverrideRequest{
+		Admin:      s.addr2.String(),
+		ProposalId: toExecute,
+		Action:     group.OverrideAction_OVERRIDE_ACTION_EXECUTE,
+	})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrUnauthorized.Is(err))
+	s.Assert().Empty(overrideEvents())
+
+	// admin executes without a vote
+	_, err = s.msgClient.AdminOverride(ctx, &group.MsgAdminOverrideRequest{
+		Admin:      s.addr1.String(),
+		ProposalId: toExecute,
+		Action:     group.OverrideAction_OVERRIDE_ACTION_EXECUTE,
+	})
+	s.Require().NoError(err)
+	res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: toExecute})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusClosed, res.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, res.Proposal.Result)
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, res.Proposal.ExecutorResult)
+	s.Assert().Equal(sdk.Coins{sdk.NewInt64Coin("test", 100)}, s.bankKeeper.GetAllBalances(sdkCtx, s.addr3))
+
+	// admin cancels
+	_, err = s.msgClient.AdminOverride(ctx, &group.MsgAdminOverrideRequest{
+		Admin:      s.addr1.String(),
+		ProposalId: toCancel,
+		Action:     group.OverrideAction_OVERRIDE_ACTION_CANCEL,
+	})
+	s.Require().NoError(err)
+	res, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: toCancel})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusAborted, res.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultUnfinalized, res.Proposal.Result)
+	s.Assert().Equal(group.ProposalExecutorResultNotRun, res.Proposal.ExecutorResult)
+
+	// both overrides are recorded
+	blockTime, err := gogotypes.TimestampProto(sdkCtx.BlockTime())
+	s.Require().NoError(err)
+	s.Assert().Equal([]*group.EventAdminOverride{
+		{
+			ProposalId: toExecute.Uint64(),
+			Admin:      s.addr1.String(),
+			Action:     group.OverrideAction_OVERRIDE_ACTION_EXECUTE,
+			Timestamp:  *blockTime,
+		},
+		{
+			ProposalId: toCancel.Uint64(),
+			Admin:      s.addr1.String(),
+			Action:     group.OverrideAction_OVERRIDE_ACTION_CANCEL,
+			Timestamp:  *blockTime,
+		},
+	}, overrideEvents())
+
+	// closed proposals can't be overridden
+	_, err = s.msgClient.AdminOverride(ctx, &group.MsgAdminOverrideRequest{
+		Admin:      s.addr1.String(),
+		ProposalId: toCancel,
+		Action:     group.OverrideAction_OVERRIDE_ACTION_EXECUTE,
+	})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrProposalFinal.Is(err))
+}
+
+func (s *IntegrationTestSuite) TestExecProposalSpendLimit() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:       s.addr1.String(),
+		GroupId:     s.groupID,
+		SpendLimit:  sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		SpendPeriod: &gogotypes.Duration{Seconds: 10},
+	}
+	err := accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr, err := sdk.AccAddressFromBech32(accountRes.GroupAccount)
+	s.Require().NoError(err)
+	s.Require().NoError(s.bankKeeper.SetBalances(sdkCtx, accountAddr, sdk.Coins{sdk.NewInt64Coin("test", 1000)}))
+
+	accepted := func(ctx context.Context, msg sdk.Msg) group.ProposalID {
+		proposalReq := &group.MsgCreateProposalRequest{
+			GroupAccount: accountAddr.String(),
+			Proposers:    []string{s.addr2.String()},
+		}
+		err := proposalReq.SetMsgs([]sdk.Msg{msg})
+		s.Require().NoError(err)
+		proposalRes, err := s.msgClient.CreateProposal(ctx, proposalReq)
+		s.Require().NoError(err)
+		_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{
+			ProposalId: proposalRes.ProposalId,
+			Voter:      s.addr2.String(),
+			Choice:     group.Choice_CHOICE_YES,
+		})
+		s.Require().NoError(err)
+		return proposalRes.ProposalId
+	}
+	acceptedSend := func(ctx context.Context, amount int64) group.ProposalID {
+		return accepted(ctx, &banktypes.MsgSend{
+			FromAddress: accountAddr.String(),
+			ToAddress:   s.addr3.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", amount)},
+		})
+	}
+	exec := func(ctx context.Context, id group.ProposalID) error {
+		_, err := s.msgClient.Exec(ctx, &group.MsgExecRequest{Signer: s.addr1.String(), ProposalId: id})
+		return err
+	}
+	executorResult := func(ctx context.Context, id group.ProposalID) group.Proposal_ExecutorResult {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		return res.Proposal.ExecutorResult
+	}
+
+	// spending up to the limit within one window
+	first := acceptedSend(ctx, 60)
+	s.Require().NoError(exec(ctx, first))
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, executorResult(ctx, first))
+	second := acceptedSend(ctx, 40)
+	s.Require().NoError(exec(ctx, second))
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, executorResult(ctx, second))
+
+	// going over the limit is rejected
+	third := acceptedSend(ctx, 1)
+	err = exec(ctx, third)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrMaxLimit.Is(err))
+	s.Assert().Contains(err.Error(), "test: required 1, remaining 0")
+	s.Assert().Equal(group.ProposalExecutorResultNotRun, executorResult(ctx, third))
+	s.Assert().Equal(sdk.Coins{sdk.NewInt64Coin("test", 900)}, s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+
+	// spending is possible again once the window has elapsed
+	sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(10 * time.Second))
+	ctx = types.Context{Context: sdkCtx}
+	s.Require().NoError(exec(ctx, third))
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, executorResult(ctx, third))
+	fourth := acceptedSend(ctx, 100)
+	err = exec(ctx, fourth)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrMaxLimit.Is(err))
+	s.Assert().Equal(sdk.Coins{sdk.NewInt64Coin("test", 899)}, s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+
+	// multi-send inputs count against the limit as well
+	coins := sdk.Coins{sdk.NewInt64Coin("test", 100)}
+	multiSend := accepted(ctx, &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(accountAddr, coins)},
+		Outputs: []banktypes.Output{banktypes.NewOutput(s.addr3, coins)},
+	})
+	err = exec(ctx, multiSend)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrMaxLimit.Is(err))
+	s.Assert().Contains(err.Error(), "test: required 100, remaining 99")
+	s.Assert().Equal(group.ProposalExecutorResultNotRun, executorResult(ctx, multiSend))
+	s.Assert().Equal(sdk.Coins{sdk.NewInt64Coin("test", 899)}, s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+}
+
+func (s *IntegrationTestSuite) TestRoleMultipliers() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	roleMultipliers := []group.RoleMultiplier{
+		{Role: "core", Multiplier: "2"},
+		{Role: "observer", Multiplier: "0.5"},
+	}
+
+	// unknown role
+	_, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:           s.addr1.String(),
+		Members:         []group.Member{{Address: s.addr2.String(), Weight: "1", Role: "unknown"}},
+		RoleMultipliers: roleMultipliers,
+	})
+	s.Require().Error(err)
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1", Role: "core"},
+			{Address: s.addr3.String(), Weight: "1"},
+			{Address: s.addr4.String(), Weight: "1", Role: "observer"},
+		},
+		RoleMultipliers: roleMultipliers,
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	getGroup := func() *group.GroupInfo {
+		res, err := s.queryClient.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		return res.Info
+	}
+	s.Assert().Equal("3.5", getGroup().TotalWeight)
+
+	// the core member's weight counts double in the tally
+	accountReq := &group.MsgCreateGroupAccountRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupID,
+	}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	proposalRes, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+		GroupAccount: accountRes.GroupAccount,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().NoError(err)
+	_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: proposalRes.ProposalId, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+	proposal, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Assert().Equal("2", proposal.Proposal.VoteState.YesCount)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Proposal.Result)
+
+	// updating the core member's weight applies the multiplier
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         s.addr1.String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: s.addr2.String(), Weight: "2", Role: "core"}},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("5.5", getGroup().TotalWeight)
+
+	// removing a member subtracts its effective weight
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         s.addr1.String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: s.addr2.String(), Weight: "0"}},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("1.5", getGroup().TotalWeight)
+}
+
 func createProposal(
 	ctx context.Context, s *IntegrationTestSuite, msgs []sdk.Msg,
 	proposers []string) group.ProposalID {
@@ -1739,3 +3768,218 @@ func createProposalAndVote(
 	s.Require().NoError(err)
 	return myProposalID
 }
+
+func (s *IntegrationTestSuite) TestMembershipProposal() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}, {Address: s.addr3.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+	createAccount := func(threshold string) string {
+		accountReq := &group.MsgCreateGroupAccountRequest{Admin: s.addr1.String(), GroupId: groupID}
+		s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy(threshold, gogotypes.Duration{Seconds: 10})))
+		accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+		s.Require().NoError(err)
+		return accountRes.GroupAccount
+	}
+	account := createAccount("1")
+	// A second account whose policy requires a total weight of at least 2.
+	createAccount("2")
+
+	propose := func(updates []group.Member, choice group.Choice) group.ProposalID {
+		res, err := s.msgClient.CreateMembershipProposal(ctx, &group.MsgCreateMembershipProposalRequest{
+			GroupAccount:  account,
+			Proposers:     []string{s.addr2.String()},
+			MemberUpdates: updates,
+		})
+		s.Require().NoError(err)
+		for _, voter := range []sdk.AccAddress{s.addr2, s.addr3} {
+			_, err = s.msgClient.Vote(ctx, &group.MsgVoteRequest{ProposalId: res.ProposalId, Voter: voter.String(), Choice: choice})
+			if err != nil {
+				// the proposal was already decided by the first vote
+				s.Require().True(group.ErrProposalFinal.Is(err) || group.ErrExpired.Is(err), err)
+			}
+		}
+		_, err = s.msgClient.Exec(ctx, &group.MsgExecRequest{Signer: s.addr1.String(), ProposalId: res.ProposalId})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	getProposal := func(id group.ProposalID) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+	getGroup := func() *group.GroupInfo {
+		res, err := s.queryClient.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		return res.Info
+	}
+
+	// a rejected proposal leaves the members unchanged
+	id := propose([]group.Member{{Address: s.addr5.String(), Weight: "5"}}, group.Choice_CHOICE_NO)
+	p := getProposal(id)
+	s.Assert().Equal(group.ProposalResultRejected, p.Result)
+	s.Assert().Equal(group.ProposalExecutorResultNotRun, p.ExecutorResult)
+	g := getGroup()
+	s.Assert().Equal("2", g.TotalWeight)
+	membersRes, err := s.queryClient.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Assert().Len(membersRes.Members, 2)
+
+	// an accepted proposal adds the member
+	id = propose([]group.Member{{Address: s.addr5.String(), Weight: "5"}}, group.Choice_CHOICE_YES)
+	p = getProposal(id)
+	s.Assert().Equal(group.ProposalResultAccepted, p.Result)
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, p.ExecutorResult)
+	g = getGroup()
+	s.Assert().Equal("7", g.TotalWeight)
+	s.Assert().Equal(groupRes.GroupId, g.GroupId)
+	membersRes, err = s.queryClient.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Assert().Len(membersRes.Members, 3)
+
+	// an update that would make the policy of the second account unsatisfiable fails
+	version := g.Version
+	id = propose([]group.Member{{Address: s.addr3.String(), Weight: "0"}, {Address: s.addr5.String(), Weight: "0"}}, group.Choice_CHOICE_YES)
+	p = getProposal(id)
+	s.Assert().Equal(group.ProposalResultAccepted, p.Result)
+	s.Assert().Equal(group.ProposalExecutorResultFailure, p.ExecutorResult)
+	g = getGroup()
+	s.Assert().Equal("7", g.TotalWeight)
+	s.Assert().Equal(version, g.Version)
+
+	// member updates are required
+	_, err = s.msgClient.CreateMembershipProposal(ctx, &group.MsgCreateMembershipProposalRequest{
+		GroupAccount: account,
+		Proposers:    []string{s.addr2.String()},
+	})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestProposalSchema() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:          s.addr1.String(),
+		Members:        []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+		ProposalSchema: &group.ProposalSchema{RequiredKeys: []string{"category"}},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+	accountReq := &group.MsgCreateGroupAccountRequest{Admin: s.addr1.String(), GroupId: groupID}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 10})))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	createProposal := func(metadata string) error {
+		_, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposalRequest{
+			GroupAccount: accountRes.GroupAccount,
+			Proposers:    []string{s.addr2.String()},
+			Metadata:     []byte(metadata),
+		})
+		return err
+	}
+
+	// a proposal satisfying the schema
+	s.Require().NoError(createProposal(`{"category":"treasury","title":"budget"}`))
+
+	// proposals missing a required key are rejected
+	for _, metadata := range []string{`{"title":"budget"}`, `not json`, ``} {
+		err = createProposal(metadata)
+		s.Require().Error(err)
+		s.Assert().True(group.ErrInvalid.Is(err), err)
+	}
+
+	// the admin can update and remove the schema
+	_, err = s.msgClient.UpdateGroupProposalSchema(ctx, &group.MsgUpdateGroupProposalSchemaRequest{
+		Admin:          s.addr1.String(),
+		GroupId:        groupID,
+		ProposalSchema: &group.ProposalSchema{RequiredKeys: []string{"category", "budget"}},
+	})
+	s.Require().NoError(err)
+	err = createProposal(`{"category":"treasury"}`)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err), err)
+
+	_, err = s.msgClient.UpdateGroupProposalSchema(ctx, &group.MsgUpdateGroupProposalSchemaRequest{
+		Admin:   s.addr1.String(),
+		GroupId: groupID,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(createProposal(`not json`))
+
+	_, err = s.msgClient.UpdateGroupProposalSchema(ctx, &group.MsgUpdateGroupProposalSchemaRequest{
+		Admin:          s.addr2.String(),
+		GroupId:        groupID,
+		ProposalSchema: &group.ProposalSchema{RequiredKeys: []string{"category"}},
+	})
+	s.Require().Error(err)
+	s.Assert().True(sdkerrors.ErrUnauthorized.Is(err), err)
+}
+
+func (s *IntegrationTestSuite) TestNormalizedWeights() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "3"},
+		},
+		NormalizedWeights: true,
+		NormalizedTotal:   "100",
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	assertWeights := func(exp map[string]string) {
+		groupInfoRes, err := s.queryClient.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		s.Assert().Equal("100", groupInfoRes.Info.TotalWeight)
+		membersRes, err := s.queryClient.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID})
+		s.Require().NoError(err)
+		weights := make(map[string]string, len(membersRes.Members))
+		for _, m := range membersRes.Members {
+			weights[m.Member.Address] = m.Member.Weight
+		}
+		s.Assert().Equal(exp, weights)
+	}
+	// weights are scaled to the total keeping their proportions
+	assertWeights(map[string]string{s.addr2.String(): "25", s.addr3.String(): "75"})
+
+	// adding a member re-scales the existing weights, rounding remainders are
+	// handed out so that the total stays constant
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         s.addr1.String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: s.addr4.String(), Weight: "50"}},
+	})
+	s.Require().NoError(err)
+	assertWeights(map[string]string{s.addr2.String(): "16.666667", s.addr3.String(): "50", s.addr4.String(): "33.333333"})
+
+	// removing a member re-scales the remaining weights
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembersRequest{
+		Admin:         s.addr1.String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.Member{{Address: s.addr3.String(), Weight: "0"}},
+	})
+	s.Require().NoError(err)
+	assertWeights(map[string]string{s.addr2.String(): "33.333334", s.addr4.String(): "66.666666"})
+
+	// normalized weights can't be combined with role multipliers
+	_, err = s.msgClient.CreateGroup(ctx, &group.MsgCreateGroupRequest{
+		Admin:             s.addr1.String(),
+		Members:           []group.Member{{Address: s.addr2.String(), Weight: "1", Role: "council"}},
+		RoleMultipliers:   []group.RoleMultiplier{{Role: "council", Multiplier: "2"}},
+		NormalizedWeights: true,
+		NormalizedTotal:   "1",
+	})
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err), err)
+}
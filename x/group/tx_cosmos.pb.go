@@ -24,6 +24,25 @@ type MsgClient interface {
 	UpdateGroupAdmin(ctx context.Context, in *MsgUpdateGroupAdminRequest, opts ...grpc.CallOption) (*MsgUpdateGroupAdminResponse, error)
 	// UpdateGroupMetadata updates the group metadata with given group id and admin address.
 	UpdateGroupMetadata(ctx context.Context, in *MsgUpdateGroupMetadataRequest, opts ...grpc.CallOption) (*MsgUpdateGroupMetadataResponse, error)
+	// UpdateGroupProposalSchema updates the schema the metadata of the group's proposals must satisfy.
+	UpdateGroupProposalSchema(ctx context.Context, in *MsgUpdateGroupProposalSchemaRequest, opts ...grpc.CallOption) (*MsgUpdateGroupProposalSchemaResponse, error)
+	// RepairTotalWeight recomputes the total weight of a group from the weights of
+	// its members, fixing a total weight that doesn't match them.
+	RepairTotalWeight(ctx context.Context, in *MsgRepairTotalWeightRequest, opts ...grpc.CallOption) (*MsgRepairTotalWeightResponse, error)
+	// CloneGroup creates a new group with the members and settings of an existing
+	// group, and a copy of each of its group accounts with the same decision policy.
+	CloneGroup(ctx context.Context, in *MsgCloneGroupRequest, opts ...grpc.CallOption) (*MsgCloneGroupResponse, error)
+	// InviteMember invites an address to join a group. The invitee only becomes
+	// a member once the invitation is accepted.
+	InviteMember(ctx context.Context, in *MsgInviteMemberRequest, opts ...grpc.CallOption) (*MsgInviteMemberResponse, error)
+	// AcceptInvitation accepts a pending group invitation.
+	AcceptInvitation(ctx context.Context, in *MsgAcceptInvitationRequest, opts ...grpc.CallOption) (*MsgAcceptInvitationResponse, error)
+	// DeclineInvitation declines a pending group invitation.
+	DeclineInvitation(ctx context.Context, in *MsgDeclineInvitationRequest, opts ...grpc.CallOption) (*MsgDeclineInvitationResponse, error)
+	// AssignSeat assigns a free seat of a council group to a new member.
+	AssignSeat(ctx context.Context, in *MsgAssignSeatRequest, opts ...grpc.CallOption) (*MsgAssignSeatResponse, error)
+	// VacateSeat removes a member from its seat of a council group.
+	VacateSeat(ctx context.Context, in *MsgVacateSeatRequest, opts ...grpc.CallOption) (*MsgVacateSeatResponse, error)
 	// CreateGroupAccount creates a new group account using given DecisionPolicy.
 	CreateGroupAccount(ctx context.Context, in *MsgCreateGroupAccountRequest, opts ...grpc.CallOption) (*MsgCreateGroupAccountResponse, error)
 	// UpdateGroupAccountAdmin updates a group account admin.
@@ -32,12 +51,35 @@ type MsgClient interface {
 	UpdateGroupAccountDecisionPolicy(ctx context.Context, in *MsgUpdateGroupAccountDecisionPolicyRequest, opts ...grpc.CallOption) (*MsgUpdateGroupAccountDecisionPolicyResponse, error)
 	// UpdateGroupAccountMetadata updates a group account metadata.
 	UpdateGroupAccountMetadata(ctx context.Context, in *MsgUpdateGroupAccountMetadataRequest, opts ...grpc.CallOption) (*MsgUpdateGroupAccountMetadataResponse, error)
+	// ReassignGroupAccount moves a group account to another group.
+	ReassignGroupAccount(ctx context.Context, in *MsgReassignGroupAccountRequest, opts ...grpc.CallOption) (*MsgReassignGroupAccountResponse, error)
 	// CreateProposal submits a new proposal.
 	CreateProposal(ctx context.Context, in *MsgCreateProposalRequest, opts ...grpc.CallOption) (*MsgCreateProposalResponse, error)
+	// CreateMembershipProposal submits a new proposal to update the members of the
+	// group of a group account, which are updated once the proposal is executed.
+	CreateMembershipProposal(ctx context.Context, in *MsgCreateMembershipProposalRequest, opts ...grpc.CallOption) (*MsgCreateMembershipProposalResponse, error)
+	// AmendProposal allows a proposer to amend the metadata and msgs of a proposal
+	// within the proposal editing window, before it is voted on.
+	AmendProposal(ctx context.Context, in *MsgAmendProposalRequest, opts ...grpc.CallOption) (*MsgAmendProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(ctx context.Context, in *MsgVoteRequest, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// ChangeVote allows a voter to replace their vote on a proposal that is still
+	// open for voting. Each change is recorded, see Query/ChangedVotes.
+	ChangeVote(ctx context.Context, in *MsgChangeVoteRequest, opts ...grpc.CallOption) (*MsgChangeVoteResponse, error)
+	// CommitVote allows a voter to commit to a hidden vote on a proposal.
+	CommitVote(ctx context.Context, in *MsgCommitVoteRequest, opts ...grpc.CallOption) (*MsgCommitVoteResponse, error)
+	// RevealVote reveals a previously committed vote and counts it.
+	RevealVote(ctx context.Context, in *MsgRevealVoteRequest, opts ...grpc.CallOption) (*MsgRevealVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExecRequest, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// AdminOverride allows the group account admin to execute or cancel a
+	// proposal bypassing the vote.
+	AdminOverride(ctx context.Context, in *MsgAdminOverrideRequest, opts ...grpc.CallOption) (*MsgAdminOverrideResponse, error)
+	// PauseProposal allows the group account admin to pause a proposal. A paused
+	// proposal can't be voted on or executed and its timeout clock stops.
+	PauseProposal(ctx context.Context, in *MsgPauseProposalRequest, opts ...grpc.CallOption) (*MsgPauseProposalResponse, error)
+	// ResumeProposal allows the group account admin to resume a paused proposal.
+	ResumeProposal(ctx context.Context, in *MsgResumeProposalRequest, opts ...grpc.CallOption) (*MsgResumeProposalResponse, error)
 }
 
 type msgClient struct {
@@ -46,13 +88,30 @@ type msgClient struct {
 	_UpdateGroupMembers               types.Invoker
 	_UpdateGroupAdmin                 types.Invoker
 	_UpdateGroupMetadata              types.Invoker
+	_UpdateGroupProposalSchema        types.Invoker
+	_RepairTotalWeight                types.Invoker
+	_CloneGroup                       types.Invoker
+	_InviteMember                     types.Invoker
+	_AcceptInvitation                 types.Invoker
+	_DeclineInvitation                types.Invoker
+	_AssignSeat                       types.Invoker
+	_VacateSeat                       types.Invoker
 	_CreateGroupAccount               types.Invoker
 	_UpdateGroupAccountAdmin          types.Invoker
 	_UpdateGroupAccountDecisionPolicy types.Invoker
 	_UpdateGroupAccountMetadata       types.Invoker
+	_ReassignGroupAccount             types.Invoker
 	_CreateProposal                   types.Invoker
+	_CreateMembershipProposal         types.Invoker
+	_AmendProposal                    types.Invoker
 	_Vote                             types.Invoker
+	_ChangeVote                       types.Invoker
+	_CommitVote                       types.Invoker
+	_RevealVote                       types.Invoker
 	_Exec                             types.Invoker
+	_AdminOverride                    types.Invoker
+	_PauseProposal                    types.Invoker
+	_ResumeProposal                   types.Invoker
 }
 
 func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
@@ -151,6 +210,190 @@ func (c *msgClient) UpdateGroupMetadata(ctx context.Context, in *MsgUpdateGroupM
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupProposalSchema(ctx context.Context, in *MsgUpdateGroupProposalSchemaRequest, opts ...grpc.CallOption) (*MsgUpdateGroupProposalSchemaResponse, error) {
+	if invoker := c._UpdateGroupProposalSchema; invoker != nil {
+		var out MsgUpdateGroupProposalSchemaResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._UpdateGroupProposalSchema, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/UpdateGroupProposalSchema")
+		if err != nil {
+			var out MsgUpdateGroupProposalSchemaResponse
+			err = c._UpdateGroupProposalSchema(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgUpdateGroupProposalSchemaResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/UpdateGroupProposalSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RepairTotalWeight(ctx context.Context, in *MsgRepairTotalWeightRequest, opts ...grpc.CallOption) (*MsgRepairTotalWeightResponse, error) {
+	if invoker := c._RepairTotalWeight; invoker != nil {
+		var out MsgRepairTotalWeightResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._RepairTotalWeight, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/RepairTotalWeight")
+		if err != nil {
+			var out MsgRepairTotalWeightResponse
+			err = c._RepairTotalWeight(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgRepairTotalWeightResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/RepairTotalWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloneGroup(ctx context.Context, in *MsgCloneGroupRequest, opts ...grpc.CallOption) (*MsgCloneGroupResponse, error) {
+	if invoker := c._CloneGroup; invoker != nil {
+		var out MsgCloneGroupResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._CloneGroup, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/CloneGroup")
+		if err != nil {
+			var out MsgCloneGroupResponse
+			err = c._CloneGroup(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgCloneGroupResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/CloneGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InviteMember(ctx context.Context, in *MsgInviteMemberRequest, opts ...grpc.CallOption) (*MsgInviteMemberResponse, error) {
+	if invoker := c._InviteMember; invoker != nil {
+		var out MsgInviteMemberResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._InviteMember, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/InviteMember")
+		if err != nil {
+			var out MsgInviteMemberResponse
+			err = c._InviteMember(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgInviteMemberResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/InviteMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptInvitation(ctx context.Context, in *MsgAcceptInvitationRequest, opts ...grpc.CallOption) (*MsgAcceptInvitationResponse, error) {
+	if invoker := c._AcceptInvitation; invoker != nil {
+		var out MsgAcceptInvitationResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._AcceptInvitation, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/AcceptInvitation")
+		if err != nil {
+			var out MsgAcceptInvitationResponse
+			err = c._AcceptInvitation(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgAcceptInvitationResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/AcceptInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeclineInvitation(ctx context.Context, in *MsgDeclineInvitationRequest, opts ...grpc.CallOption) (*MsgDeclineInvitationResponse, error) {
+	if invoker := c._DeclineInvitation; invoker != nil {
+		var out MsgDeclineInvitationResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._DeclineInvitation, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/DeclineInvitation")
+		if err != nil {
+			var out MsgDeclineInvitationResponse
+			err = c._DeclineInvitation(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgDeclineInvitationResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/DeclineInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AssignSeat(ctx context.Context, in *MsgAssignSeatRequest, opts ...grpc.CallOption) (*MsgAssignSeatResponse, error) {
+	if invoker := c._AssignSeat; invoker != nil {
+		var out MsgAssignSeatResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._AssignSeat, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/AssignSeat")
+		if err != nil {
+			var out MsgAssignSeatResponse
+			err = c._AssignSeat(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgAssignSeatResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/AssignSeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VacateSeat(ctx context.Context, in *MsgVacateSeatRequest, opts ...grpc.CallOption) (*MsgVacateSeatResponse, error) {
+	if invoker := c._VacateSeat; invoker != nil {
+		var out MsgVacateSeatResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._VacateSeat, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/VacateSeat")
+		if err != nil {
+			var out MsgVacateSeatResponse
+			err = c._VacateSeat(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgVacateSeatResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/VacateSeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateGroupAccount(ctx context.Context, in *MsgCreateGroupAccountRequest, opts ...grpc.CallOption) (*MsgCreateGroupAccountResponse, error) {
 	if invoker := c._CreateGroupAccount; invoker != nil {
 		var out MsgCreateGroupAccountResponse
@@ -243,6 +486,29 @@ func (c *msgClient) UpdateGroupAccountMetadata(ctx context.Context, in *MsgUpdat
 	return out, nil
 }
 
+func (c *msgClient) ReassignGroupAccount(ctx context.Context, in *MsgReassignGroupAccountRequest, opts ...grpc.CallOption) (*MsgReassignGroupAccountResponse, error) {
+	if invoker := c._ReassignGroupAccount; invoker != nil {
+		var out MsgReassignGroupAccountResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._ReassignGroupAccount, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/ReassignGroupAccount")
+		if err != nil {
+			var out MsgReassignGroupAccountResponse
+			err = c._ReassignGroupAccount(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgReassignGroupAccountResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/ReassignGroupAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateProposal(ctx context.Context, in *MsgCreateProposalRequest, opts ...grpc.CallOption) (*MsgCreateProposalResponse, error) {
 	if invoker := c._CreateProposal; invoker != nil {
 		var out MsgCreateProposalResponse
@@ -266,6 +532,52 @@ func (c *msgClient) CreateProposal(ctx context.Context, in *MsgCreateProposalReq
 	return out, nil
 }
 
+func (c *msgClient) CreateMembershipProposal(ctx context.Context, in *MsgCreateMembershipProposalRequest, opts ...grpc.CallOption) (*MsgCreateMembershipProposalResponse, error) {
+	if invoker := c._CreateMembershipProposal; invoker != nil {
+		var out MsgCreateMembershipProposalResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._CreateMembershipProposal, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/CreateMembershipProposal")
+		if err != nil {
+			var out MsgCreateMembershipProposalResponse
+			err = c._CreateMembershipProposal(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgCreateMembershipProposalResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/CreateMembershipProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendProposal(ctx context.Context, in *MsgAmendProposalRequest, opts ...grpc.CallOption) (*MsgAmendProposalResponse, error) {
+	if invoker := c._AmendProposal; invoker != nil {
+		var out MsgAmendProposalResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._AmendProposal, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/AmendProposal")
+		if err != nil {
+			var out MsgAmendProposalResponse
+			err = c._AmendProposal(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgAmendProposalResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/AmendProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Vote(ctx context.Context, in *MsgVoteRequest, opts ...grpc.CallOption) (*MsgVoteResponse, error) {
 	if invoker := c._Vote; invoker != nil {
 		var out MsgVoteResponse
@@ -289,6 +601,75 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVoteRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *msgClient) ChangeVote(ctx context.Context, in *MsgChangeVoteRequest, opts ...grpc.CallOption) (*MsgChangeVoteResponse, error) {
+	if invoker := c._ChangeVote; invoker != nil {
+		var out MsgChangeVoteResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._ChangeVote, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/ChangeVote")
+		if err != nil {
+			var out MsgChangeVoteResponse
+			err = c._ChangeVote(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgChangeVoteResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/ChangeVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitVote(ctx context.Context, in *MsgCommitVoteRequest, opts ...grpc.CallOption) (*MsgCommitVoteResponse, error) {
+	if invoker := c._CommitVote; invoker != nil {
+		var out MsgCommitVoteResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._CommitVote, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/CommitVote")
+		if err != nil {
+			var out MsgCommitVoteResponse
+			err = c._CommitVote(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgCommitVoteResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/CommitVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealVote(ctx context.Context, in *MsgRevealVoteRequest, opts ...grpc.CallOption) (*MsgRevealVoteResponse, error) {
+	if invoker := c._RevealVote; invoker != nil {
+		var out MsgRevealVoteResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._RevealVote, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/RevealVote")
+		if err != nil {
+			var out MsgRevealVoteResponse
+			err = c._RevealVote(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgRevealVoteResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/RevealVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Exec(ctx context.Context, in *MsgExecRequest, opts ...grpc.CallOption) (*MsgExecResponse, error) {
 	if invoker := c._Exec; invoker != nil {
 		var out MsgExecResponse
@@ -312,6 +693,75 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExecRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *msgClient) AdminOverride(ctx context.Context, in *MsgAdminOverrideRequest, opts ...grpc.CallOption) (*MsgAdminOverrideResponse, error) {
+	if invoker := c._AdminOverride; invoker != nil {
+		var out MsgAdminOverrideResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._AdminOverride, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/AdminOverride")
+		if err != nil {
+			var out MsgAdminOverrideResponse
+			err = c._AdminOverride(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgAdminOverrideResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/AdminOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseProposal(ctx context.Context, in *MsgPauseProposalRequest, opts ...grpc.CallOption) (*MsgPauseProposalResponse, error) {
+	if invoker := c._PauseProposal; invoker != nil {
+		var out MsgPauseProposalResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._PauseProposal, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/PauseProposal")
+		if err != nil {
+			var out MsgPauseProposalResponse
+			err = c._PauseProposal(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgPauseProposalResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/PauseProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeProposal(ctx context.Context, in *MsgResumeProposalRequest, opts ...grpc.CallOption) (*MsgResumeProposalResponse, error) {
+	if invoker := c._ResumeProposal; invoker != nil {
+		var out MsgResumeProposalResponse
+		err := invoker(ctx, in, &out)
+		return &out, err
+	}
+	if invokerConn, ok := c.cc.(types.InvokerConn); ok {
+		var err error
+		c._ResumeProposal, err = invokerConn.Invoker("/regen.group.v1alpha1.Msg/ResumeProposal")
+		if err != nil {
+			var out MsgResumeProposalResponse
+			err = c._ResumeProposal(ctx, in, &out)
+			return &out, err
+		}
+	}
+	out := new(MsgResumeProposalResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/ResumeProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -322,6 +772,25 @@ type MsgServer interface {
 	UpdateGroupAdmin(types.Context, *MsgUpdateGroupAdminRequest) (*MsgUpdateGroupAdminResponse, error)
 	// UpdateGroupMetadata updates the group metadata with given group id and admin address.
 	UpdateGroupMetadata(types.Context, *MsgUpdateGroupMetadataRequest) (*MsgUpdateGroupMetadataResponse, error)
+	// UpdateGroupProposalSchema updates the schema the metadata of the group's proposals must satisfy.
+	UpdateGroupProposalSchema(types.Context, *MsgUpdateGroupProposalSchemaRequest) (*MsgUpdateGroupProposalSchemaResponse, error)
+	// RepairTotalWeight recomputes the total weight of a group from the weights of
+	// its members, fixing a total weight that doesn't match them.
+	RepairTotalWeight(types.Context, *MsgRepairTotalWeightRequest) (*MsgRepairTotalWeightResponse, error)
+	// CloneGroup creates a new group with the members and settings of an existing
+	// group, and a copy of each of its group accounts with the same decision policy.
+	CloneGroup(types.Context, *MsgCloneGroupRequest) (*MsgCloneGroupResponse, error)
+	// InviteMember invites an address to join a group. The invitee only becomes
+	// a member once the invitation is accepted.
+	InviteMember(types.Context, *MsgInviteMemberRequest) (*MsgInviteMemberResponse, error)
+	// AcceptInvitation accepts a pending group invitation.
+	AcceptInvitation(types.Context, *MsgAcceptInvitationRequest) (*MsgAcceptInvitationResponse, error)
+	// DeclineInvitation declines a pending group invitation.
+	DeclineInvitation(types.Context, *MsgDeclineInvitationRequest) (*MsgDeclineInvitationResponse, error)
+	// AssignSeat assigns a free seat of a council group to a new member.
+	AssignSeat(types.Context, *MsgAssignSeatRequest) (*MsgAssignSeatResponse, error)
+	// VacateSeat removes a member from its seat of a council group.
+	VacateSeat(types.Context, *MsgVacateSeatRequest) (*MsgVacateSeatResponse, error)
 	// CreateGroupAccount creates a new group account using given DecisionPolicy.
 	CreateGroupAccount(types.Context, *MsgCreateGroupAccountRequest) (*MsgCreateGroupAccountResponse, error)
 	// UpdateGroupAccountAdmin updates a group account admin.
@@ -330,12 +799,35 @@ type MsgServer interface {
 	UpdateGroupAccountDecisionPolicy(types.Context, *MsgUpdateGroupAccountDecisionPolicyRequest) (*MsgUpdateGroupAccountDecisionPolicyResponse, error)
 	// UpdateGroupAccountMetadata updates a group account metadata.
 	UpdateGroupAccountMetadata(types.Context, *MsgUpdateGroupAccountMetadataRequest) (*MsgUpdateGroupAccountMetadataResponse, error)
+	// ReassignGroupAccount moves a group account to another group.
+	ReassignGroupAccount(types.Context, *MsgReassignGroupAccountRequest) (*MsgReassignGroupAccountResponse, error)
 	// CreateProposal submits a new proposal.
 	CreateProposal(types.Context, *MsgCreateProposalRequest) (*MsgCreateProposalResponse, error)
+	// CreateMembershipProposal submits a new proposal to update the members of the
+	// group of a group account, which are updated once the proposal is executed.
+	CreateMembershipProposal(types.Context, *MsgCreateMembershipProposalRequest) (*MsgCreateMembershipProposalResponse, error)
+	// AmendProposal allows a proposer to amend the metadata and msgs of a proposal
+	// within the proposal editing window, before it is voted on.
+	AmendProposal(types.Context, *MsgAmendProposalRequest) (*MsgAmendProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(types.Context, *MsgVoteRequest) (*MsgVoteResponse, error)
+	// ChangeVote allows a voter to replace their vote on a proposal that is still
+	// open for voting. Each change is recorded, see Query/ChangedVotes.
+	ChangeVote(types.Context, *MsgChangeVoteRequest) (*MsgChangeVoteResponse, error)
+	// CommitVote allows a voter to commit to a hidden vote on a proposal.
+	CommitVote(types.Context, *MsgCommitVoteRequest) (*MsgCommitVoteResponse, error)
+	// RevealVote reveals a previously committed vote and counts it.
+	RevealVote(types.Context, *MsgRevealVoteRequest) (*MsgRevealVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(types.Context, *MsgExecRequest) (*MsgExecResponse, error)
+	// AdminOverride allows the group account admin to execute or cancel a
+	// proposal bypassing the vote.
+	AdminOverride(types.Context, *MsgAdminOverrideRequest) (*MsgAdminOverrideResponse, error)
+	// PauseProposal allows the group account admin to pause a proposal. A paused
+	// proposal can't be voted on or executed and its timeout clock stops.
+	PauseProposal(types.Context, *MsgPauseProposalRequest) (*MsgPauseProposalResponse, error)
+	// ResumeProposal allows the group account admin to resume a paused proposal.
+	ResumeProposal(types.Context, *MsgResumeProposalRequest) (*MsgResumeProposalResponse, error)
 }
 
 func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
@@ -414,6 +906,150 @@ func _Msg_UpdateGroupMetadata_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupProposalSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupProposalSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupProposalSchema(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/UpdateGroupProposalSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupProposalSchema(types.UnwrapSDKContext(ctx), req.(*MsgUpdateGroupProposalSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepairTotalWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepairTotalWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepairTotalWeight(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/RepairTotalWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepairTotalWeight(types.UnwrapSDKContext(ctx), req.(*MsgRepairTotalWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloneGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloneGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloneGroup(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/CloneGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloneGroup(types.UnwrapSDKContext(ctx), req.(*MsgCloneGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InviteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInviteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InviteMember(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/InviteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InviteMember(types.UnwrapSDKContext(ctx), req.(*MsgInviteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptInvitation(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/AcceptInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptInvitation(types.UnwrapSDKContext(ctx), req.(*MsgAcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeclineInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeclineInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeclineInvitation(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/DeclineInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeclineInvitation(types.UnwrapSDKContext(ctx), req.(*MsgDeclineInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AssignSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAssignSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AssignSeat(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/AssignSeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AssignSeat(types.UnwrapSDKContext(ctx), req.(*MsgAssignSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VacateSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVacateSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VacateSeat(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/VacateSeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VacateSeat(types.UnwrapSDKContext(ctx), req.(*MsgVacateSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateGroupAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateGroupAccountRequest)
 	if err := dec(in); err != nil {
@@ -486,6 +1122,24 @@ func _Msg_UpdateGroupAccountMetadata_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReassignGroupAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReassignGroupAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReassignGroupAccount(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/ReassignGroupAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReassignGroupAccount(types.UnwrapSDKContext(ctx), req.(*MsgReassignGroupAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateProposalRequest)
 	if err := dec(in); err != nil {
@@ -504,6 +1158,42 @@ func _Msg_CreateProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateMembershipProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMembershipProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMembershipProposal(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/CreateMembershipProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMembershipProposal(types.UnwrapSDKContext(ctx), req.(*MsgCreateMembershipProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendProposal(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/AmendProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendProposal(types.UnwrapSDKContext(ctx), req.(*MsgAmendProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgVoteRequest)
 	if err := dec(in); err != nil {
@@ -522,6 +1212,60 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ChangeVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeVote(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/ChangeVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeVote(types.UnwrapSDKContext(ctx), req.(*MsgChangeVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitVote(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/CommitVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitVote(types.UnwrapSDKContext(ctx), req.(*MsgCommitVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealVote(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/RevealVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealVote(types.UnwrapSDKContext(ctx), req.(*MsgRevealVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecRequest)
 	if err := dec(in); err != nil {
@@ -540,6 +1284,60 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AdminOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdminOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdminOverride(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/AdminOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdminOverride(types.UnwrapSDKContext(ctx), req.(*MsgAdminOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseProposal(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/PauseProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseProposal(types.UnwrapSDKContext(ctx), req.(*MsgPauseProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeProposal(types.UnwrapSDKContext(ctx), in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/ResumeProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeProposal(types.UnwrapSDKContext(ctx), req.(*MsgResumeProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -563,6 +1361,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupMetadata",
 			Handler:    _Msg_UpdateGroupMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupProposalSchema",
+			Handler:    _Msg_UpdateGroupProposalSchema_Handler,
+		},
+		{
+			MethodName: "RepairTotalWeight",
+			Handler:    _Msg_RepairTotalWeight_Handler,
+		},
+		{
+			MethodName: "CloneGroup",
+			Handler:    _Msg_CloneGroup_Handler,
+		},
+		{
+			MethodName: "InviteMember",
+			Handler:    _Msg_InviteMember_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _Msg_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineInvitation",
+			Handler:    _Msg_DeclineInvitation_Handler,
+		},
+		{
+			MethodName: "AssignSeat",
+			Handler:    _Msg_AssignSeat_Handler,
+		},
+		{
+			MethodName: "VacateSeat",
+			Handler:    _Msg_VacateSeat_Handler,
+		},
 		{
 			MethodName: "CreateGroupAccount",
 			Handler:    _Msg_CreateGroupAccount_Handler,
@@ -579,18 +1409,54 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupAccountMetadata",
 			Handler:    _Msg_UpdateGroupAccountMetadata_Handler,
 		},
+		{
+			MethodName: "ReassignGroupAccount",
+			Handler:    _Msg_ReassignGroupAccount_Handler,
+		},
 		{
 			MethodName: "CreateProposal",
 			Handler:    _Msg_CreateProposal_Handler,
 		},
+		{
+			MethodName: "CreateMembershipProposal",
+			Handler:    _Msg_CreateMembershipProposal_Handler,
+		},
+		{
+			MethodName: "AmendProposal",
+			Handler:    _Msg_AmendProposal_Handler,
+		},
 		{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "ChangeVote",
+			Handler:    _Msg_ChangeVote_Handler,
+		},
+		{
+			MethodName: "CommitVote",
+			Handler:    _Msg_CommitVote_Handler,
+		},
+		{
+			MethodName: "RevealVote",
+			Handler:    _Msg_RevealVote_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "AdminOverride",
+			Handler:    _Msg_AdminOverride_Handler,
+		},
+		{
+			MethodName: "PauseProposal",
+			Handler:    _Msg_PauseProposal_Handler,
+		},
+		{
+			MethodName: "ResumeProposal",
+			Handler:    _Msg_ResumeProposal_Handler,
+		},
 	},
 	Metadata: "regen/group/v1alpha1/tx.proto",
 }
@@ -600,11 +1466,28 @@ const (
 	MsgUpdateGroupMembersMethod               = "/regen.group.v1alpha1.Msg/UpdateGroupMembers"
 	MsgUpdateGroupAdminMethod                 = "/regen.group.v1alpha1.Msg/UpdateGroupAdmin"
 	MsgUpdateGroupMetadataMethod              = "/regen.group.v1alpha1.Msg/UpdateGroupMetadata"
+	MsgUpdateGroupProposalSchemaMethod        = "/regen.group.v1alpha1.Msg/UpdateGroupProposalSchema"
+	MsgRepairTotalWeightMethod                = "/regen.group.v1alpha1.Msg/RepairTotalWeight"
+	MsgCloneGroupMethod                       = "/regen.group.v1alpha1.Msg/CloneGroup"
+	MsgInviteMemberMethod                     = "/regen.group.v1alpha1.Msg/InviteMember"
+	MsgAcceptInvitationMethod                 = "/regen.group.v1alpha1.Msg/AcceptInvitation"
+	MsgDeclineInvitationMethod                = "/regen.group.v1alpha1.Msg/DeclineInvitation"
+	MsgAssignSeatMethod                       = "/regen.group.v1alpha1.Msg/AssignSeat"
+	MsgVacateSeatMethod                       = "/regen.group.v1alpha1.Msg/VacateSeat"
 	MsgCreateGroupAccountMethod               = "/regen.group.v1alpha1.Msg/CreateGroupAccount"
 	MsgUpdateGroupAccountAdminMethod          = "/regen.group.v1alpha1.Msg/UpdateGroupAccountAdmin"
 	MsgUpdateGroupAccountDecisionPolicyMethod = "/regen.group.v1alpha1.Msg/UpdateGroupAccountDecisionPolicy"
 	MsgUpdateGroupAccountMetadataMethod       = "/regen.group.v1alpha1.Msg/UpdateGroupAccountMetadata"
+	MsgReassignGroupAccountMethod             = "/regen.group.v1alpha1.Msg/ReassignGroupAccount"
 	MsgCreateProposalMethod                   = "/regen.group.v1alpha1.Msg/CreateProposal"
+	MsgCreateMembershipProposalMethod         = "/regen.group.v1alpha1.Msg/CreateMembershipProposal"
+	MsgAmendProposalMethod                    = "/regen.group.v1alpha1.Msg/AmendProposal"
 	MsgVoteMethod                             = "/regen.group.v1alpha1.Msg/Vote"
+	MsgChangeVoteMethod                       = "/regen.group.v1alpha1.Msg/ChangeVote"
+	MsgCommitVoteMethod                       = "/regen.group.v1alpha1.Msg/CommitVote"
+	MsgRevealVoteMethod                       = "/regen.group.v1alpha1.Msg/RevealVote"
 	MsgExecMethod                             = "/regen.group.v1alpha1.Msg/Exec"
+	MsgAdminOverrideMethod                    = "/regen.group.v1alpha1.Msg/AdminOverride"
+	MsgPauseProposalMethod                    = "/regen.group.v1alpha1.Msg/PauseProposal"
+	MsgResumeProposalMethod                   = "/regen.group.v1alpha1.Msg/ResumeProposal"
 )
@@ -1,7 +1,12 @@
 package group
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/cockroachdb/apd/v2"
@@ -46,6 +51,43 @@ func (p ProposalID) Empty() bool {
 type DecisionPolicyResult struct {
 	Allow bool
 	Final bool
+	// Reason is a human-readable explanation of a final result.
+	Reason string
+}
+
+// Reasons of final decision policy results and other proposal outcomes,
+// stored as the proposal result reason.
+const (
+	ResultReasonThresholdReached                  = "threshold reached"
+	ResultReasonThresholdNotReachable             = "failed to reach threshold"
+	ResultReasonExpired                           = "expired without reaching threshold"
+	ResultReasonVetoed                            = "vetoed"
+	ResultReasonVetoFractionExceeded              = "vetoed by fraction of votes cast"
+	ResultReasonYesToNoRatioNotReached            = "failed to reach yes to no ratio"
+	ResultReasonQuorumNotReachable                = "failed to reach quorum"
+	ResultReasonExpiredWithoutQuorum              = "expired without quorum"
+	ResultReasonDecisiveParticipationNotReachable = "failed to reach decisive participation"
+	ResultReasonPercentageReached                 = "percentage reached"
+	ResultReasonPercentageNotReachable            = "failed to reach percentage"
+	ResultReasonOptionSelected                    = "option selected"
+	ResultReasonNoOptionSelected                  = "no option selected"
+	ResultReasonChambersApproved                  = "approved by both chambers"
+	ResultReasonChambersNotApproved               = "expired without approval of both chambers"
+	ResultReasonConvictionReached                 = "conviction reached"
+	ResultReasonExpiredWithoutConviction          = "expired without reaching conviction"
+	ResultReasonGroupModified                     = "group modified"
+	ResultReasonGroupAccountModified              = "group account modified"
+	ResultReasonAdminExecuted                     = "executed by admin override"
+	ResultReasonAdminCancelled                    = "cancelled by admin override"
+)
+
+// GroupValidator is an optional hook that lets the host app reject group
+// operations based on app-specific rules, e.g. to only allow KYC'd admins.
+type GroupValidator interface {
+	// ValidateCreateGroup is called before a group is created.
+	ValidateCreateGroup(ctx sdk.Context, admin string, members []Member) error
+	// ValidateAddMember is called before a new member is added to an existing group.
+	ValidateAddMember(ctx sdk.Context, groupID ID, member Member) error
 }
 
 // DecisionPolicy is the persistent set of rules to determine the result of election on a proposal.
@@ -56,24 +98,106 @@ type DecisionPolicy interface {
 	GetTimeout() types.Duration
 	Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error)
 	Validate(g GroupInfo) error
+	// PolicyType returns a short human readable name of the policy type.
+	PolicyType() string
+}
+
+// EvaluatePolicy evaluates the decision policy against a hypothetical tally, total
+// power and elapsed voting time. It is a pure function and does not depend on any
+// stored proposal or group.
+func EvaluatePolicy(policy DecisionPolicy, tally Tally, totalPower string, elapsed time.Duration) (DecisionPolicyResult, error) {
+	if policy == nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	return policy.Allow(tally, totalPower, elapsed)
 }
 
+// DeriveStatus evaluates the decision policy against the tally and returns the
+// resulting status, result and result reason of the proposal. A proposal stays
+// ProposalStatusSubmitted with ProposalResultUnfinalized until the decision policy
+// returns a final result, it is ProposalStatusClosed with ProposalResultAccepted
+// or ProposalResultRejected afterwards. The reason tells a proposal rejected by
+// its votes from one that expired, e.g. ResultReasonExpired.
+func DeriveStatus(tally Tally, policy DecisionPolicy, totalPower string, elapsed time.Duration) (status Proposal_Status, result Proposal_Result, reason string, err error) {
+	res, err := EvaluatePolicy(policy, tally, totalPower, elapsed)
+	switch {
+	case err != nil:
+		return ProposalStatusInvalid, ProposalResultInvalid, "", err
+	case !res.Final:
+		return ProposalStatusSubmitted, ProposalResultUnfinalized, "", nil
+	case res.Allow:
+		return ProposalStatusClosed, ProposalResultAccepted, res.Reason, nil
+	}
+	return ProposalStatusClosed, ProposalResultRejected, res.Reason, nil
+}
+
+// ThresholdPolicyType is the PolicyType of a ThresholdDecisionPolicy.
+const ThresholdPolicyType = "threshold"
+
 // Implements DecisionPolicy Interface
 var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, timeout types.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, timeout}
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
+}
+
+// NewThresholdDecisionPolicyFromDuration creates a validated threshold decision
+// policy with the given Go duration as timeout, including its sub-second part.
+func NewThresholdDecisionPolicyFromDuration(threshold string, timeout time.Duration) (*ThresholdDecisionPolicy, error) {
+	p := &ThresholdDecisionPolicy{Threshold: threshold, Timeout: *types.DurationProto(timeout)}
+	if err := p.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// PolicyType returns ThresholdPolicyType.
+func (p ThresholdDecisionPolicy) PolicyType() string {
+	return ThresholdPolicyType
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
+// When a quorum is set, the weighted sum of all votes including abstain and veto votes must also
+// reach it. Abstain votes don't count toward the threshold, but they keep a proposal that reached
+// the threshold from failing the quorum.
+// When a veto threshold is set and reached, the proposal is rejected, even if the threshold was reached as well.
+// A reached threshold then only accepts the proposal before the timeout once the undecided power can't reach
+// the veto threshold anymore, and otherwise at the timeout.
+// When a minimum number of veto voters is set, the veto threshold only rejects the proposal once at least that
+// many distinct members voted veto.
+// When a veto damping factor is set, veto votes reduce the yes count before it is compared to the threshold.
+// A reached threshold then only accepts the proposal before the timeout once veto votes of the undecided power
+// can't damp the yes count below the threshold anymore.
+// When a minimum yes to no ratio is set, the yes votes must also outnumber the no votes by it. A reached threshold
+// then only accepts the proposal before the timeout once no votes of the undecided power can't fail the ratio anymore.
+// When a veto fraction of cast is set, a proposal with more veto votes than that fraction of the cast votes
+// can't succeed, and is rejected once all power voted. A reached threshold then only accepts the proposal before
+// the timeout once veto votes of the undecided power can't exceed the fraction anymore.
+// When a minimum decisive participation is set, the decisiveness of the tally must also reach it, so that
+// a quorum met by abstain votes alone isn't enough, and the proposal is rejected once it can't be reached.
+// A negative voting duration means that voting hasn't started yet.
 func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if votingDuration < 0 {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
 	timeout, err := types.DurationFromProto(&p.Timeout)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	if timeout <= votingDuration {
-		return DecisionPolicyResult{Allow: false, Final: true}, nil
+
+	// Veto-reject takes precedence over threshold-accept.
+	vetoed := false
+	if p.VetoThreshold != "" {
+		vetoThreshold, err := math.ParsePositiveDecimal(p.VetoThreshold)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		vetoCount, err := tally.GetVetoCount()
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		vetoed = vetoCount.Cmp(vetoThreshold) >= 0 && tally.VetoVoters >= p.MinVetoVoters
 	}
 
 	threshold, err := math.ParsePositiveDecimal(p.Threshold)
@@ -84,8 +208,54 @@ func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDur
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	if yesCount.Cmp(threshold) >= 0 {
-		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	yesCount, err = p.dampYes(yesCount, tally)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	ratioReached, err := p.reachesYesToNoRatio(tally)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	vetoFractionExceeded, err := p.exceedsVetoFractionOfCast(tally)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	decisiveReached, err := p.reachesDecisiveParticipation(tally, totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	thresholdReached := yesCount.Cmp(threshold) >= 0 && ratioReached && !vetoFractionExceeded && decisiveReached
+	quorumReached := true
+	if p.Quorum != "" {
+		quorumReached, err = reachesQuorum(tally, p.Quorum)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+	}
+
+	if timeout <= votingDuration {
+		// An accept that waited for the undecided power stands once the voting period is over.
+		if p.defersAccept() && thresholdReached && quorumReached && !vetoed {
+			return DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached}, nil
+		}
+		reason := ResultReasonExpired
+		if !quorumReached {
+			reason = ResultReasonExpiredWithoutQuorum
+		}
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: reason}, nil
+	}
+	if vetoed {
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonVetoed}, nil
+	}
+
+	if thresholdReached && quorumReached {
+		final, err := p.acceptFinal(tally, totalPower)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if final {
+			return DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached}, nil
+		}
 	}
 
 	totalPowerDec, err := math.ParseNonNegativeDecimal(totalPower)
@@ -96,195 +266,1698 @@ func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDur
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	var undecided apd.Decimal
-	err = math.SafeSub(&undecided, totalPowerDec, totalCounts)
+	undecided, err := uncastWeight(totalPowerDec, totalCounts)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	var sum apd.Decimal
-	err = math.Add(&sum, yesCount, &undecided)
+
+	if p.Quorum != "" {
+		quorum, err := math.ParsePositiveDecimal(p.Quorum)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		// Reject when the quorum can't be reached anymore.
+		var maxParticipation apd.Decimal
+		err = math.Add(&maxParticipation, totalCounts, undecided)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if maxParticipation.Cmp(quorum) < 0 {
+			return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonQuorumNotReachable}, nil
+		}
+	}
+
+	// Reject when the decisive participation can't be reached anymore, even if all
+	// undecided power votes decisively.
+	if !decisiveReached {
+		maxDecisive := tally.Clone()
+		noCount, err := maxDecisive.GetNoCount()
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if err := math.Add(noCount, noCount, undecided); err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		maxDecisive.NoCount = math.DecimalString(noCount)
+		reachable, err := p.reachesDecisiveParticipation(maxDecisive, totalPower)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if !reachable {
+			return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonDecisiveParticipationNotReachable}, nil
+		}
+	}
+
+	canPass, err := p.CanStillPass(tally, totalPower)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	if sum.Cmp(threshold) < 0 {
-		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	if !canPass {
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonThresholdNotReachable}, nil
+	}
+	// Only further non veto votes can lower the veto fraction.
+	if vetoFractionExceeded && undecided.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonVetoFractionExceeded}, nil
+	}
+	// Only further yes votes can raise the ratio.
+	if !ratioReached && undecided.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonYesToNoRatioNotReached}, nil
 	}
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
-// Validate returns an error if policy threshold is greater than the total group weight
-func (p *ThresholdDecisionPolicy) Validate(g GroupInfo) error {
+// defersAccept returns whether votes cast after the threshold was reached can still
+// reject the proposal, so that an accept must wait for the undecided power.
+func (p ThresholdDecisionPolicy) defersAccept() bool {
+	return p.VetoThreshold != "" || p.VetoDampingFactor != "" || p.MinYesToNoRatio != "" ||
+		p.VetoFractionOfCast != ""
+}
+
+// acceptFinal returns whether a tally that reached the threshold stays accepted however
+// the undecided power votes.
+func (p ThresholdDecisionPolicy) acceptFinal(tally Tally, totalPower string) (bool, error) {
+	if !p.defersAccept() {
+		return true, nil
+	}
+	totalPowerDec, err := math.ParseNonNegativeDecimal(totalPower)
+	if err != nil {
+		return false, err
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return false, err
+	}
+	undecided, err := uncastWeight(totalPowerDec, totalCounts)
+	if err != nil {
+		return false, err
+	}
+	if undecided.IsZero() {
+		return true, nil
+	}
+	// The veto count is highest when all undecided power votes veto.
+	vetoCount, err := tally.GetVetoCount()
+	if err != nil {
+		return false, err
+	}
+	var maxVeto apd.Decimal
+	if err := math.Add(&maxVeto, vetoCount, undecided); err != nil {
+		return false, err
+	}
+	if p.VetoThreshold != "" {
+		vetoThreshold, err := math.ParsePositiveDecimal(p.VetoThreshold)
+		if err != nil {
+			return false, err
+		}
+		if maxVeto.Cmp(vetoThreshold) >= 0 {
+			return false, nil
+		}
+	}
+	maxVetoTally := tally.Clone()
+	maxVetoTally.VetoCount = math.DecimalString(&maxVeto)
+	if p.VetoDampingFactor != "" {
+		threshold, err := math.ParsePositiveDecimal(p.Threshold)
+		if err != nil {
+			return false, err
+		}
+		yesCount, err := tally.GetYesCount()
+		if err != nil {
+			return false, err
+		}
+		minYes, err := p.dampYes(yesCount, maxVetoTally)
+		if err != nil {
+			return false, err
+		}
+		if minYes.Cmp(threshold) < 0 {
+			return false, nil
+		}
+	}
+	if p.VetoFractionOfCast != "" {
+		exceeded, err := p.exceedsVetoFractionOfCast(maxVetoTally)
+		if err != nil {
+			return false, err
+		}
+		if exceeded {
+			return false, nil
+		}
+	}
+	// The yes to no ratio is lowest when all undecided power votes no.
+	if p.MinYesToNoRatio != "" {
+		maxNoTally := tally.Clone()
+		noCount, err := maxNoTally.GetNoCount()
+		if err != nil {
+			return false, err
+		}
+		if err := math.Add(noCount, noCount, undecided); err != nil {
+			return false, err
+		}
+		maxNoTally.NoCount = math.DecimalString(noCount)
+		ratioReached, err := p.reachesYesToNoRatio(maxNoTally)
+		if err != nil {
+			return false, err
+		}
+		if !ratioReached {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Deadline returns the absolute time at which a proposal submitted at submitTime
+// times out under this policy, i.e. submitTime plus the policy timeout including
+// its nanoseconds.
+func (p ThresholdDecisionPolicy) Deadline(submitTime time.Time) time.Time {
+	timeout := time.Duration(p.Timeout.Seconds)*time.Second + time.Duration(p.Timeout.Nanos)
+	return submitTime.Add(timeout)
+}
+
+// reachesQuorum returns true when the sum of all votes, including abstain votes,
+// meets or exceeds the quorum.
+func reachesQuorum(tally Tally, quorum string) (bool, error) {
+	quorumDec, err := math.ParsePositiveDecimal(quorum)
+	if err != nil {
+		return false, err
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return false, err
+	}
+	return totalCounts.Cmp(quorumDec) >= 0, nil
+}
+
+// quorumPolicy is implemented by decision policies that can have a quorum.
+type quorumPolicy interface {
+	GetQuorum() string
+}
+
+// QuorumReached returns true when the sum of all votes of the tally, including
+// abstain votes, meets or exceeds the quorum of the decision policy, independent
+// of whether the proposal is accepted. It returns an error if the policy has no
+// quorum configured.
+func QuorumReached(policy DecisionPolicy, tally Tally) (bool, error) {
+	p, ok := policy.(quorumPolicy)
+	if !ok || p.GetQuorum() == "" {
+		return false, sdkerrors.Wrap(ErrEmpty, "decision policy quorum")
+	}
+	return reachesQuorum(tally, p.GetQuorum())
+}
+
+// CanStillPass returns true when the threshold can still be reached, i.e. the
+// maximum achievable yes count is greater than or equal to the threshold.
+func (p ThresholdDecisionPolicy) CanStillPass(tally Tally, totalPower string) (bool, error) {
 	threshold, err := math.ParsePositiveDecimal(p.Threshold)
 	if err != nil {
-		return sdkerrors.Wrap(err, "threshold")
+		return false, err
 	}
-	totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	maxYes, err := MaxAchievableYes(tally, totalPower)
 	if err != nil {
-		return sdkerrors.Wrap(err, "group total weight")
+		return false, err
 	}
-	if threshold.Cmp(totalWeight) > 0 {
-		return sdkerrors.Wrap(ErrInvalid, "policy threshold should not be greater than the total group weight")
+	maxYesDec, err := math.ParseNonNegativeDecimal(maxYes)
+	if err != nil {
+		return false, err
 	}
-	return nil
+	maxYesDec, err = p.dampYes(maxYesDec, tally)
+	if err != nil {
+		return false, err
+	}
+	return maxYesDec.Cmp(threshold) >= 0, nil
 }
 
-func (p ThresholdDecisionPolicy) ValidateBasic() error {
-	if _, err := math.ParsePositiveDecimal(p.Threshold); err != nil {
-		return sdkerrors.Wrap(err, "threshold")
+// dampYes reduces yes by the veto count multiplied with the veto damping factor,
+// floored at zero. yes is returned unchanged when no damping factor is set.
+func (p ThresholdDecisionPolicy) dampYes(yes *apd.Decimal, tally Tally) (*apd.Decimal, error) {
+	if p.VetoDampingFactor == "" {
+		return yes, nil
+	}
+	factor, err := math.ParseNonNegativeDecimal(p.VetoDampingFactor)
+	if err != nil {
+		return nil, err
+	}
+	vetoCount, err := tally.GetVetoCount()
+	if err != nil {
+		return nil, err
+	}
+	var damping apd.Decimal
+	if err := math.Mul(&damping, factor, vetoCount); err != nil {
+		return nil, err
+	}
+	if damping.Cmp(yes) >= 0 {
+		return apd.New(0, 0), nil
 	}
+	var res apd.Decimal
+	if err := math.SafeSub(&res, yes, &damping); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
 
-	timeout, err := types.DurationFromProto(&p.Timeout)
+// exceedsVetoFractionOfCast returns true when the veto count is greater than the
+// veto fraction of the sum of all votes cast. It is always false without a veto
+// fraction or without any votes cast.
+func (p ThresholdDecisionPolicy) exceedsVetoFractionOfCast(tally Tally) (bool, error) {
+	if p.VetoFractionOfCast == "" {
+		return false, nil
+	}
+	fraction, err := math.ParsePositiveDecimal(p.VetoFractionOfCast)
+	if err != nil {
+		return false, err
+	}
+	totalCounts, err := tally.TotalCounts()
 	if err != nil {
-		return sdkerrors.Wrap(err, "timeout")
+		return false, err
 	}
+	if totalCounts.IsZero() {
+		return false, nil
+	}
+	vetoCount, err := tally.GetVetoCount()
+	if err != nil {
+		return false, err
+	}
+	var maxVeto apd.Decimal
+	if err := math.Mul(&maxVeto, totalCounts, fraction); err != nil {
+		return false, err
+	}
+	return vetoCount.Cmp(&maxVeto) > 0, nil
+}
 
-	if timeout <= time.Nanosecond {
-		return sdkerrors.Wrap(ErrInvalid, "timeout")
+// reachesDecisiveParticipation returns true when the decisiveness of the tally, i.e. the
+// share of the total power that cast decisive votes, meets or exceeds the minimum decisive
+// participation. It is always true without a minimum, and never with zero total power.
+func (p ThresholdDecisionPolicy) reachesDecisiveParticipation(tally Tally, totalPower string) (bool, error) {
+	if p.MinDecisiveParticipation == "" {
+		return true, nil
 	}
-	return nil
+	minParticipation, err := math.ParsePositiveDecimal(p.MinDecisiveParticipation)
+	if err != nil {
+		return false, err
+	}
+	totalPowerDec, err := math.ParseNonNegativeDecimal(totalPower)
+	if err != nil {
+		return false, err
+	}
+	if totalPowerDec.IsZero() {
+		return false, nil
+	}
+	decisiveness, err := tally.Decisiveness(totalPower)
+	if err != nil {
+		return false, err
+	}
+	return decisiveness.Cmp(minParticipation) >= 0, nil
 }
 
-func (g GroupMember) NaturalKey() []byte {
-	result := make([]byte, 8, 8+len(g.Member.Address))
-	copy(result[0:8], g.GroupId.Bytes())
-	result = append(result, g.Member.Address...)
-	return result
+// reachesYesToNoRatio returns true when the yes count is at least the no count
+// multiplied by the minimum yes to no ratio. It is always true without a ratio
+// or without no votes.
+func (p ThresholdDecisionPolicy) reachesYesToNoRatio(tally Tally) (bool, error) {
+	if p.MinYesToNoRatio == "" {
+		return true, nil
+	}
+	ratio, err := math.ParsePositiveDecimal(p.MinYesToNoRatio)
+	if err != nil {
+		return false, err
+	}
+	noCount, err := tally.GetNoCount()
+	if err != nil {
+		return false, err
+	}
+	if noCount.IsZero() {
+		return true, nil
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return false, err
+	}
+	var minYes apd.Decimal
+	if err := math.Mul(&minYes, noCount, ratio); err != nil {
+		return false, err
+	}
+	return yesCount.Cmp(&minYes) >= 0, nil
 }
 
-func (g GroupAccountInfo) NaturalKey() []byte {
-	addr, err := sdk.AccAddressFromBech32(g.GroupAccount)
+// MaxAchievableYes returns the highest yes count a tally can still reach: the
+// current yes count plus all the power that hasn't been cast yet.
+func MaxAchievableYes(tally Tally, totalPower string) (string, error) {
+	totalPowerDec, err := math.ParseNonNegativeDecimal(totalPower)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
-	return addr
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return "", err
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return "", err
+	}
+	maxYes, err := uncastWeight(totalPowerDec, totalCounts)
+	if err != nil {
+		return "", err
+	}
+	if err := math.Add(maxYes, maxYes, yesCount); err != nil {
+		return "", err
+	}
+	return math.DecimalString(maxYes), nil
 }
 
-var _ orm.Validateable = GroupAccountInfo{}
+// UncastWeight returns the weight that hasn't been cast yet, i.e. the total power
+// minus the total counts of the tally. Counts exceeding the total power are an error.
+func UncastWeight(tally Tally, totalPower string) (string, error) {
+	totalPowerDec, err := math.ParseNonNegativeDecimal(totalPower)
+	if err != nil {
+		return "", err
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return "", err
+	}
+	uncast, err := uncastWeight(totalPowerDec, totalCounts)
+	if err != nil {
+		return "", err
+	}
+	return math.DecimalString(uncast), nil
+}
 
-// NewGroupAccountInfo creates a new GroupAccountInfo instance
-func NewGroupAccountInfo(groupAccount sdk.AccAddress, group ID, admin sdk.AccAddress, metadata []byte, version uint64, decisionPolicy DecisionPolicy) (GroupAccountInfo, error) {
-	p := GroupAccountInfo{
-		GroupAccount: groupAccount.String(),
-		GroupId:      group,
-		Admin:        admin.String(),
-		Metadata:     metadata,
-		Version:      version,
+func uncastWeight(totalPower, totalCounts *apd.Decimal) (*apd.Decimal, error) {
+	if totalCounts.Cmp(totalPower) > 0 {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "total counts %s exceed total power %s",
+			math.DecimalString(totalCounts), math.DecimalString(totalPower))
+	}
+	var res apd.Decimal
+	if err := math.SafeSub(&res, totalPower, totalCounts); err != nil {
+		return nil, err
 	}
+	return &res, nil
+}
 
-	msg, ok := decisionPolicy.(proto.Message)
-	if !ok {
-		return GroupAccountInfo{}, fmt.Errorf("%T does not implement proto.Message", decisionPolicy)
+// Validate returns an error if policy threshold is greater than the total group weight.
+// Weights can be fractional, so the values are compared as exact decimals: a
+// threshold equal to the total weight is valid, whatever its representation
+// (e.g. "1.50" and "1.5").
+func (p *ThresholdDecisionPolicy) Validate(g GroupInfo) error {
+	threshold, err := math.ParsePositiveDecimal(p.Threshold)
+	if err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+	if threshold.Cmp(totalWeight) > 0 {
+		return sdkerrors.Wrap(ErrInvalidThreshold, "policy threshold should not be greater than the total group weight")
+	}
+	if p.Quorum != "" {
+		quorum, err := math.ParsePositiveDecimal(p.Quorum)
+		if err != nil {
+			return sdkerrors.Wrap(err, "quorum")
+		}
+		if quorum.Cmp(totalWeight) > 0 {
+			return sdkerrors.Wrap(ErrInvalidThreshold, "policy quorum should not be greater than the total group weight")
+		}
 	}
+	return nil
+}
 
-	any, err := codectypes.NewAnyWithValue(msg)
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if _, err := math.ParsePositiveDecimal(p.Threshold); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidThreshold, "threshold: %s", err)
+	}
+	if p.Quorum != "" {
+		if _, err := math.ParsePositiveDecimal(p.Quorum); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidThreshold, "quorum: %s", err)
+		}
+	}
+	if p.VetoThreshold != "" {
+		if _, err := math.ParsePositiveDecimal(p.VetoThreshold); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidThreshold, "veto threshold: %s", err)
+		}
+	}
+	if p.MinVetoVoters != 0 && p.VetoThreshold == "" {
+		return sdkerrors.Wrap(ErrInvalid, "min veto voters require a veto threshold")
+	}
+	if p.VetoDampingFactor != "" {
+		if _, err := math.ParseNonNegativeDecimal(p.VetoDampingFactor); err != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "veto damping factor: %s", err)
+		}
+	}
+	if p.MinYesToNoRatio != "" {
+		if _, err := math.ParsePositiveDecimal(p.MinYesToNoRatio); err != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "min yes to no ratio: %s", err)
+		}
+	}
+	if p.VetoFractionOfCast != "" {
+		fraction, err := math.ParsePositiveDecimal(p.VetoFractionOfCast)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "veto fraction of cast: %s", err)
+		}
+		if fraction.Cmp(apd.New(1, 0)) > 0 {
+			return sdkerrors.Wrap(ErrInvalid, "veto fraction of cast must not be greater than 1")
+		}
+	}
+	if p.MinDecisiveParticipation != "" {
+		participation, err := math.ParsePositiveDecimal(p.MinDecisiveParticipation)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "min decisive participation: %s", err)
+		}
+		if participation.Cmp(apd.New(1, 0)) > 0 {
+			return sdkerrors.Wrap(ErrInvalid, "min decisive participation must not be greater than 1")
+		}
+	}
+	return validateTimeout(p.Timeout)
+}
+
+// validateTimeout returns ErrTimeoutOutOfBounds unless timeout is a valid positive duration.
+func validateTimeout(timeout types.Duration) error {
+	d, err := types.DurationFromProto(&timeout)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrTimeoutOutOfBounds, "timeout: %s", err)
+	}
+	if d <= time.Nanosecond {
+		return sdkerrors.Wrap(ErrTimeoutOutOfBounds, "timeout")
+	}
+	return nil
+}
+
+// PercentagePolicyType is the PolicyType of a PercentageDecisionPolicy.
+const PercentagePolicyType = "percentage"
+
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &PercentageDecisionPolicy{}
+
+// NewPercentageDecisionPolicy creates a percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage string, timeout types.Duration, excludeAbstainFromBase bool) DecisionPolicy {
+	return &PercentageDecisionPolicy{percentage, timeout, excludeAbstainFromBase}
+}
+
+// PolicyType returns PercentagePolicyType.
+func (p PercentageDecisionPolicy) PolicyType() string {
+	return PercentagePolicyType
+}
+
+// Allow allows a proposal to pass when the share of yes votes equals or exceeds the percentage.
+// By default the share is measured against the total power. With ExcludeAbstainFromBase it is
+// measured against the decisive (yes, no and veto) votes only. Before the timeout a proposal is
+// only decided when the remaining undecided power can't change the result anymore.
+// A negative voting duration means that voting hasn't started yet.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if votingDuration < 0 {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+	timeout, err := types.DurationFromProto(&p.Timeout)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	percentage, err := math.ParsePositiveDecimal(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	totalPowerDec, err := math.ParseNonNegativeDecimal(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	abstainCount, err := tally.GetAbstainCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	undecided, err := uncastWeight(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	// base is the power the yes votes are measured against with all cast votes.
+	base := totalPowerDec
+	if p.ExcludeAbstainFromBase {
+		base = apd.New(0, 0)
+		if err := math.SafeSub(base, totalCounts, abstainCount); err != nil {
+			return DecisionPolicyResult{}, err
+		}
+	}
+
+	if timeout <= votingDuration {
+		if base.IsZero() {
+			return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonPercentageNotReachable}, nil
+		}
+		pass, err := meetsPercentage(yesCount, base, percentage)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if !pass {
+			return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonPercentageNotReachable}, nil
+		}
+		return DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonPercentageReached}, nil
+	}
+
+	// maxBase is the base after all undecided power has voted decisively.
+	maxBase := base
+	if p.ExcludeAbstainFromBase {
+		maxBase = apd.New(0, 0)
+		if err := math.Add(maxBase, base, undecided); err != nil {
+			return DecisionPolicyResult{}, err
+		}
+	}
+	if maxBase.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+
+	// Accept when the proposal would pass even if all undecided power votes no.
+	pass, err := meetsPercentage(yesCount, maxBase, percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if pass {
+		return DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonPercentageReached}, nil
+	}
+
+	// Reject when the proposal can't pass even if all undecided power votes yes.
+	var maxYes apd.Decimal
+	if err := math.Add(&maxYes, yesCount, undecided); err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	pass, err = meetsPercentage(&maxYes, maxBase, percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if !pass {
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonPercentageNotReachable}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// meetsPercentage returns true if yes / base >= percentage.
+func meetsPercentage(yes, base, percentage *apd.Decimal) (bool, error) {
+	var required apd.Decimal
+	if err := math.Mul(&required, base, percentage); err != nil {
+		return false, err
+	}
+	return yes.Cmp(&required) >= 0, nil
+}
+
+// Validate always succeeds as a percentage doesn't depend on the total group weight.
+func (p *PercentageDecisionPolicy) Validate(g GroupInfo) error {
+	return nil
+}
+
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	percentage, err := math.ParsePositiveDecimal(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidThreshold, "percentage: %s", err)
+	}
+	if percentage.Cmp(apd.New(1, 0)) > 0 {
+		return sdkerrors.Wrap(ErrInvalidThreshold, "percentage must not be greater than 1")
+	}
+	return validateTimeout(p.Timeout)
+}
+
+// PluralityPolicyType is the PolicyType of a PluralityDecisionPolicy.
+const PluralityPolicyType = "plurality"
+
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &PluralityDecisionPolicy{}
+
+// NewPluralityDecisionPolicy creates a plurality DecisionPolicy
+func NewPluralityDecisionPolicy(quorum string, timeout types.Duration) DecisionPolicy {
+	return &PluralityDecisionPolicy{Quorum: quorum, Timeout: timeout}
+}
+
+// PolicyType returns PluralityPolicyType.
+func (p PluralityDecisionPolicy) PolicyType() string {
+	return PluralityPolicyType
+}
+
+// Allow allows a proposal with an option set to pass when an option is selected, see Winner,
+// and the quorum, if set, is reached. Before the timeout a proposal is only decided when the
+// remaining undecided power can't change the leading option anymore.
+// A negative voting duration means that voting hasn't started yet.
+func (p PluralityDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if votingDuration < 0 {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+	timeout, err := types.DurationFromProto(&p.Timeout)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	totalPowerDec, err := math.ParseNonNegativeDecimal(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	undecided, err := uncastWeight(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	_, first, second, err := tally.leadingOptions()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	quorumReached := true
+	if p.Quorum != "" {
+		quorum, err := math.ParsePositiveDecimal(p.Quorum)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		quorumReached = totalCounts.Cmp(quorum) >= 0
+	}
+
+	if timeout <= votingDuration || undecided.IsZero() {
+		switch {
+		case !quorumReached:
+			return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonExpiredWithoutQuorum}, nil
+		case !p.selects(first, second):
+			return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonNoOptionSelected}, nil
+		}
+		return DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonOptionSelected}, nil
+	}
+
+	// Accept when the undecided power can't catch up with the leading option anymore.
+	var lead apd.Decimal
+	if err := math.SafeSub(&lead, first, second); err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if quorumReached && lead.Cmp(undecided) > 0 {
+		return DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonOptionSelected}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Winner returns the index of the option with the highest weighted sum of votes. A tie is
+// resolved according to the policy's tie break. ok is false when no option is selected.
+// The quorum is not taken into account.
+func (p PluralityDecisionPolicy) Winner(tally Tally) (option uint32, ok bool, err error) {
+	leader, first, second, err := tally.leadingOptions()
+	if err != nil {
+		return 0, false, err
+	}
+	return leader, p.selects(first, second), nil
+}
+
+// selects returns true when the leading option with count first is selected over the
+// runner-up with count second. leadingOptions returns the lowest index on a tie.
+func (p PluralityDecisionPolicy) selects(first, second *apd.Decimal) bool {
+	if first.IsZero() {
+		return false
+	}
+	return first.Cmp(second) > 0 || p.TieBreak == TieBreak_TIE_BREAK_LOWEST_INDEX
+}
+
+// Validate returns an error if the policy quorum is greater than the total group weight.
+func (p *PluralityDecisionPolicy) Validate(g GroupInfo) error {
+	if p.Quorum == "" {
+		return nil
+	}
+	quorum, err := math.ParsePositiveDecimal(p.Quorum)
+	if err != nil {
+		return sdkerrors.Wrap(err, "quorum")
+	}
+	totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+	if quorum.Cmp(totalWeight) > 0 {
+		return sdkerrors.Wrap(ErrInvalidThreshold, "policy quorum should not be greater than the total group weight")
+	}
+	return nil
+}
+
+func (p PluralityDecisionPolicy) ValidateBasic() error {
+	if p.Quorum != "" {
+		if _, err := math.ParsePositiveDecimal(p.Quorum); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidThreshold, "quorum: %s", err)
+		}
+	}
+	if _, ok := TieBreak_name[int32(p.TieBreak)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "tie break")
+	}
+	return validateTimeout(p.Timeout)
+}
+
+// BicameralPolicyType is the PolicyType of a BicameralDecisionPolicy.
+const BicameralPolicyType = "bicameral"
+
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &BicameralDecisionPolicy{}
+
+// NewBicameralDecisionPolicy creates a bicameral DecisionPolicy
+func NewBicameralDecisionPolicy(first, second Chamber, timeout types.Duration) DecisionPolicy {
+	return &BicameralDecisionPolicy{First: first, Second: second, Timeout: timeout}
+}
+
+// PolicyType returns BicameralPolicyType.
+func (p BicameralDecisionPolicy) PolicyType() string {
+	return BicameralPolicyType
+}
+
+// Allow allows a proposal to pass when the yes votes of the members of each chamber,
+// counted in the role tallies, reach the chamber's threshold. A proposal is accepted
+// as soon as both chambers approved it and rejected at the timeout otherwise.
+// A negative voting duration means that voting hasn't started yet.
+func (p BicameralDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if votingDuration < 0 {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+	timeout, err := types.DurationFromProto(&p.Timeout)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	firstApproved, err := p.First.approves(tally)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "first chamber")
+	}
+	secondApproved, err := p.Second.approves(tally)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "second chamber")
+	}
+	if firstApproved && secondApproved {
+		return DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonChambersApproved}, nil
+	}
+	if timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonChambersNotApproved}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Validate returns an error if a chamber role isn't defined by the group's role multipliers.
+func (p *BicameralDecisionPolicy) Validate(g GroupInfo) error {
+	roles := RoleMultipliers(g.RoleMultipliers)
+	if _, err := roles.Multiplier(p.First.Role); err != nil {
+		return sdkerrors.Wrap(err, "first chamber")
+	}
+	if _, err := roles.Multiplier(p.Second.Role); err != nil {
+		return sdkerrors.Wrap(err, "second chamber")
+	}
+	return nil
+}
+
+func (p BicameralDecisionPolicy) ValidateBasic() error {
+	if err := p.First.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "first chamber")
+	}
+	if err := p.Second.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "second chamber")
+	}
+	if p.First.Role == p.Second.Role {
+		return sdkerrors.Wrap(ErrInvalid, "chambers must have different roles")
+	}
+	return validateTimeout(p.Timeout)
+}
+
+func (c Chamber) ValidateBasic() error {
+	if c.Role == "" {
+		return sdkerrors.Wrap(ErrEmpty, "role")
+	}
+	if _, err := math.ParsePositiveDecimal(c.Threshold); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidThreshold, "threshold: %s", err)
+	}
+	return nil
+}
+
+// approves returns true when the yes votes of the chamber's members reach its threshold.
+func (c Chamber) approves(tally Tally) (bool, error) {
+	threshold, err := math.ParsePositiveDecimal(c.Threshold)
+	if err != nil {
+		return false, err
+	}
+	roleTally := tally.RoleTally(c.Role)
+	yesCount, err := roleTally.GetYesCount()
+	if err != nil {
+		return false, err
+	}
+	return yesCount.Cmp(threshold) >= 0, nil
+}
+
+// ConvictionPolicyType is the PolicyType of a ConvictionDecisionPolicy.
+const ConvictionPolicyType = "conviction"
+
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &ConvictionDecisionPolicy{}
+
+// NewConvictionDecisionPolicy creates a conviction DecisionPolicy
+func NewConvictionDecisionPolicy(threshold string, timeout, growthPeriod types.Duration, maxMultiplier string) DecisionPolicy {
+	return &ConvictionDecisionPolicy{Threshold: threshold, Timeout: timeout, GrowthPeriod: growthPeriod, MaxMultiplier: maxMultiplier}
+}
+
+// PolicyType returns ConvictionPolicyType.
+func (p ConvictionDecisionPolicy) PolicyType() string {
+	return ConvictionPolicyType
+}
+
+// Allow allows a proposal to pass when the conviction, i.e. the yes count of a tally
+// built with ConvictionTally, reaches the threshold. A proposal is rejected at the
+// timeout otherwise, as conviction can still grow until then.
+// A negative voting duration means that voting hasn't started yet.
+func (p ConvictionDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if votingDuration < 0 {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+	timeout, err := types.DurationFromProto(&p.Timeout)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	threshold, err := math.ParsePositiveDecimal(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	conviction, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if conviction.Cmp(threshold) >= 0 {
+		return DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonConvictionReached}, nil
+	}
+	if timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonExpiredWithoutConviction}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Multiplier returns the multiplier of a yes vote cast at votedAt when measured at
+// the given time: 1 plus the number of growth periods it was held, capped at the
+// max multiplier.
+func (p ConvictionDecisionPolicy) Multiplier(votedAt, at time.Time) (*apd.Decimal, error) {
+	maxMultiplier, err := math.ParsePositiveDecimal(p.MaxMultiplier)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "max multiplier")
+	}
+	period, err := types.DurationFromProto(&p.GrowthPeriod)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "growth period")
+	}
+	held := at.Sub(votedAt)
+	if held <= 0 {
+		return apd.New(1, 0), nil
+	}
+	var multiplier apd.Decimal
+	if err := math.Quo(&multiplier, apd.New(int64(held), 0), apd.New(int64(period), 0)); err != nil {
+		return nil, err
+	}
+	if err := math.Add(&multiplier, &multiplier, apd.New(1, 0)); err != nil {
+		return nil, err
+	}
+	if multiplier.Cmp(maxMultiplier) > 0 {
+		return maxMultiplier, nil
+	}
+	return &multiplier, nil
+}
+
+// ConvictionTally returns a copy of the tally with the yes count replaced by the
+// conviction of the given votes at the given time, i.e. the sum of the weights of
+// the yes votes multiplied by their multiplier. weights are the voters' weights in
+// the order of votes.
+func (p ConvictionDecisionPolicy) ConvictionTally(tally Tally, votes []Vote, weights []string, at time.Time) (Tally, error) {
+	if len(votes) != len(weights) {
+		return Tally{}, sdkerrors.Wrap(ErrInvalid, "votes and weights length mismatch")
+	}
+	conviction := apd.New(0, 0)
+	for i, vote := range votes {
+		if vote.Choice != Choice_CHOICE_YES {
+			continue
+		}
+		weight, err := math.ParseNonNegativeDecimal(weights[i])
+		if err != nil {
+			return Tally{}, sdkerrors.Wrapf(err, "weight of %s", vote.Voter)
+		}
+		votedAt, err := types.TimestampFromProto(&vote.SubmittedAt)
+		if err != nil {
+			return Tally{}, sdkerrors.Wrapf(err, "submitted at of %s", vote.Voter)
+		}
+		multiplier, err := p.Multiplier(votedAt, at)
+		if err != nil {
+			return Tally{}, err
+		}
+		if err := math.Mul(weight, weight, multiplier); err != nil {
+			return Tally{}, err
+		}
+		if err := math.Add(conviction, conviction, weight); err != nil {
+			return Tally{}, err
+		}
+	}
+	res := tally.Clone()
+	res.YesCount = math.CanonicalDecimalString(conviction)
+	return res, nil
+}
+
+// Validate returns an error if the threshold can't be reached even if all group
+// members vote yes with the max multiplier.
+func (p *ConvictionDecisionPolicy) Validate(g GroupInfo) error {
+	threshold, err := math.ParsePositiveDecimal(p.Threshold)
+	if err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	maxMultiplier, err := math.ParsePositiveDecimal(p.MaxMultiplier)
+	if err != nil {
+		return sdkerrors.Wrap(err, "max multiplier")
+	}
+	totalWeight, err := math.ParseNonNegativeDecimal(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+	var maxConviction apd.Decimal
+	if err := math.Mul(&maxConviction, totalWeight, maxMultiplier); err != nil {
+		return err
+	}
+	if threshold.Cmp(&maxConviction) > 0 {
+		return sdkerrors.Wrap(ErrInvalidThreshold, "policy threshold should not be greater than the total group weight times the max multiplier")
+	}
+	return nil
+}
+
+func (p ConvictionDecisionPolicy) ValidateBasic() error {
+	if _, err := math.ParsePositiveDecimal(p.Threshold); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidThreshold, "threshold: %s", err)
+	}
+	maxMultiplier, err := math.ParsePositiveDecimal(p.MaxMultiplier)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "max multiplier: %s", err)
+	}
+	if maxMultiplier.Cmp(apd.New(1, 0)) < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "max multiplier must not be less than 1")
+	}
+	period, err := types.DurationFromProto(&p.GrowthPeriod)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "growth period: %s", err)
+	}
+	if period <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "growth period must be positive")
+	}
+	return validateTimeout(p.Timeout)
+}
+
+// MinOptionSetSize is the minimum number of options of an option set.
+const MinOptionSetSize = 2
+
+var _ orm.Validateable = OptionSet{}
+
+func (o OptionSet) ValidateBasic() error {
+	if len(o.Options) < MinOptionSetSize {
+		return sdkerrors.Wrapf(ErrInvalid, "at least %d options required", MinOptionSetSize)
+	}
+	seen := make(map[string]struct{}, len(o.Options))
+	for i, option := range o.Options {
+		if option == "" {
+			return sdkerrors.Wrapf(ErrEmpty, "option %d", i)
+		}
+		if _, ok := seen[option]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "option %q", option)
+		}
+		seen[option] = struct{}{}
+	}
+	return nil
+}
+
+func (g GroupMember) NaturalKey() []byte {
+	result := make([]byte, 8, 8+len(g.Member.Address))
+	copy(result[0:8], g.GroupId.Bytes())
+	result = append(result, g.Member.Address...)
+	return result
+}
+
+func (g GroupInvitation) NaturalKey() []byte {
+	return GroupMember{GroupId: g.GroupId, Member: g.Member}.NaturalKey()
+}
+
+func (g GroupMemberActivity) NaturalKey() []byte {
+	return GroupMember{GroupId: g.GroupId, Member: &Member{Address: g.Member}}.NaturalKey()
+}
+
+var _ orm.Validateable = GroupMemberActivity{}
+
+func (g GroupMemberActivity) ValidateBasic() error {
+	if g.GroupId.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Member); err != nil {
+		return sdkerrors.Wrap(err, "member")
+	}
+	t, err := types.TimestampFromProto(&g.LastActive)
+	if err != nil {
+		return sdkerrors.Wrap(err, "last active")
+	}
+	if t.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "last active")
+	}
+	return nil
+}
+
+var _ orm.Validateable = GroupInvitation{}
+
+func (g GroupInvitation) ValidateBasic() error {
+	if g.GroupId.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	if g.Member == nil {
+		return sdkerrors.Wrap(ErrEmpty, "member")
+	}
+	if err := g.Member.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "member")
+	}
+	t, err := types.TimestampFromProto(&g.InvitedAt)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invited at")
+	}
+	if t.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "invited at")
+	}
+	return nil
+}
+
+func (g GroupAccountInfo) NaturalKey() []byte {
+	addr, err := sdk.AccAddressFromBech32(g.GroupAccount)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+var _ orm.Validateable = GroupAccountInfo{}
+
+// NewGroupAccountInfo creates a new GroupAccountInfo instance
+func NewGroupAccountInfo(groupAccount sdk.AccAddress, group ID, admin sdk.AccAddress, metadata []byte, version uint64, decisionPolicy DecisionPolicy) (GroupAccountInfo, error) {
+	p := GroupAccountInfo{
+		GroupAccount: groupAccount.String(),
+		GroupId:      group,
+		Admin:        admin.String(),
+		Metadata:     metadata,
+		Version:      version,
+	}
+
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return GroupAccountInfo{}, fmt.Errorf("%T does not implement proto.Message", decisionPolicy)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
 	if err != nil {
 		return GroupAccountInfo{}, err
 	}
 
-	p.DecisionPolicy = any
-	return p, nil
+	p.DecisionPolicy = any
+	return p, nil
+}
+
+func (g GroupAccountInfo) GetDecisionPolicy() DecisionPolicy {
+	decisionPolicy, ok := g.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+func (g GroupAccountInfo) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(g.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	_, err = sdk.AccAddressFromBech32(g.GroupAccount)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+	policy := g.GetDecisionPolicy()
+
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "policy")
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "policy")
+	}
+	return validateSpendLimit(g.SpendLimit, g.SpendPeriod)
+}
+
+// ValidateGroupAccount validates the group account, its decision policy and the
+// consistency of the decision policy with the group of the account in one call.
+// It returns the first failure.
+func ValidateGroupAccount(info GroupAccountInfo, g GroupInfo) error {
+	if err := info.ValidateBasic(); err != nil {
+		return err
+	}
+	if info.GroupId != g.GroupId {
+		return sdkerrors.Wrapf(ErrInvalid, "group account of group %d validated against group %d", info.GroupId, g.GroupId)
+	}
+	// The decision policy is validated by info.ValidateBasic already.
+	if err := info.GetDecisionPolicy().Validate(g); err != nil {
+		return sdkerrors.Wrap(err, "policy")
+	}
+	return nil
+}
+
+// validateSpendLimit checks that an optional spend limit is valid and comes
+// with a positive spend period.
+func validateSpendLimit(limit sdk.Coins, period *types.Duration) error {
+	if limit.Empty() {
+		return nil
+	}
+	if err := limit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "spend limit")
+	}
+	if period == nil {
+		return sdkerrors.Wrap(ErrEmpty, "spend period")
+	}
+	d, err := types.DurationFromProto(period)
+	if err != nil {
+		return sdkerrors.Wrap(err, "spend period")
+	}
+	if d <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "spend period must be positive")
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupAccountInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e GroupExport) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, account := range e.GroupAccounts {
+		if err := account.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, p := range e.Proposals {
+		if err := p.Proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPolicy returns the unpacked decision policy.
+func (r QueryGroupAccountDecisionPolicyResponse) GetPolicy() DecisionPolicy {
+	decisionPolicy, ok := r.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryGroupAccountDecisionPolicyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(r.DecisionPolicy, &decisionPolicy)
+}
+
+// NewQueryEvaluatePolicyRequest creates a new Query/EvaluatePolicy request.
+func NewQueryEvaluatePolicyRequest(decisionPolicy DecisionPolicy, tally Tally, totalPower string, elapsed time.Duration) (*QueryEvaluatePolicyRequest, error) {
+	r := &QueryEvaluatePolicyRequest{
+		Tally:      tally,
+		TotalPower: totalPower,
+		Elapsed:    *types.DurationProto(elapsed),
+	}
+	if err := r.SetDecisionPolicy(decisionPolicy); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetPolicy returns the unpacked decision policy.
+func (r QueryEvaluatePolicyRequest) GetPolicy() DecisionPolicy {
+	if r.DecisionPolicy == nil {
+		return nil
+	}
+	decisionPolicy, ok := r.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// SetDecisionPolicy packs the decision policy into the request.
+func (r *QueryEvaluatePolicyRequest) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return fmt.Errorf("can't proto marshal %T", msg)
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	r.DecisionPolicy = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryEvaluatePolicyRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(r.DecisionPolicy, &decisionPolicy)
+}
+
+func (v Vote) NaturalKey() []byte {
+	return VoteNaturalKey(v.ProposalId, v.Voter)
+}
+
+// VoteNaturalKey returns the natural key of a vote. It only depends on the proposal
+// and the voter so that there is at most one vote per voter on a proposal.
+func VoteNaturalKey(proposalID ProposalID, voter string) []byte {
+	result := make([]byte, 8, 8+len(voter))
+	copy(result[0:8], proposalID.Bytes())
+	result = append(result, voter...)
+	return result
+}
+
+var _ orm.Validateable = Vote{}
+
+func (v Vote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(v.Voter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+
+	if v.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	if v.Choice == Choice_CHOICE_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "choice")
+	}
+	if _, ok := Choice_name[int32(v.Choice)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "choice")
+	}
+	if v.Option != 0 && v.Choice != Choice_CHOICE_OPTION {
+		return sdkerrors.Wrap(ErrInvalid, "option requires option choice")
+	}
+	t, err := types.TimestampFromProto(&v.SubmittedAt)
+	if err != nil {
+		return sdkerrors.Wrap(err, "submitted at")
+	}
+	if t.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "submitted at")
+	}
+	return nil
+}
+
+// VoteCommitmentHash returns the hash a voter commits to when casting a hidden vote.
+// It is computed as sha256(proposal id || voter || choice || salt).
+func VoteCommitmentHash(proposalID ProposalID, voter string, choice Choice, salt []byte) []byte {
+	choiceBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(choiceBz, uint32(choice))
+
+	h := sha256.New()
+	h.Write(proposalID.Bytes())
+	h.Write([]byte(voter))
+	h.Write(choiceBz)
+	h.Write(salt)
+	return h.Sum(nil)
+}
+
+func (c VoteCommitment) NaturalKey() []byte {
+	return VoteNaturalKey(c.ProposalId, c.Voter)
+}
+
+// Matches returns true if the given choice and salt reveal this commitment.
+func (c VoteCommitment) Matches(choice Choice, salt []byte) bool {
+	return bytes.Equal(c.CommitHash, VoteCommitmentHash(c.ProposalId, c.Voter, choice, salt))
+}
+
+var _ orm.Validateable = VoteCommitment{}
+
+func (c VoteCommitment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(c.Voter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+
+	if c.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	if len(c.CommitHash) != sha256.Size {
+		return sdkerrors.Wrap(ErrInvalid, "commit hash")
+	}
+	t, err := types.TimestampFromProto(&c.SubmittedAt)
+	if err != nil {
+		return sdkerrors.Wrap(err, "submitted at")
+	}
+	if t.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "submitted at")
+	}
+	return nil
+}
+
+var _ orm.Validateable = VoteChange{}
+
+func (c VoteChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(c.Voter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+
+	if c.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	}
+	if _, ok := Choice_name[int32(c.OldChoice)]; !ok || c.OldChoice == Choice_CHOICE_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrInvalid, "old choice")
+	}
+	if _, ok := Choice_name[int32(c.NewChoice)]; !ok || c.NewChoice == Choice_CHOICE_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrInvalid, "new choice")
+	}
+	t, err := types.TimestampFromProto(&c.ChangedAt)
+	if err != nil {
+		return sdkerrors.Wrap(err, "changed at")
+	}
+	if t.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "changed at")
+	}
+	return nil
+}
+
+func (g GroupAccountSpend) NaturalKey() []byte {
+	addr, err := sdk.AccAddressFromBech32(g.GroupAccount)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+var _ orm.Validateable = GroupAccountSpend{}
+
+func (g GroupAccountSpend) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(g.GroupAccount)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+	t, err := types.TimestampFromProto(&g.WindowStart)
+	if err != nil {
+		return sdkerrors.Wrap(err, "window start")
+	}
+	if t.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "window start")
+	}
+	if err := g.Spent.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "spent")
+	}
+	return nil
+}
+
+// MaxMetadataLength defines the max length of the metadata bytes field
+// for various entities within the group module
+// TODO: This could be used as params once x/params is upgraded to use protobuf
+const MaxMetadataLength = 255
+
+// MaxVoteNonceLength defines the max length of the client supplied vote nonce.
+const MaxVoteNonceLength = 64
+
+var _ orm.Validateable = GroupInfo{}
+
+func (g GroupInfo) ValidateBasic() error {
+	if g.GroupId.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "group")
+	}
+
+	_, err := sdk.AccAddressFromBech32(g.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	if _, err := math.ParseNonNegativeDecimal(g.TotalWeight); err != nil {
+		return sdkerrors.Wrap(err, "total weight")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+	if err := RoleMultipliers(g.RoleMultipliers).ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "role multipliers")
+	}
+	if g.WeightDecay != nil {
+		if err := g.WeightDecay.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "weight decay")
+		}
+	}
+	if err := validateMetadataURI(g.MetadataUri, g.MetadataHash); err != nil {
+		return err
+	}
+	if g.Seats != 0 {
+		if err := validateCouncil(g.RoleMultipliers, g.WeightDecay); err != nil {
+			return err
+		}
+	}
+	if g.ProposalSchema != nil {
+		if err := g.ProposalSchema.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "proposal schema")
+		}
+	}
+	return validateNormalizedWeights(g.NormalizedWeights, g.NormalizedTotal, g.RoleMultipliers, g.WeightDecay, g.Seats)
+}
+
+// ValidateProposalMetadata checks that the proposal metadata satisfies the
+// proposal schema of the group, if any.
+func (g GroupInfo) ValidateProposalMetadata(metadata []byte) error {
+	if g.ProposalSchema == nil {
+		return nil
+	}
+	return g.ProposalSchema.ValidateMetadata(metadata)
+}
+
+// EqualIgnoringVersion returns true if both groups are equal in all fields but
+// the version.
+func (g GroupInfo) EqualIgnoringVersion(other GroupInfo) bool {
+	if len(g.RoleMultipliers) != len(other.RoleMultipliers) {
+		return false
+	}
+	for i := range g.RoleMultipliers {
+		if g.RoleMultipliers[i] != other.RoleMultipliers[i] {
+			return false
+		}
+	}
+	return g.GroupId == other.GroupId &&
+		g.Admin == other.Admin &&
+		bytes.Equal(g.Metadata, other.Metadata) &&
+		g.TotalWeight == other.TotalWeight &&
+		g.RevokeOnRemoval == other.RevokeOnRemoval &&
+		g.WeightDecay.equal(other.WeightDecay) &&
+		g.MetadataUri == other.MetadataUri &&
+		bytes.Equal(g.MetadataHash, other.MetadataHash) &&
+		g.PruneVotes == other.PruneVotes &&
+		g.Seats == other.Seats &&
+		g.ProposalSchema.equal(other.ProposalSchema) &&
+		g.NormalizedWeights == other.NormalizedWeights &&
+		g.NormalizedTotal == other.NormalizedTotal
+}
+
+func (d WeightDecay) ValidateBasic() error {
+	if _, err := math.ParsePositiveDecimal(d.Rate); err != nil {
+		return sdkerrors.Wrap(err, "rate")
+	}
+	period, err := types.DurationFromProto(&d.Period)
+	if err != nil {
+		return sdkerrors.Wrap(err, "period")
+	}
+	if period <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "period must be positive")
+	}
+	return nil
+}
+
+func (p ProposalSchema) ValidateBasic() error {
+	keys := make(map[string]struct{}, len(p.RequiredKeys))
+	for _, key := range p.RequiredKeys {
+		if key == "" {
+			return sdkerrors.Wrap(ErrEmpty, "required key")
+		}
+		if _, exists := keys[key]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "required key %q", key)
+		}
+		keys[key] = struct{}{}
+	}
+	return nil
 }
 
-func (g GroupAccountInfo) GetDecisionPolicy() DecisionPolicy {
-	decisionPolicy, ok := g.DecisionPolicy.GetCachedValue().(DecisionPolicy)
-	if !ok {
+// ValidateMetadata checks that the proposal metadata is a JSON object containing
+// all required keys. Any metadata is valid when no keys are required.
+func (p ProposalSchema) ValidateMetadata(metadata []byte) error {
+	if len(p.RequiredKeys) == 0 {
 		return nil
 	}
-	return decisionPolicy
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(metadata, &fields); err != nil || fields == nil {
+		return sdkerrors.Wrap(ErrInvalid, "proposal metadata must be a JSON object")
+	}
+	for _, key := range p.RequiredKeys {
+		if _, ok := fields[key]; !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal metadata misses required key %q", key)
+		}
+	}
+	return nil
 }
 
-func (g GroupAccountInfo) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(g.Admin)
-	if err != nil {
-		return sdkerrors.Wrap(err, "admin")
+func (p *ProposalSchema) equal(other *ProposalSchema) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if len(p.RequiredKeys) != len(other.RequiredKeys) {
+		return false
+	}
+	for i := range p.RequiredKeys {
+		if p.RequiredKeys[i] != other.RequiredKeys[i] {
+			return false
+		}
 	}
+	return true
+}
 
-	_, err = sdk.AccAddressFromBech32(g.GroupAccount)
-	if err != nil {
-		return sdkerrors.Wrap(err, "group account")
+func (d *WeightDecay) equal(other *WeightDecay) bool {
+	if d == nil || other == nil {
+		return d == other
 	}
+	return d.Rate == other.Rate && d.Period.Equal(other.Period)
+}
 
-	if g.GroupId == 0 {
-		return sdkerrors.Wrap(ErrEmpty, "group")
+// validateMetadataURI checks that an optional off-chain metadata URI is an
+// absolute URI and that its hash is a SHA-256 hash. The hash must be set if and
+// only if the URI is set.
+func validateMetadataURI(uri string, hash []byte) error {
+	if len(uri) == 0 {
+		if len(hash) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "metadata hash without metadata uri")
+		}
+		return nil
 	}
-	if g.Version == 0 {
-		return sdkerrors.Wrap(ErrEmpty, "version")
+	u, err := url.Parse(uri)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalid, "metadata uri")
 	}
-	policy := g.GetDecisionPolicy()
-
-	if policy == nil {
-		return sdkerrors.Wrap(ErrEmpty, "policy")
+	if u.Scheme == "" || (u.Host == "" && u.Opaque == "" && u.Path == "") {
+		return sdkerrors.Wrap(ErrInvalid, "metadata uri must be absolute")
 	}
-	if err := policy.ValidateBasic(); err != nil {
-		return sdkerrors.Wrap(err, "policy")
+	if len(hash) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "metadata hash")
+	}
+	if len(hash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalid, "metadata hash must be %d bytes", sha256.Size)
 	}
 	return nil
 }
 
-// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
-func (g GroupAccountInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	var decisionPolicy DecisionPolicy
-	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
+// validateCouncil checks that a council group has neither role multipliers nor
+// weight decay, as both would make its seats unequal.
+func validateCouncil(roleMultipliers []RoleMultiplier, weightDecay *WeightDecay) error {
+	if len(roleMultipliers) != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "council groups can't have role multipliers")
+	}
+	if weightDecay != nil {
+		return sdkerrors.Wrap(ErrInvalid, "council groups can't have weight decay")
+	}
+	return nil
 }
 
-func (v Vote) NaturalKey() []byte {
-	result := make([]byte, 8, 8+len(v.Voter))
-	copy(result[0:8], v.ProposalId.Bytes())
-	result = append(result, v.Voter...)
-	return result
+// validateNormalizedWeights checks the settings of a group with normalized weights.
+// Its total must be positive, and it can't have role multipliers, weight decay or
+// council seats, which would all change weights independently of the normalization.
+func validateNormalizedWeights(normalized bool, total string, roleMultipliers []RoleMultiplier, weightDecay *WeightDecay, seats uint64) error {
+	if !normalized {
+		if total != "" {
+			return sdkerrors.Wrap(ErrInvalid, "normalized total requires normalized weights")
+		}
+		return nil
+	}
+	if _, err := math.ParsePositiveDecimal(total); err != nil {
+		return sdkerrors.Wrap(err, "normalized total")
+	}
+	if len(roleMultipliers) != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "groups with normalized weights can't have role multipliers")
+	}
+	if weightDecay != nil {
+		return sdkerrors.Wrap(ErrInvalid, "groups with normalized weights can't have weight decay")
+	}
+	if seats != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "groups with normalized weights can't have seats")
+	}
+	return nil
 }
 
-var _ orm.Validateable = Vote{}
-
-func (v Vote) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(v.Voter)
-	if err != nil {
-		return sdkerrors.Wrap(err, "voter")
+// ParseWeight parses the weight of a group member, which must be a positive decimal.
+// The returned error tells why a weight is invalid: ErrEmpty for an empty weight,
+// ErrMalformedWeight if it isn't a finite decimal, ErrNegativeWeight or ErrZeroWeight.
+func ParseWeight(s string) (math.Decimal, error) {
+	if s == "" {
+		return math.Decimal{}, ErrEmpty
 	}
-
-	if v.ProposalId == 0 {
-		return sdkerrors.Wrap(ErrEmpty, "proposal")
+	weight, err := math.NewDecimalFromString(s)
+	if err != nil {
+		return math.Decimal{}, sdkerrors.Wrapf(ErrMalformedWeight, "expected a decimal, got %s", s)
 	}
-	if v.Choice == Choice_CHOICE_UNSPECIFIED {
-		return sdkerrors.Wrap(ErrEmpty, "choice")
+	if weight.IsZero() {
+		return math.Decimal{}, sdkerrors.Wrapf(ErrZeroWeight, "got %s", s)
 	}
-	if _, ok := Choice_name[int32(v.Choice)]; !ok {
-		return sdkerrors.Wrap(ErrInvalid, "choice")
+	if weight.Negative {
+		return math.Decimal{}, sdkerrors.Wrapf(ErrNegativeWeight, "got %s", s)
 	}
-	t, err := types.TimestampFromProto(&v.SubmittedAt)
+	return weight, nil
+}
+
+// assertSeatWeight checks that the member has the weight of a council seat.
+func assertSeatWeight(m Member) error {
+	weight, err := math.ParseNonNegativeDecimal(m.Weight)
 	if err != nil {
-		return sdkerrors.Wrap(err, "submitted at")
+		return sdkerrors.Wrapf(err, "member %s", m.Address)
 	}
-	if t.IsZero() {
-		return sdkerrors.Wrap(ErrEmpty, "submitted at")
+	if weight.Cmp(apd.New(1, 0)) != 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "member %s: council seats have a weight of 1", m.Address)
 	}
 	return nil
 }
 
-// MaxMetadataLength defines the max length of the metadata bytes field
-// for various entities within the group module
-// TODO: This could be used as params once x/params is upgraded to use protobuf
-const MaxMetadataLength = 255
+// AssertSeatWeight checks that a member of a council group has the weight of a
+// seat. It is a no-op for groups that aren't councils.
+func (g GroupInfo) AssertSeatWeight(m Member) error {
+	if g.Seats == 0 {
+		return nil
+	}
+	return assertSeatWeight(m)
+}
 
-var _ orm.Validateable = GroupInfo{}
+// MemberEffectiveWeight pairs the nominal weight of a group member with its
+// effective weight, see GroupInfo.EffectiveWeight.
+type MemberEffectiveWeight struct {
+	Member          Member
+	EffectiveWeight string
+}
 
-func (g GroupInfo) ValidateBasic() error {
-	if g.GroupId.Empty() {
-		return sdkerrors.Wrap(ErrEmpty, "group")
+// EffectiveWeight returns the weight of the member multiplied by the group's
+// multiplier for the member's role. This is the weight that counts toward the
+// group total weight and the tally of votes.
+func (g GroupInfo) EffectiveWeight(m Member) (*apd.Decimal, error) {
+	weight, err := math.ParseNonNegativeDecimal(m.Weight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "weight")
 	}
-
-	_, err := sdk.AccAddressFromBech32(g.Admin)
+	multiplier, err := RoleMultipliers(g.RoleMultipliers).Multiplier(m.Role)
 	if err != nil {
-		return sdkerrors.Wrap(err, "admin")
+		return nil, err
+	}
+	if err := math.Mul(weight, weight, multiplier); err != nil {
+		return nil, err
 	}
+	return weight, nil
+}
 
-	if _, err := math.ParseNonNegativeDecimal(g.TotalWeight); err != nil {
-		return sdkerrors.Wrap(err, "total weight")
+// Expired returns whether the membership of m has expired at the given block time.
+// Members without expiry time never expire.
+func (m Member) Expired(blockTime time.Time) (bool, error) {
+	if m.ExpiresAt == nil {
+		return false, nil
 	}
-	if g.Version == 0 {
-		return sdkerrors.Wrap(ErrEmpty, "version")
+	expiresAt, err := types.TimestampFromProto(m.ExpiresAt)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "expires at")
 	}
-	return nil
+	return !blockTime.Before(expiresAt), nil
 }
 
 var _ orm.Validateable = GroupMember{}
@@ -305,13 +1978,98 @@ func (t *Tally) Sub(vote Vote, weight string) error {
 	if err := t.operation(vote, weight, math.SafeSub); err != nil {
 		return err
 	}
+	// Tallies from before veto voters were counted may have none to subtract.
+	if vote.Choice == Choice_CHOICE_VETO && t.VetoVoters > 0 {
+		t.VetoVoters--
+	}
 	return nil
 }
 
+// Add adds the weight of the vote to the count of its choice. Counts are stored
+// as canonical decimal strings, see math.CanonicalDecimalString.
+// Present votes don't change any count. Veto votes are also counted in VetoVoters.
 func (t *Tally) Add(vote Vote, weight string) error {
 	if err := t.operation(vote, weight, math.Add); err != nil {
 		return err
 	}
+	if vote.Choice == Choice_CHOICE_VETO {
+		t.VetoVoters++
+	}
+	return nil
+}
+
+// AddBatch adds the given votes with their respective weights to the tally.
+// The result is the same as calling Add for each vote in order, but the counts
+// are only parsed and formatted once. On error the tally is left unchanged.
+func (t *Tally) AddBatch(votes []Vote, weights []string) error {
+	if len(votes) != len(weights) {
+		return sdkerrors.Wrapf(ErrInvalid, "got %d votes but %d weights", len(votes), len(weights))
+	}
+
+	yesCount, err := t.GetYesCount()
+	if err != nil {
+		return sdkerrors.Wrap(err, "yes count")
+	}
+	noCount, err := t.GetNoCount()
+	if err != nil {
+		return sdkerrors.Wrap(err, "no count")
+	}
+	abstainCount, err := t.GetAbstainCount()
+	if err != nil {
+		return sdkerrors.Wrap(err, "abstain count")
+	}
+	vetoCount, err := t.GetVetoCount()
+	if err != nil {
+		return sdkerrors.Wrap(err, "veto count")
+	}
+	optionCounts := make([]*apd.Decimal, len(t.OptionCounts))
+	for i := range t.OptionCounts {
+		if optionCounts[i], err = t.GetOptionCount(uint32(i)); err != nil {
+			return err
+		}
+	}
+
+	var vetoVoters uint64
+	for i, vote := range votes {
+		weightDec, err := math.ParsePositiveDecimal(weights[i])
+		if err != nil {
+			return err
+		}
+		var count *apd.Decimal
+		switch vote.Choice {
+		case Choice_CHOICE_PRESENT:
+			// Present votes are recorded for attendance only and not counted.
+			continue
+		case Choice_CHOICE_YES:
+			count = yesCount
+		case Choice_CHOICE_NO:
+			count = noCount
+		case Choice_CHOICE_ABSTAIN:
+			count = abstainCount
+		case Choice_CHOICE_VETO:
+			count = vetoCount
+			vetoVoters++
+		case Choice_CHOICE_OPTION:
+			if int(vote.Option) >= len(optionCounts) {
+				return sdkerrors.Wrapf(ErrInvalid, "option %d out of range", vote.Option)
+			}
+			count = optionCounts[vote.Option]
+		default:
+			return sdkerrors.Wrapf(ErrInvalid, "unknown choice %s", vote.Choice.String())
+		}
+		if err := math.Add(count, count, weightDec); err != nil {
+			return sdkerrors.Wrapf(err, "%s count", vote.Choice.String())
+		}
+	}
+
+	t.YesCount = math.CanonicalDecimalString(yesCount)
+	t.NoCount = math.CanonicalDecimalString(noCount)
+	t.AbstainCount = math.CanonicalDecimalString(abstainCount)
+	t.VetoCount = math.CanonicalDecimalString(vetoCount)
+	t.VetoVoters += vetoVoters
+	for i, c := range optionCounts {
+		t.OptionCounts[i] = math.CanonicalDecimalString(c)
+	}
 	return nil
 }
 
@@ -346,31 +2104,138 @@ func (t *Tally) operation(vote Vote, weight string, op operation) error {
 		if err != nil {
 			return sdkerrors.Wrap(err, "yes count")
 		}
-		t.YesCount = math.DecimalString(yesCount)
+		t.YesCount = math.CanonicalDecimalString(yesCount)
 	case Choice_CHOICE_NO:
 		err := op(noCount, noCount, weightDec)
 		if err != nil {
 			return sdkerrors.Wrap(err, "no count")
 		}
-		t.NoCount = math.DecimalString(noCount)
+		t.NoCount = math.CanonicalDecimalString(noCount)
 	case Choice_CHOICE_ABSTAIN:
 		err := op(abstainCount, abstainCount, weightDec)
 		if err != nil {
 			return sdkerrors.Wrap(err, "abstain count")
 		}
-		t.AbstainCount = math.DecimalString(abstainCount)
+		t.AbstainCount = math.CanonicalDecimalString(abstainCount)
 	case Choice_CHOICE_VETO:
 		err := op(vetoCount, vetoCount, weightDec)
 		if err != nil {
 			return sdkerrors.Wrap(err, "veto count")
 		}
-		t.VetoCount = math.DecimalString(vetoCount)
+		t.VetoCount = math.CanonicalDecimalString(vetoCount)
+	case Choice_CHOICE_OPTION:
+		optionCount, err := t.GetOptionCount(vote.Option)
+		if err != nil {
+			return err
+		}
+		if err := op(optionCount, optionCount, weightDec); err != nil {
+			return sdkerrors.Wrapf(err, "option %d count", vote.Option)
+		}
+		t.OptionCounts[vote.Option] = math.CanonicalDecimalString(optionCount)
+	case Choice_CHOICE_PRESENT:
+		// Present votes are recorded for attendance only and not counted.
 	default:
 		return sdkerrors.Wrapf(ErrInvalid, "unknown choice %s", vote.Choice.String())
 	}
 	return nil
 }
 
+// AddRoleVote adds the weight of the vote of a member with the given role to
+// the tally of the role. The vote must have been added to the tally itself separately.
+func (t *Tally) AddRoleVote(role string, vote Vote, weight string) error {
+	for i := range t.RoleTallies {
+		if t.RoleTallies[i].Role == role {
+			return t.RoleTallies[i].Tally.Add(vote, weight)
+		}
+	}
+	roleTally := RoleTally{Role: role, Tally: Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"}}
+	if len(t.OptionCounts) != 0 {
+		roleTally.Tally.OptionCounts = make([]string, len(t.OptionCounts))
+		for i := range roleTally.Tally.OptionCounts {
+			roleTally.Tally.OptionCounts[i] = "0"
+		}
+	}
+	if err := roleTally.Tally.Add(vote, weight); err != nil {
+		return err
+	}
+	t.RoleTallies = append(t.RoleTallies, roleTally)
+	return nil
+}
+
+// SubRoleVote subtracts the weight of the vote of a member with the given role
+// from the tally of the role. The vote must have been subtracted from the tally
+// itself separately.
+func (t *Tally) SubRoleVote(role string, vote Vote, weight string) error {
+	for i := range t.RoleTallies {
+		if t.RoleTallies[i].Role == role {
+			return t.RoleTallies[i].Tally.Sub(vote, weight)
+		}
+	}
+	return sdkerrors.Wrapf(ErrInvalid, "no tally for role %s", role)
+}
+
+// Clone returns a deep copy of the tally that can be modified without
+// affecting t.
+func (t Tally) Clone() Tally {
+	c := t
+	if t.OptionCounts != nil {
+		c.OptionCounts = append([]string(nil), t.OptionCounts...)
+	}
+	if t.RoleTallies != nil {
+		c.RoleTallies = make([]RoleTally, len(t.RoleTallies))
+		for i, r := range t.RoleTallies {
+			c.RoleTallies[i] = RoleTally{Role: r.Role, Tally: r.Tally.Clone()}
+		}
+	}
+	return c
+}
+
+// Rounded returns a copy of the tally with each count, including option counts and
+// role tallies, rounded to the given decimal places with the rounding mode of the
+// math package, e.g. "2.555" to "2.56" with 2 places. It is meant for displaying
+// tallies in query responses only, stored tallies keep their full precision.
+// Counts that aren't valid decimals are kept as is, places below zero are treated
+// as zero.
+func (t Tally) Rounded(places int) Tally {
+	if places < 0 {
+		places = 0
+	}
+	round := func(count string) string {
+		x, err := math.ParseNonNegativeDecimal(count)
+		if err != nil {
+			return count
+		}
+		res := apd.New(0, 0)
+		if err := math.Round(res, x, uint32(places)); err != nil {
+			return count
+		}
+		return math.DecimalString(res)
+	}
+	c := t.Clone()
+	c.YesCount = round(c.YesCount)
+	c.NoCount = round(c.NoCount)
+	c.AbstainCount = round(c.AbstainCount)
+	c.VetoCount = round(c.VetoCount)
+	for i := range c.OptionCounts {
+		c.OptionCounts[i] = round(c.OptionCounts[i])
+	}
+	for i := range c.RoleTallies {
+		c.RoleTallies[i].Tally = c.RoleTallies[i].Tally.Rounded(places)
+	}
+	return c
+}
+
+// RoleTally returns the tally of the votes of the members with the given role.
+// The tally is empty when none of them voted.
+func (t Tally) RoleTally(role string) Tally {
+	for _, r := range t.RoleTallies {
+		if r.Role == role {
+			return r.Tally
+		}
+	}
+	return Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"}
+}
+
 // TotalCounts is the sum of all weights.
 func (t Tally) TotalCounts() (*apd.Decimal, error) {
 	yesCount, err := t.GetYesCount()
@@ -407,9 +2272,73 @@ func (t Tally) TotalCounts() (*apd.Decimal, error) {
 	if err != nil {
 		return nil, err
 	}
+	for i := range t.OptionCounts {
+		optionCount, err := t.GetOptionCount(uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		if err := math.Add(totalCounts, totalCounts, optionCount); err != nil {
+			return nil, err
+		}
+	}
 	return totalCounts, nil
 }
 
+// Equal compares the counts of both tallies by their decimal values, so that
+// e.g. "2.5" and "2.50" are equal. Role tallies are compared by role. It returns
+// an error if any count of either tally is not a valid decimal.
+func (t Tally) Equal(other Tally) (bool, error) {
+	counts := [][2]string{
+		{t.YesCount, other.YesCount},
+		{t.NoCount, other.NoCount},
+		{t.AbstainCount, other.AbstainCount},
+		{t.VetoCount, other.VetoCount},
+	}
+	if len(t.OptionCounts) != len(other.OptionCounts) {
+		return false, nil
+	}
+	for i := range t.OptionCounts {
+		counts = append(counts, [2]string{t.OptionCounts[i], other.OptionCounts[i]})
+	}
+	for _, c := range counts {
+		x, err := math.ParseNonNegativeDecimal(c[0])
+		if err != nil {
+			return false, err
+		}
+		y, err := math.ParseNonNegativeDecimal(c[1])
+		if err != nil {
+			return false, err
+		}
+		if x.Cmp(y) != 0 {
+			return false, nil
+		}
+	}
+
+	if len(t.RoleTallies) != len(other.RoleTallies) {
+		return false, nil
+	}
+	for _, r := range t.RoleTallies {
+		var found bool
+		for _, o := range other.RoleTallies {
+			if o.Role != r.Role {
+				continue
+			}
+			found = true
+			equal, err := r.Tally.Equal(o.Tally)
+			if err != nil {
+				return false, sdkerrors.Wrapf(err, "role %s", r.Role)
+			}
+			if !equal {
+				return false, nil
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (t Tally) GetYesCount() (*apd.Decimal, error) {
 	yesCount, err := math.ParseNonNegativeDecimal(t.YesCount)
 	if err != nil {
@@ -442,6 +2371,112 @@ func (t Tally) GetVetoCount() (*apd.Decimal, error) {
 	return vetoCount, nil
 }
 
+// GetOptionCount returns the weighted sum of votes for the option with the given index.
+func (t Tally) GetOptionCount(option uint32) (*apd.Decimal, error) {
+	if int(option) >= len(t.OptionCounts) {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "option %d out of range", option)
+	}
+	optionCount, err := math.ParseNonNegativeDecimal(t.OptionCounts[option])
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "option %d count", option)
+	}
+	return optionCount, nil
+}
+
+// LeadingOption returns the index of the option with the highest weighted sum of votes.
+// ok is false when no votes were cast for any option or when the highest count is tied.
+func (t Tally) LeadingOption() (option uint32, ok bool, err error) {
+	leader, first, second, err := t.leadingOptions()
+	if err != nil {
+		return 0, false, err
+	}
+	return leader, first.Cmp(second) > 0, nil
+}
+
+// leadingOptions returns the index and count of the option with the highest count
+// together with the second highest count. Missing counts are zero.
+func (t Tally) leadingOptions() (leader uint32, first, second *apd.Decimal, err error) {
+	first, second = apd.New(0, 0), apd.New(0, 0)
+	for i := range t.OptionCounts {
+		count, err := t.GetOptionCount(uint32(i))
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		switch {
+		case count.Cmp(first) > 0:
+			leader, first, second = uint32(i), count, first
+		case count.Cmp(second) > 0:
+			second = count
+		}
+	}
+	return leader, first, second, nil
+}
+
+// ValidateAgainstTotal checks that the sum of all counts doesn't exceed
+// the given total power, which would indicate that some weight was counted twice.
+func (t Tally) ValidateAgainstTotal(totalPower string) error {
+	total, err := math.ParseNonNegativeDecimal(totalPower)
+	if err != nil {
+		return sdkerrors.Wrap(err, "total power")
+	}
+	totalCounts, err := t.TotalCounts()
+	if err != nil {
+		return err
+	}
+	if totalCounts.Cmp(total) > 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "total counts %s exceed total power %s", math.DecimalString(totalCounts), totalPower)
+	}
+	return nil
+}
+
+// Percentages returns each count as a share of the given total power, i.e. a
+// value between 0 and 1. Together with the share of the power that didn't vote,
+// the four values sum up to 1.
+func (t Tally) Percentages(totalPower string) (yes, no, abstain, veto math.Decimal, err error) {
+	total, err := math.ParsePositiveDecimal(totalPower)
+	if err != nil {
+		return yes, no, abstain, veto, sdkerrors.Wrap(err, "total power")
+	}
+	counts := []func() (*apd.Decimal, error){t.GetYesCount, t.GetNoCount, t.GetAbstainCount, t.GetVetoCount}
+	res := make([]math.Decimal, len(counts))
+	for i, count := range counts {
+		c, err := count()
+		if err != nil {
+			return yes, no, abstain, veto, err
+		}
+		if err := math.Quo(&res[i].Decimal, c, total); err != nil {
+			return yes, no, abstain, veto, err
+		}
+	}
+	return res[0], res[1], res[2], res[3], nil
+}
+
+// Decisiveness returns the share of the given total power that cast a decisive
+// vote, i.e. the sum of the yes, no, veto and option counts divided by the total
+// power. Abstain votes are not decisive.
+func (t Tally) Decisiveness(totalPower string) (math.Decimal, error) {
+	total, err := math.ParsePositiveDecimal(totalPower)
+	if err != nil {
+		return math.Decimal{}, sdkerrors.Wrap(err, "total power")
+	}
+	decisive, err := t.TotalCounts()
+	if err != nil {
+		return math.Decimal{}, err
+	}
+	abstainCount, err := t.GetAbstainCount()
+	if err != nil {
+		return math.Decimal{}, sdkerrors.Wrap(err, "abstain count")
+	}
+	if err := math.SafeSub(decisive, decisive, abstainCount); err != nil {
+		return math.Decimal{}, err
+	}
+	var res math.Decimal
+	if err := math.Quo(&res.Decimal, decisive, total); err != nil {
+		return math.Decimal{}, err
+	}
+	return res, nil
+}
+
 func (t Tally) ValidateBasic() error {
 	if _, err := t.GetYesCount(); err != nil {
 		return sdkerrors.Wrap(err, "yes count")
@@ -452,8 +2487,62 @@ func (t Tally) ValidateBasic() error {
 	if _, err := t.GetAbstainCount(); err != nil {
 		return sdkerrors.Wrap(err, "abstain count")
 	}
-	if _, err := t.GetVetoCount(); err != nil {
+	vetoCount, err := t.GetVetoCount()
+	if err != nil {
 		return sdkerrors.Wrap(err, "veto count")
 	}
+	if t.VetoVoters != 0 && vetoCount.IsZero() {
+		return sdkerrors.Wrap(ErrInvalid, "veto voters without veto count")
+	}
+	for i := range t.OptionCounts {
+		if _, err := t.GetOptionCount(uint32(i)); err != nil {
+			return err
+		}
+	}
+	roles := make(map[string]struct{}, len(t.RoleTallies))
+	for _, r := range t.RoleTallies {
+		if r.Role == "" {
+			return sdkerrors.Wrap(ErrEmpty, "role tally role")
+		}
+		if _, exists := roles[r.Role]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "role tally %s", r.Role)
+		}
+		roles[r.Role] = struct{}{}
+		if len(r.Tally.RoleTallies) != 0 {
+			return sdkerrors.Wrapf(ErrInvalid, "role tally %s: nested role tallies", r.Role)
+		}
+		if err := r.Tally.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "role tally %s", r.Role)
+		}
+	}
+	return nil
+}
+
+// Canonicalize validates the tally like ValidateBasic and rewrites all counts,
+// including option counts and role tallies, to canonical decimal strings, see
+// math.CanonicalDecimalString. The tally is left unchanged if it is invalid.
+func (t *Tally) Canonicalize() error {
+	if err := t.ValidateBasic(); err != nil {
+		return err
+	}
+	t.canonicalizeCounts()
 	return nil
 }
+
+// canonicalizeCounts rewrites the counts of a valid tally to canonical decimal strings.
+func (t *Tally) canonicalizeCounts() {
+	canonical := func(count string) string {
+		dec, _ := math.ParseNonNegativeDecimal(count)
+		return math.CanonicalDecimalString(dec)
+	}
+	t.YesCount = canonical(t.YesCount)
+	t.NoCount = canonical(t.NoCount)
+	t.AbstainCount = canonical(t.AbstainCount)
+	t.VetoCount = canonical(t.VetoCount)
+	for i := range t.OptionCounts {
+		t.OptionCounts[i] = canonical(t.OptionCounts[i])
+	}
+	for i := range t.RoleTallies {
+		t.RoleTallies[i].Tally.canonicalizeCounts()
+	}
+}
@@ -7,6 +7,8 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
@@ -27,6 +29,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TieBreak defines how a PluralityDecisionPolicy resolves a tie between options.
+type TieBreak int32
+
+const (
+	// TIE_BREAK_UNSPECIFIED defaults to TIE_BREAK_REJECT.
+	TieBreak_TIE_BREAK_UNSPECIFIED TieBreak = 0
+	// TIE_BREAK_REJECT rejects the proposal as indecisive.
+	TieBreak_TIE_BREAK_REJECT TieBreak = 1
+	// TIE_BREAK_LOWEST_INDEX selects the tied option with the lowest index.
+	TieBreak_TIE_BREAK_LOWEST_INDEX TieBreak = 2
+)
+
+var TieBreak_name = map[int32]string{
+	0: "TIE_BREAK_UNSPECIFIED",
+	1: "TIE_BREAK_REJECT",
+	2: "TIE_BREAK_LOWEST_INDEX",
+}
+
+var TieBreak_value = map[string]int32{
+	"TIE_BREAK_UNSPECIFIED":  0,
+	"TIE_BREAK_REJECT":       1,
+	"TIE_BREAK_LOWEST_INDEX": 2,
+}
+
+func (x TieBreak) String() string {
+	return proto.EnumName(TieBreak_name, int32(x))
+}
+
+func (TieBreak) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{0}
+}
+
 // Choice defines available types of choices for voting.
 type Choice int32
 
@@ -37,10 +71,17 @@ const (
 	Choice_CHOICE_NO Choice = 1
 	// CHOICE_YES defines a yes voting choice.
 	Choice_CHOICE_YES Choice = 2
-	// CHOICE_ABSTAIN defines an abstaining voting choice.
+	// CHOICE_ABSTAIN defines an abstaining voting choice. Abstain votes count
+	// toward the quorum but not toward the threshold.
 	Choice_CHOICE_ABSTAIN Choice = 3
 	// CHOICE_VETO defines a voting choice with veto.
 	Choice_CHOICE_VETO Choice = 4
+	// CHOICE_OPTION defines a vote for one of the options of a proposal with an option set.
+	Choice_CHOICE_OPTION Choice = 5
+	// CHOICE_PRESENT defines a vote of a member that is present but explicitly
+	// doesn't participate. Unlike CHOICE_ABSTAIN it counts toward neither the
+	// threshold nor the quorum; the vote is only recorded for attendance.
+	Choice_CHOICE_PRESENT Choice = 6
 )
 
 var Choice_name = map[int32]string{
@@ -49,6 +90,8 @@ var Choice_name = map[int32]string{
 	2: "CHOICE_YES",
 	3: "CHOICE_ABSTAIN",
 	4: "CHOICE_VETO",
+	5: "CHOICE_OPTION",
+	6: "CHOICE_PRESENT",
 }
 
 var Choice_value = map[string]int32{
@@ -57,6 +100,8 @@ var Choice_value = map[string]int32{
 	"CHOICE_YES":         2,
 	"CHOICE_ABSTAIN":     3,
 	"CHOICE_VETO":        4,
+	"CHOICE_OPTION":      5,
+	"CHOICE_PRESENT":     6,
 }
 
 func (x Choice) String() string {
@@ -64,7 +109,40 @@ func (x Choice) String() string {
 }
 
 func (Choice) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{0}
+	return fileDescriptor_9b7906b115009838, []int{1}
+}
+
+// OverrideAction defines the actions a group account admin can take on a
+// proposal bypassing the vote.
+type OverrideAction int32
+
+const (
+	// OVERRIDE_ACTION_UNSPECIFIED defines a no-op override action.
+	OverrideAction_OVERRIDE_ACTION_UNSPECIFIED OverrideAction = 0
+	// OVERRIDE_ACTION_EXECUTE accepts the proposal and executes its messages.
+	OverrideAction_OVERRIDE_ACTION_EXECUTE OverrideAction = 1
+	// OVERRIDE_ACTION_CANCEL aborts the proposal.
+	OverrideAction_OVERRIDE_ACTION_CANCEL OverrideAction = 2
+)
+
+var OverrideAction_name = map[int32]string{
+	0: "OVERRIDE_ACTION_UNSPECIFIED",
+	1: "OVERRIDE_ACTION_EXECUTE",
+	2: "OVERRIDE_ACTION_CANCEL",
+}
+
+var OverrideAction_value = map[string]int32{
+	"OVERRIDE_ACTION_UNSPECIFIED": 0,
+	"OVERRIDE_ACTION_EXECUTE":     1,
+	"OVERRIDE_ACTION_CANCEL":      2,
+}
+
+func (x OverrideAction) String() string {
+	return proto.EnumName(OverrideAction_name, int32(x))
+}
+
+func (OverrideAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{2}
 }
 
 // Status defines proposal statuses.
@@ -100,7 +178,7 @@ func (x Proposal_Status) String() string {
 }
 
 func (Proposal_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{5, 0}
+	return fileDescriptor_9b7906b115009838, []int{14, 0}
 }
 
 // Result defines types of proposal results.
@@ -136,7 +214,7 @@ func (x Proposal_Result) String() string {
 }
 
 func (Proposal_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{5, 1}
+	return fileDescriptor_9b7906b115009838, []int{14, 1}
 }
 
 // ExecutorResult defines types of proposal executor results.
@@ -151,6 +229,8 @@ const (
 	ProposalExecutorResultSuccess Proposal_ExecutorResult = 2
 	// The executor returned an error and proposed action didn't update state.
 	ProposalExecutorResultFailure Proposal_ExecutorResult = 3
+	// The executor kept failing after all automatic retries and the proposal can't be executed anymore.
+	ProposalExecutorResultPermanentFailure Proposal_ExecutorResult = 4
 )
 
 var Proposal_ExecutorResult_name = map[int32]string{
@@ -158,13 +238,15 @@ var Proposal_ExecutorResult_name = map[int32]string{
 	1: "EXECUTOR_RESULT_NOT_RUN",
 	2: "EXECUTOR_RESULT_SUCCESS",
 	3: "EXECUTOR_RESULT_FAILURE",
+	4: "EXECUTOR_RESULT_PERMANENT_FAILURE",
 }
 
 var Proposal_ExecutorResult_value = map[string]int32{
-	"EXECUTOR_RESULT_UNSPECIFIED": 0,
-	"EXECUTOR_RESULT_NOT_RUN":     1,
-	"EXECUTOR_RESULT_SUCCESS":     2,
-	"EXECUTOR_RESULT_FAILURE":     3,
+	"EXECUTOR_RESULT_UNSPECIFIED":       0,
+	"EXECUTOR_RESULT_NOT_RUN":           1,
+	"EXECUTOR_RESULT_SUCCESS":           2,
+	"EXECUTOR_RESULT_FAILURE":           3,
+	"EXECUTOR_RESULT_PERMANENT_FAILURE": 4,
 }
 
 func (x Proposal_ExecutorResult) String() string {
@@ -172,7 +254,7 @@ func (x Proposal_ExecutorResult) String() string {
 }
 
 func (Proposal_ExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{5, 2}
+	return fileDescriptor_9b7906b115009838, []int{14, 2}
 }
 
 // Member represents a group member with an account address,
@@ -184,6 +266,13 @@ type Member struct {
 	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
 	// metadata is any arbitrary metadata to attached to the member.
 	Metadata []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// role is the optional role of the member within the group. The member's
+	// weight is multiplied by the group's multiplier for this role.
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// expires_at is the optional time at which the membership expires. An expired
+	// member's weight no longer counts toward the group total weight, the member
+	// can't vote and is removed from the group at the end of the block.
+	ExpiresAt *types.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
@@ -240,27 +329,43 @@ func (m *Member) GetMetadata() []byte {
 	return nil
 }
 
-// ThresholdDecisionPolicy implements the DecisionPolicy interface
-type ThresholdDecisionPolicy struct {
-	// threshold is the minimum weighted sum of yes votes that must be met or exceeded for a proposal to succeed.
-	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// timeout is the duration from submission of a proposal to the end of voting period
-	// Within this times votes and exec messages can be submitted.
-	Timeout types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout"`
+func (m *Member) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
 }
 
-func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy{} }
-func (m *ThresholdDecisionPolicy) String() string { return proto.CompactTextString(m) }
-func (*ThresholdDecisionPolicy) ProtoMessage()    {}
-func (*ThresholdDecisionPolicy) Descriptor() ([]byte, []int) {
+func (m *Member) GetExpiresAt() *types.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+// WeightDecay defines how the weights of group members that don't participate
+// decay. A member participates by submitting a proposal or voting.
+type WeightDecay struct {
+	// rate is the positive decimal subtracted from the weight of a member for each
+	// period without participation. Weights don't decay below zero, members whose
+	// weight decays to zero are removed from the group.
+	Rate string `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// period is the duration without participation after which the rate is applied.
+	Period types.Duration `protobuf:"bytes,2,opt,name=period,proto3" json:"period"`
+}
+
+func (m *WeightDecay) Reset()         { *m = WeightDecay{} }
+func (m *WeightDecay) String() string { return proto.CompactTextString(m) }
+func (*WeightDecay) ProtoMessage()    {}
+func (*WeightDecay) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b7906b115009838, []int{1}
 }
-func (m *ThresholdDecisionPolicy) XXX_Unmarshal(b []byte) error {
+func (m *WeightDecay) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ThresholdDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WeightDecay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ThresholdDecisionPolicy.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WeightDecay.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -270,61 +375,51 @@ func (m *ThresholdDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ThresholdDecisionPolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ThresholdDecisionPolicy.Merge(m, src)
+func (m *WeightDecay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightDecay.Merge(m, src)
 }
-func (m *ThresholdDecisionPolicy) XXX_Size() int {
+func (m *WeightDecay) XXX_Size() int {
 	return m.Size()
 }
-func (m *ThresholdDecisionPolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_ThresholdDecisionPolicy.DiscardUnknown(m)
+func (m *WeightDecay) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightDecay.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ThresholdDecisionPolicy proto.InternalMessageInfo
+var xxx_messageInfo_WeightDecay proto.InternalMessageInfo
 
-func (m *ThresholdDecisionPolicy) GetThreshold() string {
+func (m *WeightDecay) GetRate() string {
 	if m != nil {
-		return m.Threshold
+		return m.Rate
 	}
 	return ""
 }
 
-func (m *ThresholdDecisionPolicy) GetTimeout() types.Duration {
+func (m *WeightDecay) GetPeriod() types.Duration {
 	if m != nil {
-		return m.Timeout
+		return m.Period
 	}
 	return types.Duration{}
 }
 
-// GroupInfo represents the high-level on-chain information for a group.
-type GroupInfo struct {
-	// group_id is the unique ID of the group.
-	GroupId ID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=ID" json:"group_id,omitempty"`
-	// admin is the account address of the group's admin.
-	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
-	// metadata is any arbitrary metadata to attached to the group.
-	Metadata []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// version is used to track changes to a group's membership structure that
-	// would break existing proposals. Whenever any members weight is changed,
-	// or any member is added or removed this version is incremented and will
-	// cause proposals based on older versions of this group to fail
-	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
-	// total_weight is the sum of the group members' weights.
-	TotalWeight string `protobuf:"bytes,5,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+// ProposalSchema defines the metadata every proposal of a group must include.
+// Proposal metadata of a group with a proposal schema must be a JSON object.
+type ProposalSchema struct {
+	// required_keys are the keys the JSON object of the proposal metadata must contain.
+	RequiredKeys []string `protobuf:"bytes,1,rep,name=required_keys,json=requiredKeys,proto3" json:"required_keys,omitempty"`
 }
 
-func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
-func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
-func (*GroupInfo) ProtoMessage()    {}
-func (*GroupInfo) Descriptor() ([]byte, []int) {
+func (m *ProposalSchema) Reset()         { *m = ProposalSchema{} }
+func (m *ProposalSchema) String() string { return proto.CompactTextString(m) }
+func (*ProposalSchema) ProtoMessage()    {}
+func (*ProposalSchema) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b7906b115009838, []int{2}
 }
-func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
+func (m *ProposalSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposalSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposalSchema.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -334,73 +429,131 @@ func (m *GroupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GroupInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupInfo.Merge(m, src)
+func (m *ProposalSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalSchema.Merge(m, src)
 }
-func (m *GroupInfo) XXX_Size() int {
+func (m *ProposalSchema) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupInfo.DiscardUnknown(m)
+func (m *ProposalSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalSchema.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GroupInfo proto.InternalMessageInfo
+var xxx_messageInfo_ProposalSchema proto.InternalMessageInfo
 
-func (m *GroupInfo) GetGroupId() ID {
+func (m *ProposalSchema) GetRequiredKeys() []string {
 	if m != nil {
-		return m.GroupId
+		return m.RequiredKeys
 	}
-	return 0
+	return nil
 }
 
-func (m *GroupInfo) GetAdmin() string {
-	if m != nil {
-		return m.Admin
-	}
-	return ""
+// RoleMultiplier defines the multiplier applied to the weight of group members
+// with the given role.
+type RoleMultiplier struct {
+	// role is the name of the role.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// multiplier is the positive decimal the weight of members with this role is multiplied by.
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
 }
 
-func (m *GroupInfo) GetMetadata() []byte {
-	if m != nil {
-		return m.Metadata
+func (m *RoleMultiplier) Reset()         { *m = RoleMultiplier{} }
+func (m *RoleMultiplier) String() string { return proto.CompactTextString(m) }
+func (*RoleMultiplier) ProtoMessage()    {}
+func (*RoleMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{3}
+}
+func (m *RoleMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *RoleMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleMultiplier.Merge(m, src)
+}
+func (m *RoleMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleMultiplier.DiscardUnknown(m)
 }
 
-func (m *GroupInfo) GetVersion() uint64 {
+var xxx_messageInfo_RoleMultiplier proto.InternalMessageInfo
+
+func (m *RoleMultiplier) GetRole() string {
 	if m != nil {
-		return m.Version
+		return m.Role
 	}
-	return 0
+	return ""
 }
 
-func (m *GroupInfo) GetTotalWeight() string {
+func (m *RoleMultiplier) GetMultiplier() string {
 	if m != nil {
-		return m.TotalWeight
+		return m.Multiplier
 	}
 	return ""
 }
 
-// GroupMember represents the relationship between a group and a member.
-type GroupMember struct {
-	// group_id is the unique ID of the group.
-	GroupId ID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=ID" json:"group_id,omitempty"`
-	// member is the member data.
-	Member *Member `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+// ThresholdDecisionPolicy implements the DecisionPolicy interface
+type ThresholdDecisionPolicy struct {
+	// threshold is the minimum weighted sum of yes votes that must be met or exceeded for a proposal to succeed.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout"`
+	// quorum is the optional minimum weighted sum of all votes (yes, no, abstain and veto) that must be
+	// met or exceeded for a proposal to succeed. Abstain and veto votes count toward the quorum,
+	// so abstaining members can help a proposal reach the quorum without supporting it.
+	Quorum string `protobuf:"bytes,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// veto_threshold is the optional weighted sum of veto votes at which a proposal is rejected.
+	// A reached veto threshold takes precedence over a reached threshold.
+	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// veto_damping_factor is the optional non-negative factor by which veto votes reduce the yes count.
+	// When set, the effective yes count compared to the threshold is yes - veto_damping_factor * veto,
+	// floored at zero.
+	VetoDampingFactor string `protobuf:"bytes,5,opt,name=veto_damping_factor,json=vetoDampingFactor,proto3" json:"veto_damping_factor,omitempty"`
+	// min_yes_to_no_ratio is the optional minimum ratio of the yes count to the no count,
+	// e.g. "2" for yes to outnumber no 2:1, for a proposal to succeed in addition to the
+	// threshold. It is always met when there are no no votes.
+	MinYesToNoRatio string `protobuf:"bytes,6,opt,name=min_yes_to_no_ratio,json=minYesToNoRatio,proto3" json:"min_yes_to_no_ratio,omitempty"`
+	// veto_fraction_of_cast is the optional fraction, as a decimal in (0, 1], of the votes actually cast
+	// that veto votes must not exceed for a proposal to succeed, i.e. veto / (yes + no + abstain + veto).
+	// Unlike veto_threshold, it is measured against the cast votes rather than the total power and is
+	// never exceeded when no votes were cast.
+	VetoFractionOfCast string `protobuf:"bytes,7,opt,name=veto_fraction_of_cast,json=vetoFractionOfCast,proto3" json:"veto_fraction_of_cast,omitempty"`
+	// min_decisive_participation is the optional minimum share, as a decimal in (0, 1], of the
+	// total power that must cast decisive votes, i.e. (yes + no + veto + options) / total power,
+	// for a proposal to succeed in addition to the threshold and quorum. Unlike the quorum,
+	// abstain votes don't count toward it.
+	MinDecisiveParticipation string `protobuf:"bytes,8,opt,name=min_decisive_participation,json=minDecisiveParticipation,proto3" json:"min_decisive_participation,omitempty"`
+	// min_veto_voters is the optional minimum number of distinct members that must vote veto for
+	// a reached veto_threshold to reject a proposal, so that a single member with a large weight
+	// can't veto alone. It requires a veto_threshold.
+	MinVetoVoters uint64 `protobuf:"varint,9,opt,name=min_veto_voters,json=minVetoVoters,proto3" json:"min_veto_voters,omitempty"`
 }
 
-func (m *GroupMember) Reset()         { *m = GroupMember{} }
-func (m *GroupMember) String() string { return proto.CompactTextString(m) }
-func (*GroupMember) ProtoMessage()    {}
-func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{3}
+func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy{} }
+func (m *ThresholdDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ThresholdDecisionPolicy) ProtoMessage()    {}
+func (*ThresholdDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{4}
 }
-func (m *GroupMember) XXX_Unmarshal(b []byte) error {
+func (m *ThresholdDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ThresholdDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupMember.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ThresholdDecisionPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -410,61 +563,106 @@ func (m *GroupMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GroupMember) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupMember.Merge(m, src)
+func (m *ThresholdDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdDecisionPolicy.Merge(m, src)
 }
-func (m *GroupMember) XXX_Size() int {
+func (m *ThresholdDecisionPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupMember) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupMember.DiscardUnknown(m)
+func (m *ThresholdDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdDecisionPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GroupMember proto.InternalMessageInfo
+var xxx_messageInfo_ThresholdDecisionPolicy proto.InternalMessageInfo
 
-func (m *GroupMember) GetGroupId() ID {
+func (m *ThresholdDecisionPolicy) GetThreshold() string {
 	if m != nil {
-		return m.GroupId
+		return m.Threshold
 	}
-	return 0
+	return ""
 }
 
-func (m *GroupMember) GetMember() *Member {
+func (m *ThresholdDecisionPolicy) GetTimeout() types.Duration {
 	if m != nil {
-		return m.Member
+		return m.Timeout
 	}
-	return nil
+	return types.Duration{}
 }
 
-// GroupAccountInfo represents the high-level on-chain information for a group account.
-type GroupAccountInfo struct {
-	// group_account is the group account address.
-	GroupAccount string `protobuf:"bytes,1,opt,name=group_account,json=groupAccount,proto3" json:"group_account,omitempty"`
-	// group_id is the unique ID of the group.
-	GroupId ID `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3,casttype=ID" json:"group_id,omitempty"`
-	// admin is the account address of the group admin.
-	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
-	// metadata is any arbitrary metadata to attached to the group account.
-	Metadata []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// version is used to track changes to a group's GroupAccountInfo structure that
-	// would create a different result on a running proposal.
-	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
-	// decision_policy specifies the group account's decision policy.
-	DecisionPolicy *types1.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+func (m *ThresholdDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
 }
 
-func (m *GroupAccountInfo) Reset()         { *m = GroupAccountInfo{} }
-func (m *GroupAccountInfo) String() string { return proto.CompactTextString(m) }
-func (*GroupAccountInfo) ProtoMessage()    {}
-func (*GroupAccountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{4}
+func (m *ThresholdDecisionPolicy) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
 }
-func (m *GroupAccountInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *ThresholdDecisionPolicy) GetVetoDampingFactor() string {
+	if m != nil {
+		return m.VetoDampingFactor
+	}
+	return ""
+}
+
+func (m *ThresholdDecisionPolicy) GetMinYesToNoRatio() string {
+	if m != nil {
+		return m.MinYesToNoRatio
+	}
+	return ""
+}
+
+func (m *ThresholdDecisionPolicy) GetVetoFractionOfCast() string {
+	if m != nil {
+		return m.VetoFractionOfCast
+	}
+	return ""
+}
+
+func (m *ThresholdDecisionPolicy) GetMinDecisiveParticipation() string {
+	if m != nil {
+		return m.MinDecisiveParticipation
+	}
+	return ""
+}
+
+func (m *ThresholdDecisionPolicy) GetMinVetoVoters() uint64 {
+	if m != nil {
+		return m.MinVetoVoters
+	}
+	return 0
+}
+
+// PercentageDecisionPolicy implements the DecisionPolicy interface
+type PercentageDecisionPolicy struct {
+	// percentage is the minimum share of yes votes, as a decimal in (0, 1], that must be met or exceeded
+	// for a proposal to succeed. By default the share is measured against the group's total weight.
+	Percentage string `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout"`
+	// exclude_abstain_from_base measures the share of yes votes against the decisive votes only,
+	// i.e. yes / (yes + no + veto), so that abstain votes don't dilute the result.
+	ExcludeAbstainFromBase bool `protobuf:"varint,3,opt,name=exclude_abstain_from_base,json=excludeAbstainFromBase,proto3" json:"exclude_abstain_from_base,omitempty"`
+}
+
+func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPolicy{} }
+func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*PercentageDecisionPolicy) ProtoMessage()    {}
+func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{5}
+}
+func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupAccountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PercentageDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupAccountInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PercentageDecisionPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -474,66 +672,65 @@ func (m *GroupAccountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GroupAccountInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupAccountInfo.Merge(m, src)
+func (m *PercentageDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercentageDecisionPolicy.Merge(m, src)
 }
-func (m *GroupAccountInfo) XXX_Size() int {
+func (m *PercentageDecisionPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupAccountInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupAccountInfo.DiscardUnknown(m)
+func (m *PercentageDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercentageDecisionPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GroupAccountInfo proto.InternalMessageInfo
+var xxx_messageInfo_PercentageDecisionPolicy proto.InternalMessageInfo
 
-// Proposal defines a group proposal. Any member of a group can submit a proposal
-// for a group account to decide upon.
-// A proposal consists of a set of `sdk.Msg`s that will be executed if the proposal
-// passes as well as some optional metadata associated with the proposal.
-type Proposal struct {
-	// group_account is the group account address.
-	GroupAccount string `protobuf:"bytes,1,opt,name=group_account,json=groupAccount,proto3" json:"group_account,omitempty"`
-	// metadata is any arbitrary metadata to attached to the proposal.
-	Metadata []byte `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// proposers are the account addresses of the proposers.
-	Proposers []string `protobuf:"bytes,3,rep,name=proposers,proto3" json:"proposers,omitempty"`
-	// submitted_at is a timestamp specifying when a proposal was submitted.
-	SubmittedAt types.Timestamp `protobuf:"bytes,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
-	// group_version tracks the version of the group that this proposal corresponds to.
-	// When group membership is changed, existing proposals for prior group versions will become invalid.
-	GroupVersion uint64 `protobuf:"varint,5,opt,name=group_version,json=groupVersion,proto3" json:"group_version,omitempty"`
-	// group_account_version tracks the version of the group account that this proposal corresponds to.
-	// When a decision policy is changed, an existing proposals for prior policy versions will become invalid.
-	GroupAccountVersion uint64 `protobuf:"varint,6,opt,name=group_account_version,json=groupAccountVersion,proto3" json:"group_account_version,omitempty"`
-	// Status represents the high level position in the life cycle of the proposal. Initial value is Submitted.
-	Status Proposal_Status `protobuf:"varint,7,opt,name=status,proto3,enum=regen.group.v1alpha1.Proposal_Status" json:"status,omitempty"`
-	// result is the final result based on the votes and election rule. Initial value is Undefined.
-	// The result is persisted so that clients can always rely on this state and not have to replicate the logic.
-	Result Proposal_Result `protobuf:"varint,8,opt,name=result,proto3,enum=regen.group.v1alpha1.Proposal_Result" json:"result,omitempty"`
-	// vote_state contains the sums of all weighted votes for this proposal.
-	VoteState Tally `protobuf:"bytes,9,opt,name=vote_state,json=voteState,proto3" json:"vote_state"`
-	// timeout is the timestamp of the block where the proposal execution times out. Header times of the votes and execution messages
-	// must be before this end time to be included in the election. After the timeout timestamp the proposal can not be
-	// executed anymore and should be considered pending delete.
-	Timeout types.Timestamp `protobuf:"bytes,10,opt,name=timeout,proto3" json:"timeout"`
-	// executor_result is the final result based on the votes and election rule. Initial value is NotRun.
-	ExecutorResult Proposal_ExecutorResult `protobuf:"varint,11,opt,name=executor_result,json=executorResult,proto3,enum=regen.group.v1alpha1.Proposal_ExecutorResult" json:"executor_result,omitempty"`
-	// msgs is a list of Msgs that will be executed if the proposal passes.
-	Msgs []*types1.Any `protobuf:"bytes,12,rep,name=msgs,proto3" json:"msgs,omitempty"`
+func (m *PercentageDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
 }
 
-func (m *Proposal) Reset()         { *m = Proposal{} }
-func (m *Proposal) String() string { return proto.CompactTextString(m) }
-func (*Proposal) ProtoMessage()    {}
-func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{5}
+func (m *PercentageDecisionPolicy) GetTimeout() types.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return types.Duration{}
 }
-func (m *Proposal) XXX_Unmarshal(b []byte) error {
+
+func (m *PercentageDecisionPolicy) GetExcludeAbstainFromBase() bool {
+	if m != nil {
+		return m.ExcludeAbstainFromBase
+	}
+	return false
+}
+
+// PluralityDecisionPolicy implements the DecisionPolicy interface for proposals with an option set.
+// The option with the highest weighted sum of votes is selected.
+type PluralityDecisionPolicy struct {
+	// quorum is the optional minimum weighted sum of all votes, including abstain votes, that must be
+	// met or exceeded for an option to be selected.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout"`
+	// tie_break defines how a tie for the highest weighted sum of votes is resolved.
+	// By default a tie rejects the proposal.
+	TieBreak TieBreak `protobuf:"varint,3,opt,name=tie_break,json=tieBreak,proto3,enum=regen.group.v1alpha1.TieBreak" json:"tie_break,omitempty"`
+}
+
+func (m *PluralityDecisionPolicy) Reset()         { *m = PluralityDecisionPolicy{} }
+func (m *PluralityDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*PluralityDecisionPolicy) ProtoMessage()    {}
+func (*PluralityDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{6}
+}
+func (m *PluralityDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PluralityDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PluralityDecisionPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -543,42 +740,65 @@ func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Proposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Proposal.Merge(m, src)
+func (m *PluralityDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluralityDecisionPolicy.Merge(m, src)
 }
-func (m *Proposal) XXX_Size() int {
+func (m *PluralityDecisionPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *Proposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_Proposal.DiscardUnknown(m)
+func (m *PluralityDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluralityDecisionPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Proposal proto.InternalMessageInfo
+var xxx_messageInfo_PluralityDecisionPolicy proto.InternalMessageInfo
 
-// Tally represents the sum of weighted votes.
-type Tally struct {
-	// yes_count is the weighted sum of yes votes.
-	YesCount string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
-	// no_count is the weighted sum of no votes.
-	NoCount string `protobuf:"bytes,2,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
-	// abstain_count is the weighted sum of abstainers
-	AbstainCount string `protobuf:"bytes,3,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
-	// veto_count is the weighted sum of vetoes.
-	VetoCount string `protobuf:"bytes,4,opt,name=veto_count,json=vetoCount,proto3" json:"veto_count,omitempty"`
+func (m *PluralityDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
 }
 
-func (m *Tally) Reset()         { *m = Tally{} }
-func (m *Tally) String() string { return proto.CompactTextString(m) }
-func (*Tally) ProtoMessage()    {}
-func (*Tally) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{6}
+func (m *PluralityDecisionPolicy) GetTimeout() types.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return types.Duration{}
 }
-func (m *Tally) XXX_Unmarshal(b []byte) error {
+
+func (m *PluralityDecisionPolicy) GetTieBreak() TieBreak {
+	if m != nil {
+		return m.TieBreak
+	}
+	return TieBreak_TIE_BREAK_UNSPECIFIED
+}
+
+// BicameralDecisionPolicy implements the DecisionPolicy interface
+// for proposals that must be approved by two disjoint sets of members.
+// The sets are defined by member roles and each set must independently reach
+// its own threshold of yes votes.
+type BicameralDecisionPolicy struct {
+	// first is the first chamber.
+	First Chamber `protobuf:"bytes,1,opt,name=first,proto3" json:"first"`
+	// second is the second chamber.
+	Second Chamber `protobuf:"bytes,2,opt,name=second,proto3" json:"second"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout types.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout"`
+}
+
+func (m *BicameralDecisionPolicy) Reset()         { *m = BicameralDecisionPolicy{} }
+func (m *BicameralDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*BicameralDecisionPolicy) ProtoMessage()    {}
+func (*BicameralDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{7}
+}
+func (m *BicameralDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Tally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BicameralDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Tally.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BicameralDecisionPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -588,44 +808,68 @@ func (m *Tally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Tally) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Tally.Merge(m, src)
+func (m *BicameralDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BicameralDecisionPolicy.Merge(m, src)
 }
-func (m *Tally) XXX_Size() int {
+func (m *BicameralDecisionPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *Tally) XXX_DiscardUnknown() {
-	xxx_messageInfo_Tally.DiscardUnknown(m)
+func (m *BicameralDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_BicameralDecisionPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Tally proto.InternalMessageInfo
+var xxx_messageInfo_BicameralDecisionPolicy proto.InternalMessageInfo
 
-// Vote represents a vote for a proposal.
-type Vote struct {
-	// proposal is the unique ID of the proposal.
-	ProposalId ProposalID `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3,casttype=ProposalID" json:"proposal_id,omitempty"`
-	// voter is the account address of the voter.
-	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	// choice is the voter's choice on the proposal.
-	Choice Choice `protobuf:"varint,3,opt,name=choice,proto3,enum=regen.group.v1alpha1.Choice" json:"choice,omitempty"`
-	// metadata is any arbitrary metadata to attached to the vote.
-	Metadata []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// submitted_at is the timestamp when the vote was submitted.
-	SubmittedAt types.Timestamp `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
+func (m *BicameralDecisionPolicy) GetFirst() Chamber {
+	if m != nil {
+		return m.First
+	}
+	return Chamber{}
 }
 
-func (m *Vote) Reset()         { *m = Vote{} }
-func (m *Vote) String() string { return proto.CompactTextString(m) }
-func (*Vote) ProtoMessage()    {}
-func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{7}
+func (m *BicameralDecisionPolicy) GetSecond() Chamber {
+	if m != nil {
+		return m.Second
+	}
+	return Chamber{}
 }
-func (m *Vote) XXX_Unmarshal(b []byte) error {
+
+func (m *BicameralDecisionPolicy) GetTimeout() types.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return types.Duration{}
+}
+
+// ConvictionDecisionPolicy implements the DecisionPolicy interface for conviction voting.
+// The weight of a yes vote grows the longer it is held: its multiplier starts at 1 when
+// the vote is cast and grows by 1 per growth period, capped at max_multiplier. The
+// conviction of a proposal, the sum of the weighted yes votes, is recomputed from the
+// votes' submission times whenever the proposal is tallied.
+type ConvictionDecisionPolicy struct {
+	// threshold is the minimum conviction that must be met or exceeded for a proposal to succeed.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout"`
+	// growth_period is the duration in which the multiplier of a yes vote grows by 1.
+	GrowthPeriod types.Duration `protobuf:"bytes,3,opt,name=growth_period,json=growthPeriod,proto3" json:"growth_period"`
+	// max_multiplier is the decimal, not less than 1, at which the multiplier of a yes vote is capped.
+	MaxMultiplier string `protobuf:"bytes,4,opt,name=max_multiplier,json=maxMultiplier,proto3" json:"max_multiplier,omitempty"`
+}
+
+func (m *ConvictionDecisionPolicy) Reset()         { *m = ConvictionDecisionPolicy{} }
+func (m *ConvictionDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ConvictionDecisionPolicy) ProtoMessage()    {}
+func (*ConvictionDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{8}
+}
+func (m *ConvictionDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConvictionDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConvictionDecisionPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -635,887 +879,7364 @@ func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Vote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vote.Merge(m, src)
+func (m *ConvictionDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvictionDecisionPolicy.Merge(m, src)
 }
-func (m *Vote) XXX_Size() int {
+func (m *ConvictionDecisionPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *Vote) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vote.DiscardUnknown(m)
+func (m *ConvictionDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvictionDecisionPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Vote proto.InternalMessageInfo
-
-func (m *Vote) GetProposalId() ProposalID {
-	if m != nil {
-		return m.ProposalId
-	}
-	return 0
-}
+var xxx_messageInfo_ConvictionDecisionPolicy proto.InternalMessageInfo
 
-func (m *Vote) GetVoter() string {
+func (m *ConvictionDecisionPolicy) GetThreshold() string {
 	if m != nil {
-		return m.Voter
+		return m.Threshold
 	}
 	return ""
 }
 
-func (m *Vote) GetChoice() Choice {
+func (m *ConvictionDecisionPolicy) GetTimeout() types.Duration {
 	if m != nil {
-		return m.Choice
+		return m.Timeout
 	}
-	return Choice_CHOICE_UNSPECIFIED
+	return types.Duration{}
 }
 
-func (m *Vote) GetMetadata() []byte {
+func (m *ConvictionDecisionPolicy) GetGrowthPeriod() types.Duration {
 	if m != nil {
-		return m.Metadata
+		return m.GrowthPeriod
 	}
-	return nil
+	return types.Duration{}
 }
 
-func (m *Vote) GetSubmittedAt() types.Timestamp {
+func (m *ConvictionDecisionPolicy) GetMaxMultiplier() string {
 	if m != nil {
-		return m.SubmittedAt
+		return m.MaxMultiplier
 	}
-	return types.Timestamp{}
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("regen.group.v1alpha1.Choice", Choice_name, Choice_value)
-	proto.RegisterEnum("regen.group.v1alpha1.Proposal_Status", Proposal_Status_name, Proposal_Status_value)
-	proto.RegisterEnum("regen.group.v1alpha1.Proposal_Result", Proposal_Result_name, Proposal_Result_value)
-	proto.RegisterEnum("regen.group.v1alpha1.Proposal_ExecutorResult", Proposal_ExecutorResult_name, Proposal_ExecutorResult_value)
-	proto.RegisterType((*Member)(nil), "regen.group.v1alpha1.Member")
-	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "regen.group.v1alpha1.ThresholdDecisionPolicy")
-	proto.RegisterType((*GroupInfo)(nil), "regen.group.v1alpha1.GroupInfo")
-	proto.RegisterType((*GroupMember)(nil), "regen.group.v1alpha1.GroupMember")
-	proto.RegisterType((*GroupAccountInfo)(nil), "regen.group.v1alpha1.GroupAccountInfo")
-	proto.RegisterType((*Proposal)(nil), "regen.group.v1alpha1.Proposal")
-	proto.RegisterType((*Tally)(nil), "regen.group.v1alpha1.Tally")
-	proto.RegisterType((*Vote)(nil), "regen.group.v1alpha1.Vote")
+// Chamber is a set of group members of a BicameralDecisionPolicy.
+type Chamber struct {
+	// role is the member role of the members of the chamber.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// threshold is the minimum weighted sum of yes votes of the chamber's members
+	// that must be met or exceeded for the chamber to approve a proposal.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func init() { proto.RegisterFile("regen/group/v1alpha1/types.proto", fileDescriptor_9b7906b115009838) }
-
-var fileDescriptor_9b7906b115009838 = []byte{
-	// 1249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x8e, 0x63, 0x3f, 0x27, 0x8e, 0x35, 0xa4, 0xad, 0xe3, 0xa4, 0xce, 0xd6, 0x15,
-	0x52, 0x04, 0x8a, 0xad, 0x04, 0x38, 0x10, 0xa9, 0x08, 0x7b, 0xbd, 0x29, 0x46, 0xa9, 0x1d, 0x76,
-	0xed, 0x00, 0xbd, 0x58, 0xeb, 0xdd, 0x89, 0xb3, 0xb0, 0xde, 0xb1, 0x76, 0x67, 0xd3, 0x9a, 0x2b,
-	0x12, 0x2a, 0x3e, 0x71, 0xe5, 0x60, 0xa9, 0x12, 0x7f, 0x81, 0x1f, 0x51, 0x71, 0xaa, 0xb8, 0x80,
-	0x38, 0x54, 0xa8, 0xe1, 0xc0, 0x6f, 0xe8, 0x09, 0xed, 0xec, 0x6c, 0x92, 0x4d, 0x9c, 0x34, 0xe2,
-	0x96, 0x37, 0xef, 0xfb, 0xde, 0x7b, 0xdf, 0x37, 0x93, 0x7d, 0x06, 0xd1, 0xc1, 0x03, 0x6c, 0x57,
-	0x07, 0x0e, 0xf1, 0x46, 0xd5, 0xe3, 0x2d, 0xcd, 0x1a, 0x1d, 0x69, 0x5b, 0x55, 0x3a, 0x1e, 0x61,
-	0xb7, 0x32, 0x72, 0x08, 0x25, 0x68, 0x99, 0x21, 0x2a, 0x0c, 0x51, 0x09, 0x11, 0xc5, 0xe5, 0x01,
-	0x19, 0x10, 0x06, 0xa8, 0xfa, 0x7f, 0x05, 0xd8, 0x62, 0x69, 0x40, 0xc8, 0xc0, 0xc2, 0x55, 0x16,
-	0xf5, 0xbd, 0xc3, 0xaa, 0xe1, 0x39, 0x1a, 0x35, 0x89, 0xcd, 0xf3, 0xeb, 0x17, 0xf3, 0xd4, 0x1c,
-	0x62, 0x97, 0x6a, 0xc3, 0x11, 0x07, 0xac, 0xe8, 0xc4, 0x1d, 0x12, 0xb7, 0x17, 0x54, 0x0e, 0x82,
-	0x30, 0x75, 0x91, 0xab, 0xd9, 0xe3, 0x20, 0x55, 0x3e, 0x80, 0xd4, 0x23, 0x3c, 0xec, 0x63, 0x07,
-	0x15, 0x60, 0x5e, 0x33, 0x0c, 0x07, 0xbb, 0x6e, 0x41, 0x10, 0x85, 0x8d, 0x8c, 0x12, 0x86, 0xe8,
-	0x36, 0xa4, 0x9e, 0x60, 0x73, 0x70, 0x44, 0x0b, 0x71, 0x96, 0xe0, 0x11, 0x2a, 0x42, 0x7a, 0x88,
-	0xa9, 0x66, 0x68, 0x54, 0x2b, 0x24, 0x44, 0x61, 0x63, 0x41, 0x39, 0x8d, 0xcb, 0x3f, 0x08, 0x70,
-	0xa7, 0x73, 0xe4, 0x60, 0xf7, 0x88, 0x58, 0x46, 0x03, 0xeb, 0xa6, 0x6b, 0x12, 0x7b, 0x9f, 0x58,
-	0xa6, 0x3e, 0x46, 0x6b, 0x90, 0xa1, 0x61, 0x8a, 0xf7, 0x3a, 0x3b, 0x40, 0x1f, 0xc3, 0xbc, 0x2f,
-	0x8d, 0x78, 0x41, 0xbb, 0xec, 0xf6, 0x4a, 0x25, 0x18, 0xbf, 0x12, 0x8e, 0x5f, 0x69, 0x70, 0x6b,
-	0xea, 0xc9, 0x17, 0xaf, 0xd6, 0x63, 0x4a, 0x88, 0xdf, 0x41, 0xbf, 0xff, 0xba, 0x99, 0x8b, 0x36,
-	0x2b, 0x4f, 0x05, 0xc8, 0x3c, 0xf4, 0x2f, 0xa0, 0x69, 0x1f, 0x12, 0x74, 0x0f, 0xd2, 0xec, 0x36,
-	0x7a, 0x66, 0xd0, 0x39, 0x59, 0x4f, 0xbd, 0x79, 0xb5, 0x1e, 0x6f, 0x36, 0x94, 0x79, 0x76, 0xde,
-	0x34, 0xd0, 0x32, 0xcc, 0x69, 0xc6, 0xd0, 0xb4, 0xb9, 0xd8, 0x20, 0xb8, 0x4e, 0xab, 0xef, 0xdc,
-	0x31, 0x76, 0xfc, 0x9e, 0x85, 0xa4, 0x5f, 0x53, 0x09, 0x43, 0x74, 0x0f, 0x16, 0x28, 0xa1, 0x9a,
-	0xd5, 0xe3, 0xfe, 0xcd, 0xb1, 0x92, 0x59, 0x76, 0xf6, 0x25, 0x3b, 0x2a, 0x1f, 0x42, 0x96, 0x8d,
-	0xc7, 0x6f, 0xe1, 0x06, 0x03, 0x7e, 0x08, 0xa9, 0x21, 0x03, 0x73, 0x7f, 0xd6, 0x2a, 0xb3, 0x9e,
-	0x59, 0x25, 0x28, 0xa8, 0x70, 0x6c, 0xf9, 0xfb, 0x38, 0xe4, 0x59, 0xa3, 0x9a, 0xae, 0x13, 0xcf,
-	0xa6, 0xcc, 0x8e, 0xfb, 0xb0, 0x18, 0x74, 0xd3, 0x82, 0x43, 0x7e, 0x1b, 0x0b, 0x83, 0x73, 0xc0,
-	0xc8, 0x48, 0xf1, 0xb7, 0x78, 0x96, 0xb8, 0xca, 0xb3, 0xe4, 0xd5, 0x9e, 0xcd, 0x45, 0x3d, 0xfb,
-	0x02, 0x96, 0x0c, 0x7e, 0x85, 0xbd, 0x11, 0xbb, 0xc3, 0x42, 0x8a, 0xe9, 0x5c, 0xbe, 0xf4, 0x0e,
-	0x6a, 0xf6, 0xb8, 0x8e, 0x7e, 0xbb, 0x74, 0xe7, 0x4a, 0xce, 0x88, 0xc4, 0x3b, 0xe9, 0x67, 0xcf,
-	0xd7, 0x63, 0xff, 0x3e, 0x5f, 0x17, 0xca, 0x7f, 0x00, 0xa4, 0xf7, 0x1d, 0x32, 0x22, 0xae, 0x66,
-	0xdd, 0x4c, 0xfd, 0x79, 0x11, 0xf1, 0x0b, 0x22, 0xd6, 0x20, 0x33, 0x62, 0xc5, 0xb0, 0xe3, 0x16,
-	0x12, 0x62, 0xc2, 0x7f, 0xc8, 0xa7, 0x07, 0x48, 0x82, 0x05, 0xd7, 0xeb, 0x0f, 0x4d, 0x4a, 0xb1,
-	0xd1, 0xd3, 0x28, 0xb3, 0x20, 0xbb, 0x5d, 0xbc, 0xa4, 0xa2, 0x13, 0xfe, 0x23, 0xf3, 0xe7, 0x9c,
-	0x3d, 0x65, 0xd5, 0xe8, 0xd9, 0x8c, 0x51, 0xb7, 0x82, 0x19, 0x0f, 0xb8, 0x65, 0xdb, 0x70, 0x2b,
-	0x22, 0xe4, 0x14, 0x9c, 0x62, 0xe0, 0x77, 0xce, 0x0b, 0x0a, 0x39, 0x0f, 0x20, 0xe5, 0x52, 0x8d,
-	0x7a, 0x6e, 0x61, 0x5e, 0x14, 0x36, 0x72, 0xdb, 0xef, 0xce, 0x7e, 0x45, 0xa1, 0x59, 0x15, 0x95,
-	0x81, 0x15, 0x4e, 0xf2, 0xe9, 0x0e, 0x76, 0x3d, 0x8b, 0x16, 0xd2, 0x37, 0xa2, 0x2b, 0x0c, 0xac,
-	0x70, 0x12, 0xfa, 0x14, 0xe0, 0x98, 0x50, 0xdc, 0xf3, 0xab, 0xe1, 0x42, 0x86, 0x39, 0xb3, 0x3a,
-	0xbb, 0x44, 0x47, 0xb3, 0xac, 0x31, 0xb7, 0x26, 0xe3, 0x93, 0xfc, 0x49, 0x30, 0xda, 0x39, 0xfb,
-	0x4c, 0xc0, 0x0d, 0x8d, 0x0d, 0x09, 0xe8, 0x00, 0x96, 0xf0, 0x53, 0xac, 0x7b, 0x94, 0x38, 0x3d,
-	0xae, 0x22, 0xcb, 0x54, 0x6c, 0xbe, 0x45, 0x85, 0xcc, 0x59, 0x5c, 0x4d, 0x0e, 0x47, 0x62, 0xb4,
-	0x01, 0xc9, 0xa1, 0x3b, 0x70, 0x0b, 0x0b, 0x62, 0xe2, 0xaa, 0xf7, 0xaa, 0x30, 0x44, 0xf9, 0xa5,
-	0x00, 0xa9, 0xc0, 0x51, 0xb4, 0x05, 0x48, 0xed, 0xd4, 0x3a, 0x5d, 0xb5, 0xd7, 0x6d, 0xa9, 0xfb,
-	0xb2, 0xd4, 0xdc, 0x6d, 0xca, 0x8d, 0x7c, 0xac, 0xb8, 0x32, 0x99, 0x8a, 0xb7, 0xc2, 0xce, 0x01,
-	0xb6, 0x69, 0x1f, 0x6b, 0x96, 0x69, 0xa0, 0x2d, 0xc8, 0x73, 0x8a, 0xda, 0xad, 0x3f, 0x6a, 0x76,
-	0x3a, 0x72, 0x23, 0x2f, 0x14, 0x57, 0x27, 0x53, 0xf1, 0x4e, 0x94, 0xa0, 0x86, 0x2f, 0x09, 0xbd,
-	0x0f, 0x8b, 0x9c, 0x22, 0xed, 0xb5, 0x55, 0xb9, 0x91, 0x8f, 0x17, 0x0b, 0x93, 0xa9, 0xb8, 0x1c,
-	0xc5, 0x4b, 0x16, 0x71, 0xb1, 0x81, 0x36, 0x21, 0xc7, 0xc1, 0xb5, 0x7a, 0x5b, 0xf1, 0xab, 0x27,
-	0x66, 0x8d, 0x53, 0xeb, 0x13, 0x87, 0x62, 0xa3, 0x98, 0x7c, 0xf6, 0x4b, 0x29, 0x56, 0xfe, 0x4b,
-	0x80, 0x14, 0xf7, 0x61, 0x0b, 0x90, 0x22, 0xab, 0xdd, 0xbd, 0xce, 0x75, 0x92, 0x02, 0x6c, 0x28,
-	0xe9, 0xa3, 0x73, 0x94, 0xdd, 0x66, 0xab, 0xb6, 0xd7, 0x7c, 0xcc, 0x44, 0xdd, 0x9d, 0x4c, 0xc5,
-	0x95, 0x28, 0xa5, 0x6b, 0x1f, 0x9a, 0xb6, 0x66, 0x99, 0xdf, 0x61, 0x03, 0x55, 0x61, 0x89, 0xd3,
-	0x6a, 0x92, 0x24, 0xef, 0x77, 0x98, 0xb0, 0xe2, 0x64, 0x2a, 0xde, 0x8e, 0x72, 0x6a, 0xba, 0x8e,
-	0x47, 0x34, 0x42, 0x50, 0xe4, 0xcf, 0x65, 0x29, 0xd0, 0x36, 0x83, 0xa0, 0xe0, 0x6f, 0xb0, 0x7e,
-	0x26, 0xee, 0xe7, 0x38, 0xe4, 0xa2, 0x97, 0x8f, 0xea, 0xb0, 0x2a, 0x7f, 0x25, 0x4b, 0xdd, 0x4e,
-	0x5b, 0xe9, 0xcd, 0x54, 0x7b, 0x6f, 0x32, 0x15, 0xef, 0x86, 0x55, 0xa3, 0xe4, 0x50, 0xf5, 0x03,
-	0xb8, 0x73, 0xb1, 0x46, 0xab, 0xdd, 0xe9, 0x29, 0xdd, 0x56, 0x5e, 0x28, 0x8a, 0x93, 0xa9, 0xb8,
-	0x36, 0x9b, 0xdf, 0x22, 0x54, 0xf1, 0x6c, 0xf4, 0xc9, 0x65, 0xba, 0xda, 0x95, 0x24, 0x59, 0x55,
-	0xf3, 0xf1, 0xeb, 0xda, 0xab, 0x9e, 0xae, 0xfb, 0x8b, 0x7d, 0x06, 0x7f, 0xb7, 0xd6, 0xdc, 0xeb,
-	0x2a, 0x72, 0x3e, 0x71, 0x1d, 0x7f, 0x57, 0x33, 0x2d, 0xcf, 0xc1, 0x81, 0x37, 0x3b, 0x49, 0xff,
-	0xeb, 0x5a, 0xfe, 0x51, 0x80, 0x39, 0xf6, 0xaf, 0x8a, 0x56, 0x21, 0x33, 0xc6, 0x6e, 0xef, 0xfc,
-	0x27, 0x35, 0x3d, 0xc6, 0xae, 0xc4, 0x3e, 0xa7, 0x2b, 0x90, 0xb6, 0x09, 0xcf, 0x05, 0x0b, 0x76,
-	0xde, 0x26, 0x41, 0xea, 0x3e, 0x2c, 0x6a, 0x7d, 0x97, 0x6a, 0xa6, 0xcd, 0xf3, 0xc1, 0x32, 0x59,
-	0xe0, 0x87, 0x01, 0xe8, 0x2e, 0xc0, 0x31, 0xa6, 0x61, 0x85, 0x64, 0xf0, 0xe3, 0xc1, 0x3f, 0x61,
-	0x69, 0x3e, 0xcb, 0x3f, 0x02, 0x24, 0x0f, 0x08, 0xc5, 0xa8, 0x0a, 0xd9, 0x11, 0x57, 0x70, 0xb6,
-	0x50, 0x73, 0x6f, 0x5e, 0xad, 0x43, 0x28, 0xac, 0xd9, 0x50, 0x20, 0x84, 0x04, 0x8b, 0xcc, 0xff,
-	0xc4, 0x38, 0xe1, 0xf2, 0x67, 0x81, 0xbf, 0x71, 0xf5, 0x23, 0x62, 0xea, 0x98, 0x8d, 0x94, 0xbb,
-	0x6a, 0xe3, 0x4a, 0x0c, 0xa3, 0x70, 0xec, 0xb5, 0xeb, 0xef, 0xe2, 0x6e, 0x98, 0xfb, 0x1f, 0xbb,
-	0xe1, 0x3d, 0x03, 0x52, 0x41, 0x4b, 0x74, 0x1b, 0x90, 0xf4, 0x59, 0xbb, 0x29, 0xc9, 0xd1, 0x27,
-	0x88, 0x16, 0x21, 0xc3, 0xcf, 0x5b, 0xed, 0xbc, 0x80, 0x72, 0x00, 0x3c, 0xfc, 0x5a, 0x56, 0xf3,
-	0x71, 0x84, 0x20, 0xc7, 0xe3, 0x5a, 0x5d, 0xed, 0xd4, 0x9a, 0xad, 0x7c, 0x02, 0x2d, 0x41, 0x96,
-	0x9f, 0x1d, 0xc8, 0x9d, 0x76, 0x3e, 0x59, 0x7f, 0xf8, 0xe2, 0x75, 0x49, 0x78, 0xf9, 0xba, 0x24,
-	0xfc, 0xfd, 0xba, 0x24, 0xfc, 0x74, 0x52, 0x8a, 0xbd, 0x3c, 0x29, 0xc5, 0xfe, 0x3c, 0x29, 0xc5,
-	0x1e, 0x6f, 0x0e, 0x4c, 0x7a, 0xe4, 0xf5, 0x2b, 0x3a, 0x19, 0x56, 0x99, 0x21, 0x9b, 0x36, 0xa6,
-	0x4f, 0x88, 0xf3, 0x2d, 0x8f, 0x2c, 0x6c, 0x0c, 0xb0, 0x53, 0x7d, 0x1a, 0xfc, 0x44, 0xee, 0xa7,
-	0x98, 0xaa, 0x0f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x81, 0x3d, 0x85, 0xb2, 0x38, 0x0b, 0x00,
-	0x00,
+func (m *Chamber) Reset()         { *m = Chamber{} }
+func (m *Chamber) String() string { return proto.CompactTextString(m) }
+func (*Chamber) ProtoMessage()    {}
+func (*Chamber) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{9}
 }
-
-func (this *GroupAccountInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*GroupAccountInfo)
-	if !ok {
-		that2, ok := that.(GroupAccountInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *Chamber) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Chamber) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Chamber.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.GroupAccount != that1.GroupAccount {
-		return false
-	}
-	if this.GroupId != that1.GroupId {
-		return false
-	}
-	if this.Admin != that1.Admin {
-		return false
-	}
-	if !bytes.Equal(this.Metadata, that1.Metadata) {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	if !this.DecisionPolicy.Equal(that1.DecisionPolicy) {
-		return false
-	}
-	return true
 }
-func (m *Member) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Chamber) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Chamber.Merge(m, src)
+}
+func (m *Chamber) XXX_Size() int {
+	return m.Size()
+}
+func (m *Chamber) XXX_DiscardUnknown() {
+	xxx_messageInfo_Chamber.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Chamber proto.InternalMessageInfo
+
+func (m *Chamber) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Chamber) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
 }
 
-func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Weight) > 0 {
-		i -= len(m.Weight)
-		copy(dAtA[i:], m.Weight)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// GroupInfo represents the high-level on-chain information for a group.
+type GroupInfo struct {
+	// group_id is the unique ID of the group.
+	GroupId ID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=ID" json:"group_id,omitempty"`
+	// admin is the account address of the group's admin.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// metadata is any arbitrary metadata to attached to the group.
+	Metadata []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// version is used to track changes to a group's membership structure that
+	// would break existing proposals. Whenever any members weight is changed,
+	// or any member is added or removed this version is incremented and will
+	// cause proposals based on older versions of this group to fail
+	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// total_weight is the sum of the group members' effective weights.
+	TotalWeight string `protobuf:"bytes,5,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// role_multipliers maps member roles to the multiplier applied to the weight of
+	// members with that role. Members without a role have a multiplier of 1.
+	RoleMultipliers []RoleMultiplier `protobuf:"bytes,6,rep,name=role_multipliers,json=roleMultipliers,proto3" json:"role_multipliers"`
+	// revoke_on_removal, if set, subtracts the votes of removed members from the
+	// tallies of open proposals and deletes these votes. Membership updates that
+	// only remove members then keep the group version, so that open proposals can
+	// still be decided.
+	RevokeOnRemoval bool `protobuf:"varint,7,opt,name=revoke_on_removal,json=revokeOnRemoval,proto3" json:"revoke_on_removal,omitempty"`
+	// weight_decay, if set, makes the weights of inactive members decay over time.
+	WeightDecay *WeightDecay `protobuf:"bytes,8,opt,name=weight_decay,json=weightDecay,proto3" json:"weight_decay,omitempty"`
+	// metadata_uri is the optional URI of off-chain metadata of the group.
+	MetadataUri string `protobuf:"bytes,9,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+	// metadata_hash is the SHA-256 hash of the content of metadata_uri.
+	// It is required if metadata_uri is set.
+	MetadataHash []byte `protobuf:"bytes,10,opt,name=metadata_hash,json=metadataHash,proto3" json:"metadata_hash,omitempty"`
+	// prune_votes, if set, deletes the individual votes on the group's proposals
+	// when they are finalized. Only the tally and the voters of the proposals are kept.
+	PruneVotes bool `protobuf:"varint,11,opt,name=prune_votes,json=pruneVotes,proto3" json:"prune_votes,omitempty"`
+	// seats, if non-zero, makes the group a council with that many equal seats.
+	// Every member of a council holds one seat with a weight of 1 and the number
+	// of members can't exceed the number of seats.
+	Seats uint64 `protobuf:"varint,12,opt,name=seats,proto3" json:"seats,omitempty"`
+	// proposal_schema is the optional schema the metadata of the group's proposals must satisfy.
+	ProposalSchema *ProposalSchema `protobuf:"bytes,13,opt,name=proposal_schema,json=proposalSchema,proto3" json:"proposal_schema,omitempty"`
+	// normalized_weights, if set, scales the member weights whenever members are added, updated
+	// or removed, keeping their proportions, so that the total weight equals normalized_total.
+	NormalizedWeights bool `protobuf:"varint,14,opt,name=normalized_weights,json=normalizedWeights,proto3" json:"normalized_weights,omitempty"`
+	// normalized_total is the positive decimal the member weights of a group with normalized
+	// weights sum up to, e.g. 100 or 1.
+	NormalizedTotal string `protobuf:"bytes,15,opt,name=normalized_total,json=normalizedTotal,proto3" json:"normalized_total,omitempty"`
 }
 
-func (m *ThresholdDecisionPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
+func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
+func (*GroupInfo) ProtoMessage()    {}
+func (*GroupInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{10}
 }
-
-func (m *ThresholdDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+func (m *GroupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GroupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupInfo.Merge(m, src)
+}
+func (m *GroupInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupInfo.DiscardUnknown(m)
 }
 
-func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GroupInfo proto.InternalMessageInfo
+
+func (m *GroupInfo) GetGroupId() ID {
+	if m != nil {
+		return m.GroupId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GroupInfo) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
 }
 
-func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalWeight) > 0 {
-		i -= len(m.TotalWeight)
-		copy(dAtA[i:], m.TotalWeight)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Version != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x20
+func (m *GroupInfo) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *GroupInfo) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *GroupInfo) GetTotalWeight() string {
+	if m != nil {
+		return m.TotalWeight
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *GroupInfo) GetRoleMultipliers() []RoleMultiplier {
+	if m != nil {
+		return m.RoleMultipliers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GroupMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GroupInfo) GetRevokeOnRemoval() bool {
+	if m != nil {
+		return m.RevokeOnRemoval
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GroupMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GroupInfo) GetWeightDecay() *WeightDecay {
+	if m != nil {
+		return m.WeightDecay
+	}
+	return nil
 }
 
-func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Member != nil {
-		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *GroupInfo) GetMetadataUri() string {
+	if m != nil {
+		return m.MetadataUri
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *GroupInfo) GetMetadataHash() []byte {
+	if m != nil {
+		return m.MetadataHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GroupAccountInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GroupInfo) GetPruneVotes() bool {
+	if m != nil {
+		return m.PruneVotes
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GroupAccountInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GroupInfo) GetSeats() uint64 {
+	if m != nil {
+		return m.Seats
+	}
+	return 0
 }
 
-func (m *GroupAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DecisionPolicy != nil {
-		{
-			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+func (m *GroupInfo) GetProposalSchema() *ProposalSchema {
+	if m != nil {
+		return m.ProposalSchema
 	}
-	if m.Version != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *GroupInfo) GetNormalizedWeights() bool {
+	if m != nil {
+		return m.NormalizedWeights
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x22
+	return false
+}
+
+func (m *GroupInfo) GetNormalizedTotal() string {
+	if m != nil {
+		return m.NormalizedTotal
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.GroupAccount) > 0 {
-		i -= len(m.GroupAccount)
-		copy(dAtA[i:], m.GroupAccount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// GroupMember represents the relationship between a group and a member.
+type GroupMember struct {
+	// group_id is the unique ID of the group.
+	GroupId ID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=ID" json:"group_id,omitempty"`
+	// member is the member data.
+	Member *Member `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GroupMember) Reset()         { *m = GroupMember{} }
+func (m *GroupMember) String() string { return proto.CompactTextString(m) }
+func (*GroupMember) ProtoMessage()    {}
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{11}
 }
-
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msgs) > 0 {
-		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if m.ExecutorResult != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorResult))
-		i--
-		dAtA[i] = 0x58
-	}
-	{
-		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size, err := m.VoteState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Result != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.GroupAccountVersion != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GroupAccountVersion))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.GroupVersion != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GroupVersion))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size, err := m.SubmittedAt.MarshalToSizedBuffer(dAtA[:i])
+func (m *GroupMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Proposers) > 0 {
-		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proposers[iNdEx])
-			copy(dAtA[i:], m.Proposers[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Proposers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GroupAccount) > 0 {
-		i -= len(m.GroupAccount)
-		copy(dAtA[i:], m.GroupAccount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GroupMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMember.Merge(m, src)
+}
+func (m *GroupMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMember proto.InternalMessageInfo
+
+func (m *GroupMember) GetGroupId() ID {
+	if m != nil {
+		return m.GroupId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Tally) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GroupMember) GetMember() *Member {
+	if m != nil {
+		return m.Member
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Tally) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GroupInvitation represents a pending invitation for an address to join a group.
+// The invited member's weight doesn't count toward the group's total weight
+// until the invitation is accepted.
+type GroupInvitation struct {
+	// group_id is the unique ID of the group.
+	GroupId ID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=ID" json:"group_id,omitempty"`
+	// member is the invited member.
+	Member *Member `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// invited_at is the timestamp when the invitation was created.
+	InvitedAt types.Timestamp `protobuf:"bytes,3,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at"`
 }
 
-func (m *Tally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VetoCount) > 0 {
-		i -= len(m.VetoCount)
-		copy(dAtA[i:], m.VetoCount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoCount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AbstainCount) > 0 {
-		i -= len(m.AbstainCount)
-		copy(dAtA[i:], m.AbstainCount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.AbstainCount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NoCount) > 0 {
-		i -= len(m.NoCount)
-		copy(dAtA[i:], m.NoCount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.NoCount)))
-		i--
-		dAtA[i] = 0x12
+func (m *GroupInvitation) Reset()         { *m = GroupInvitation{} }
+func (m *GroupInvitation) String() string { return proto.CompactTextString(m) }
+func (*GroupInvitation) ProtoMessage()    {}
+func (*GroupInvitation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{12}
+}
+func (m *GroupInvitation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupInvitation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupInvitation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.YesCount) > 0 {
-		i -= len(m.YesCount)
-		copy(dAtA[i:], m.YesCount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.YesCount)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GroupInvitation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupInvitation.Merge(m, src)
+}
+func (m *GroupInvitation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupInvitation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupInvitation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupInvitation proto.InternalMessageInfo
+
+func (m *GroupInvitation) GetGroupId() ID {
+	if m != nil {
+		return m.GroupId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GroupInvitation) GetMember() *Member {
+	if m != nil {
+		return m.Member
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GroupInvitation) GetInvitedAt() types.Timestamp {
+	if m != nil {
+		return m.InvitedAt
+	}
+	return types.Timestamp{}
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SubmittedAt.MarshalToSizedBuffer(dAtA[:i])
+// GroupAccountInfo represents the high-level on-chain information for a group account.
+type GroupAccountInfo struct {
+	// group_account is the group account address.
+	GroupAccount string `protobuf:"bytes,1,opt,name=group_account,json=groupAccount,proto3" json:"group_account,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId ID `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3,casttype=ID" json:"group_id,omitempty"`
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	// metadata is any arbitrary metadata to attached to the group account.
+	Metadata []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// version is used to track changes to a group's GroupAccountInfo structure that
+	// would create a different result on a running proposal.
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// decision_policy specifies the group account's decision policy.
+	DecisionPolicy *types1.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	// spend_limit is the optional maximum amount the group account can send
+	// through executed proposals per spend_period. Only the listed denoms are limited.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// spend_period is the length of the window the spend_limit applies to.
+	// It is required when spend_limit is set.
+	SpendPeriod *types.Duration `protobuf:"bytes,8,opt,name=spend_period,json=spendPeriod,proto3" json:"spend_period,omitempty"`
+}
+
+func (m *GroupAccountInfo) Reset()         { *m = GroupAccountInfo{} }
+func (m *GroupAccountInfo) String() string { return proto.CompactTextString(m) }
+func (*GroupAccountInfo) ProtoMessage()    {}
+func (*GroupAccountInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{13}
+}
+func (m *GroupAccountInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupAccountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupAccountInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Choice != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Choice))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GroupAccountInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupAccountInfo.Merge(m, src)
+}
+func (m *GroupAccountInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupAccountInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupAccountInfo.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_GroupAccountInfo proto.InternalMessageInfo
+
+// Proposal defines a group proposal. Any member of a group can submit a proposal
+// for a group account to decide upon.
+// A proposal consists of a set of `sdk.Msg`s that will be executed if the proposal
+// passes as well as some optional metadata associated with the proposal.
+type Proposal struct {
+	// group_account is the group account address.
+	GroupAccount string `protobuf:"bytes,1,opt,name=group_account,json=groupAccount,proto3" json:"group_account,omitempty"`
+	// metadata is any arbitrary metadata to attached to the proposal.
+	Metadata []byte `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// proposers are the account addresses of the proposers.
+	Proposers []string `protobuf:"bytes,3,rep,name=proposers,proto3" json:"proposers,omitempty"`
+	// submitted_at is a timestamp specifying when a proposal was submitted.
+	SubmittedAt types.Timestamp `protobuf:"bytes,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
+	// group_version tracks the version of the group that this proposal corresponds to.
+	// When group membership is changed, existing proposals for prior group versions will become invalid.
+	GroupVersion uint64 `protobuf:"varint,5,opt,name=group_version,json=groupVersion,proto3" json:"group_version,omitempty"`
+	// group_account_version tracks the version of the group account that this proposal corresponds to.
+	// When a decision policy is changed, an existing proposals for prior policy versions will become invalid.
+	GroupAccountVersion uint64 `protobuf:"varint,6,opt,name=group_account_version,json=groupAccountVersion,proto3" json:"group_account_version,omitempty"`
+	// Status represents the high level position in the life cycle of the proposal. Initial value is Submitted.
+	Status Proposal_Status `protobuf:"varint,7,opt,name=status,proto3,enum=regen.group.v1alpha1.Proposal_Status" json:"status,omitempty"`
+	// result is the final result based on the votes and election rule. Initial value is Undefined.
+	// The result is persisted so that clients can always rely on this state and not have to replicate the logic.
+	Result Proposal_Result `protobuf:"varint,8,opt,name=result,proto3,enum=regen.group.v1alpha1.Proposal_Result" json:"result,omitempty"`
+	// vote_state contains the sums of all weighted votes for this proposal.
+	VoteState Tally `protobuf:"bytes,9,opt,name=vote_state,json=voteState,proto3" json:"vote_state"`
+	// timeout is the timestamp of the block where the proposal execution times out. Header times of the votes and execution messages
+	// must be before this end time to be included in the election. After the timeout timestamp the proposal can not be
+	// executed anymore and should be considered pending delete.
+	Timeout types.Timestamp `protobuf:"bytes,10,opt,name=timeout,proto3" json:"timeout"`
+	// executor_result is the final result based on the votes and election rule. Initial value is NotRun.
+	ExecutorResult Proposal_ExecutorResult `protobuf:"varint,11,opt,name=executor_result,json=executorResult,proto3,enum=regen.group.v1alpha1.Proposal_ExecutorResult" json:"executor_result,omitempty"`
+	// msgs is a list of Msgs that will be executed if the proposal passes.
+	Msgs []*types1.Any `protobuf:"bytes,12,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// voting_start_time is the timestamp from which on votes are accepted. The proposal timeout is measured
+	// from this time. If not set, voting starts with the proposal submission.
+	VotingStartTime *types.Timestamp `protobuf:"bytes,13,opt,name=voting_start_time,json=votingStartTime,proto3" json:"voting_start_time,omitempty"`
+	// option_set is the optional set of options to choose from. A proposal with an option set
+	// is voted on with CHOICE_OPTION or CHOICE_ABSTAIN instead of yes/no.
+	OptionSet *OptionSet `protobuf:"bytes,14,opt,name=option_set,json=optionSet,proto3" json:"option_set,omitempty"`
+	// result_reason is a human-readable explanation of the result, e.g. "vetoed" or
+	// "expired without quorum". It is set together with the result.
+	ResultReason string `protobuf:"bytes,15,opt,name=result_reason,json=resultReason,proto3" json:"result_reason,omitempty"`
+	// paused_at is the time the proposal was paused at by the group account admin.
+	// It is unset when the proposal is not paused. Paused proposals can't be voted
+	// on or executed.
+	PausedAt *types.Timestamp `protobuf:"bytes,16,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	// paused_duration is the total time the proposal was paused for, excluding an
+	// ongoing pause. It doesn't count toward the voting duration and the timeout
+	// is shifted by it.
+	PausedDuration types.Duration `protobuf:"bytes,17,opt,name=paused_duration,json=pausedDuration,proto3" json:"paused_duration"`
+	// metadata_uri is the optional URI of off-chain metadata of the proposal.
+	MetadataUri string `protobuf:"bytes,18,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+	// metadata_hash is the SHA-256 hash of the content of metadata_uri.
+	// It is required if metadata_uri is set.
+	MetadataHash []byte `protobuf:"bytes,19,opt,name=metadata_hash,json=metadataHash,proto3" json:"metadata_hash,omitempty"`
+	// pruned_voters are the addresses of the voters whose individual votes were
+	// deleted when the proposal was finalized, see GroupInfo.prune_votes.
+	PrunedVoters []string `protobuf:"bytes,20,rep,name=pruned_voters,json=prunedVoters,proto3" json:"pruned_voters,omitempty"`
+	// revision is the number of times the proposal was amended by a proposer.
+	Revision uint64 `protobuf:"varint,21,opt,name=revision,proto3" json:"revision,omitempty"`
+	// fast_track is set if the proposal was submitted as a fast-track proposal. It is
+	// then decided by the module's fast-track percentage within the fast-track window
+	// instead of the decision policy of the group account.
+	FastTrack bool `protobuf:"varint,22,opt,name=fast_track,json=fastTrack,proto3" json:"fast_track,omitempty"`
+	// execution_retries is the number of times the execution of the proposal was
+	// retried at the end of a block after it failed, see the module's
+	// MaxExecutionRetries setting.
+	ExecutionRetries uint64 `protobuf:"varint,23,opt,name=execution_retries,json=executionRetries,proto3" json:"execution_retries,omitempty"`
+	// member_updates are the updates to the members of the group of the group account
+	// applied on the execution of a membership proposal, see Msg/CreateMembershipProposal.
+	MemberUpdates []Member `protobuf:"bytes,24,rep,name=member_updates,json=memberUpdates,proto3" json:"member_updates"`
+	// decision_policy is the optional decision policy overriding the decision policy of
+	// the group account for this proposal only, see Msg/CreateProposal.
+	DecisionPolicy *types1.Any `protobuf:"bytes,25,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
 }
-func (m *Member) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{14}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
 }
 
-func (m *ThresholdDecisionPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.Timeout.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+// OptionSet is the set of options of a multiple-option proposal.
+type OptionSet struct {
+	// options are the descriptions of the options, referenced by their index.
+	Options []string `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
 }
 
-func (m *GroupInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovTypes(uint64(m.GroupId))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovTypes(uint64(m.Version))
-	}
-	l = len(m.TotalWeight)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (m *OptionSet) Reset()         { *m = OptionSet{} }
+func (m *OptionSet) String() string { return proto.CompactTextString(m) }
+func (*OptionSet) ProtoMessage()    {}
+func (*OptionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{15}
+}
+func (m *OptionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *OptionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionSet.Merge(m, src)
+}
+func (m *OptionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionSet.DiscardUnknown(m)
 }
 
-func (m *GroupMember) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovTypes(uint64(m.GroupId))
-	}
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_OptionSet proto.InternalMessageInfo
+
+func (m *OptionSet) GetOptions() []string {
+	if m != nil {
+		return m.Options
 	}
-	return n
+	return nil
 }
 
-func (m *GroupAccountInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GroupAccount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.GroupId != 0 {
-		n += 1 + sovTypes(uint64(m.GroupId))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovTypes(uint64(m.Version))
+// Tally represents the sum of weighted votes.
+type Tally struct {
+	// yes_count is the weighted sum of yes votes.
+	YesCount string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
+	// no_count is the weighted sum of no votes.
+	NoCount string `protobuf:"bytes,2,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
+	// abstain_count is the weighted sum of abstainers
+	AbstainCount string `protobuf:"bytes,3,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
+	// veto_count is the weighted sum of vetoes.
+	VetoCount string `protobuf:"bytes,4,opt,name=veto_count,json=vetoCount,proto3" json:"veto_count,omitempty"`
+	// option_counts are the weighted sums of votes per option of a proposal with an option set.
+	OptionCounts []string `protobuf:"bytes,5,rep,name=option_counts,json=optionCounts,proto3" json:"option_counts,omitempty"`
+	// role_tallies are the tallies of the votes of members with a role, per role.
+	// They're a breakdown of the counts above and not counted again.
+	RoleTallies []RoleTally `protobuf:"bytes,6,rep,name=role_tallies,json=roleTallies,proto3" json:"role_tallies"`
+	// veto_voters is the number of distinct voters whose vote is counted in veto_count,
+	// see ThresholdDecisionPolicy.min_veto_voters.
+	VetoVoters uint64 `protobuf:"varint,7,opt,name=veto_voters,json=vetoVoters,proto3" json:"veto_voters,omitempty"`
+}
+
+func (m *Tally) Reset()         { *m = Tally{} }
+func (m *Tally) String() string { return proto.CompactTextString(m) }
+func (*Tally) ProtoMessage()    {}
+func (*Tally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{16}
+}
+func (m *Tally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.DecisionPolicy != nil {
-		l = m.DecisionPolicy.Size()
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *Tally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tally.Merge(m, src)
+}
+func (m *Tally) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tally) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tally proto.InternalMessageInfo
+
+// RoleTally represents the tally of the votes of the members with a role.
+type RoleTally struct {
+	// role is the member role.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// tally is the tally of the votes of the members with the role.
+	Tally Tally `protobuf:"bytes,2,opt,name=tally,proto3" json:"tally"`
+}
+
+func (m *RoleTally) Reset()         { *m = RoleTally{} }
+func (m *RoleTally) String() string { return proto.CompactTextString(m) }
+func (*RoleTally) ProtoMessage()    {}
+func (*RoleTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{17}
+}
+func (m *RoleTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleTally.Merge(m, src)
+}
+func (m *RoleTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleTally proto.InternalMessageInfo
+
+func (m *RoleTally) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *RoleTally) GetTally() Tally {
+	if m != nil {
+		return m.Tally
+	}
+	return Tally{}
+}
+
+// Vote represents a vote for a proposal.
+type Vote struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId ProposalID `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3,casttype=ProposalID" json:"proposal_id,omitempty"`
+	// voter is the account address of the voter.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// choice is the voter's choice on the proposal.
+	Choice Choice `protobuf:"varint,3,opt,name=choice,proto3,enum=regen.group.v1alpha1.Choice" json:"choice,omitempty"`
+	// metadata is any arbitrary metadata to attached to the vote.
+	Metadata []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// submitted_at is the timestamp when the vote was submitted.
+	SubmittedAt types.Timestamp `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
+	// nonce is the optional client supplied nonce of the vote submission.
+	Nonce []byte `protobuf:"bytes,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// option is the index of the selected option when choice is CHOICE_OPTION.
+	Option uint32 `protobuf:"varint,7,opt,name=option,proto3" json:"option,omitempty"`
+	// weight is the effective weight of the voter the vote was counted with. It is
+	// empty for votes cast before the weight was recorded.
+	Weight string `protobuf:"bytes,8,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{18}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (m *Vote) GetProposalId() ProposalID {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Vote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *Vote) GetChoice() Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return Choice_CHOICE_UNSPECIFIED
+}
+
+func (m *Vote) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Vote) GetSubmittedAt() types.Timestamp {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return types.Timestamp{}
+}
+
+func (m *Vote) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *Vote) GetOption() uint32 {
+	if m != nil {
+		return m.Option
+	}
+	return 0
+}
+
+func (m *Vote) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+// VoteChange records a change of a vote on a proposal.
+type VoteChange struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId ProposalID `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3,casttype=ProposalID" json:"proposal_id,omitempty"`
+	// voter is the account address of the voter.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// old_choice is the choice of the vote before the change.
+	OldChoice Choice `protobuf:"varint,3,opt,name=old_choice,json=oldChoice,proto3,enum=regen.group.v1alpha1.Choice" json:"old_choice,omitempty"`
+	// new_choice is the choice of the vote after the change.
+	NewChoice Choice `protobuf:"varint,4,opt,name=new_choice,json=newChoice,proto3,enum=regen.group.v1alpha1.Choice" json:"new_choice,omitempty"`
+	// changed_at is the timestamp when the vote was changed.
+	ChangedAt types.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at"`
+}
+
+func (m *VoteChange) Reset()         { *m = VoteChange{} }
+func (m *VoteChange) String() string { return proto.CompactTextString(m) }
+func (*VoteChange) ProtoMessage()    {}
+func (*VoteChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{19}
+}
+func (m *VoteChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteChange.Merge(m, src)
+}
+func (m *VoteChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteChange proto.InternalMessageInfo
+
+func (m *VoteChange) GetProposalId() ProposalID {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoteChange) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VoteChange) GetOldChoice() Choice {
+	if m != nil {
+		return m.OldChoice
+	}
+	return Choice_CHOICE_UNSPECIFIED
+}
+
+func (m *VoteChange) GetNewChoice() Choice {
+	if m != nil {
+		return m.NewChoice
+	}
+	return Choice_CHOICE_UNSPECIFIED
+}
+
+func (m *VoteChange) GetChangedAt() types.Timestamp {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return types.Timestamp{}
+}
+
+// VoteCommitment represents a hidden vote that has been committed to a proposal
+// and is waiting to be revealed.
+type VoteCommitment struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId ProposalID `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3,casttype=ProposalID" json:"proposal_id,omitempty"`
+	// voter is the account address of the voter.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// commit_hash is the sha256 hash of the proposal id, voter, choice and salt.
+	CommitHash []byte `protobuf:"bytes,3,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// submitted_at is the timestamp when the commitment was submitted.
+	SubmittedAt types.Timestamp `protobuf:"bytes,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
+}
+
+func (m *VoteCommitment) Reset()         { *m = VoteCommitment{} }
+func (m *VoteCommitment) String() string { return proto.CompactTextString(m) }
+func (*VoteCommitment) ProtoMessage()    {}
+func (*VoteCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{20}
+}
+func (m *VoteCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteCommitment.Merge(m, src)
+}
+func (m *VoteCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteCommitment proto.InternalMessageInfo
+
+func (m *VoteCommitment) GetProposalId() ProposalID {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoteCommitment) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VoteCommitment) GetCommitHash() []byte {
+	if m != nil {
+		return m.CommitHash
+	}
+	return nil
+}
+
+func (m *VoteCommitment) GetSubmittedAt() types.Timestamp {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return types.Timestamp{}
+}
+
+// GroupMemberActivity tracks the last participation of a member of a group
+// with weight decay.
+type GroupMemberActivity struct {
+	// group_id is the unique ID of the group.
+	GroupId ID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=ID" json:"group_id,omitempty"`
+	// member is the member's account address.
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// last_active is the timestamp from which the member's inactivity is measured.
+	// It is the time of the member's last participation or, once the member's weight
+	// decayed, the end of the last decayed period.
+	LastActive types.Timestamp `protobuf:"bytes,3,opt,name=last_active,json=lastActive,proto3" json:"last_active"`
+}
+
+func (m *GroupMemberActivity) Reset()         { *m = GroupMemberActivity{} }
+func (m *GroupMemberActivity) String() string { return proto.CompactTextString(m) }
+func (*GroupMemberActivity) ProtoMessage()    {}
+func (*GroupMemberActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{21}
+}
+func (m *GroupMemberActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupMemberActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupMemberActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupMemberActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMemberActivity.Merge(m, src)
+}
+func (m *GroupMemberActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupMemberActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMemberActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMemberActivity proto.InternalMessageInfo
+
+func (m *GroupMemberActivity) GetGroupId() ID {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *GroupMemberActivity) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *GroupMemberActivity) GetLastActive() types.Timestamp {
+	if m != nil {
+		return m.LastActive
+	}
+	return types.Timestamp{}
+}
+
+// GroupExport bundles the state of a single group, for migrating the group
+// without exporting the whole module state.
+type GroupExport struct {
+	// group is the group info.
+	Group GroupInfo `protobuf:"bytes,1,opt,name=group,proto3" json:"group"`
+	// members are the group members.
+	Members []GroupMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
+	// group_accounts are the group accounts of the group.
+	GroupAccounts []GroupAccountInfo `protobuf:"bytes,3,rep,name=group_accounts,json=groupAccounts,proto3" json:"group_accounts"`
+	// proposals are the open proposals to the group accounts with their votes.
+	// They are only exported on request.
+	Proposals []ProposalExport `protobuf:"bytes,4,rep,name=proposals,proto3" json:"proposals"`
+}
+
+func (m *GroupExport) Reset()         { *m = GroupExport{} }
+func (m *GroupExport) String() string { return proto.CompactTextString(m) }
+func (*GroupExport) ProtoMessage()    {}
+func (*GroupExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{22}
+}
+func (m *GroupExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupExport.Merge(m, src)
+}
+func (m *GroupExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupExport proto.InternalMessageInfo
+
+func (m *GroupExport) GetGroup() GroupInfo {
+	if m != nil {
+		return m.Group
+	}
+	return GroupInfo{}
+}
+
+func (m *GroupExport) GetMembers() []GroupMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *GroupExport) GetGroupAccounts() []GroupAccountInfo {
+	if m != nil {
+		return m.GroupAccounts
+	}
+	return nil
+}
+
+func (m *GroupExport) GetProposals() []ProposalExport {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+// ProposalExport is a proposal with its ID and votes, as part of a GroupExport.
+type ProposalExport struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId ProposalID `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3,casttype=ProposalID" json:"proposal_id,omitempty"`
+	// proposal is the proposal.
+	Proposal Proposal `protobuf:"bytes,2,opt,name=proposal,proto3" json:"proposal"`
+	// votes are the votes on the proposal.
+	Votes []Vote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *ProposalExport) Reset()         { *m = ProposalExport{} }
+func (m *ProposalExport) String() string { return proto.CompactTextString(m) }
+func (*ProposalExport) ProtoMessage()    {}
+func (*ProposalExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{23}
+}
+func (m *ProposalExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalExport.Merge(m, src)
+}
+func (m *ProposalExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalExport proto.InternalMessageInfo
+
+func (m *ProposalExport) GetProposalId() ProposalID {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalExport) GetProposal() Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return Proposal{}
+}
+
+func (m *ProposalExport) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+// GroupAccountSpend tracks the amount a group account has sent through
+// executed proposals in its current spend window.
+type GroupAccountSpend struct {
+	// group_account is the group account address.
+	GroupAccount string `protobuf:"bytes,1,opt,name=group_account,json=groupAccount,proto3" json:"group_account,omitempty"`
+	// window_start is the timestamp when the current spend window started.
+	WindowStart types.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start"`
+	// spent is the amount sent since window_start.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *GroupAccountSpend) Reset()         { *m = GroupAccountSpend{} }
+func (m *GroupAccountSpend) String() string { return proto.CompactTextString(m) }
+func (*GroupAccountSpend) ProtoMessage()    {}
+func (*GroupAccountSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{24}
+}
+func (m *GroupAccountSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupAccountSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupAccountSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupAccountSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupAccountSpend.Merge(m, src)
+}
+func (m *GroupAccountSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupAccountSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupAccountSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupAccountSpend proto.InternalMessageInfo
+
+func (m *GroupAccountSpend) GetGroupAccount() string {
+	if m != nil {
+		return m.GroupAccount
+	}
+	return ""
+}
+
+func (m *GroupAccountSpend) GetWindowStart() types.Timestamp {
+	if m != nil {
+		return m.WindowStart
+	}
+	return types.Timestamp{}
+}
+
+func (m *GroupAccountSpend) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("regen.group.v1alpha1.TieBreak", TieBreak_name, TieBreak_value)
+	proto.RegisterEnum("regen.group.v1alpha1.Choice", Choice_name, Choice_value)
+	proto.RegisterEnum("regen.group.v1alpha1.OverrideAction", OverrideAction_name, OverrideAction_value)
+	proto.RegisterEnum("regen.group.v1alpha1.Proposal_Status", Proposal_Status_name, Proposal_Status_value)
+	proto.RegisterEnum("regen.group.v1alpha1.Proposal_Result", Proposal_Result_name, Proposal_Result_value)
+	proto.RegisterEnum("regen.group.v1alpha1.Proposal_ExecutorResult", Proposal_ExecutorResult_name, Proposal_ExecutorResult_value)
+	proto.RegisterType((*Member)(nil), "regen.group.v1alpha1.Member")
+	proto.RegisterType((*WeightDecay)(nil), "regen.group.v1alpha1.WeightDecay")
+	proto.RegisterType((*ProposalSchema)(nil), "regen.group.v1alpha1.ProposalSchema")
+	proto.RegisterType((*RoleMultiplier)(nil), "regen.group.v1alpha1.RoleMultiplier")
+	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "regen.group.v1alpha1.ThresholdDecisionPolicy")
+	proto.RegisterType((*PercentageDecisionPolicy)(nil), "regen.group.v1alpha1.PercentageDecisionPolicy")
+	proto.RegisterType((*PluralityDecisionPolicy)(nil), "regen.group.v1alpha1.PluralityDecisionPolicy")
+	proto.RegisterType((*BicameralDecisionPolicy)(nil), "regen.group.v1alpha1.BicameralDecisionPolicy")
+	proto.RegisterType((*ConvictionDecisionPolicy)(nil), "regen.group.v1alpha1.ConvictionDecisionPolicy")
+	proto.RegisterType((*Chamber)(nil), "regen.group.v1alpha1.Chamber")
+	proto.RegisterType((*GroupInfo)(nil), "regen.group.v1alpha1.GroupInfo")
+	proto.RegisterType((*GroupMember)(nil), "regen.group.v1alpha1.GroupMember")
+	proto.RegisterType((*GroupInvitation)(nil), "regen.group.v1alpha1.GroupInvitation")
+	proto.RegisterType((*GroupAccountInfo)(nil), "regen.group.v1alpha1.GroupAccountInfo")
+	proto.RegisterType((*Proposal)(nil), "regen.group.v1alpha1.Proposal")
+	proto.RegisterType((*OptionSet)(nil), "regen.group.v1alpha1.OptionSet")
+	proto.RegisterType((*Tally)(nil), "regen.group.v1alpha1.Tally")
+	proto.RegisterType((*RoleTally)(nil), "regen.group.v1alpha1.RoleTally")
+	proto.RegisterType((*Vote)(nil), "regen.group.v1alpha1.Vote")
+	proto.RegisterType((*VoteChange)(nil), "regen.group.v1alpha1.VoteChange")
+	proto.RegisterType((*VoteCommitment)(nil), "regen.group.v1alpha1.VoteCommitment")
+	proto.RegisterType((*GroupMemberActivity)(nil), "regen.group.v1alpha1.GroupMemberActivity")
+	proto.RegisterType((*GroupExport)(nil), "regen.group.v1alpha1.GroupExport")
+	proto.RegisterType((*ProposalExport)(nil), "regen.group.v1alpha1.ProposalExport")
+	proto.RegisterType((*GroupAccountSpend)(nil), "regen.group.v1alpha1.GroupAccountSpend")
+}
+
+func init() { proto.RegisterFile("regen/group/v1alpha1/types.proto", fileDescriptor_9b7906b115009838) }
+
+var fileDescriptor_9b7906b115009838 = []byte{
+	// 2894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xd5, 0x37, 0x1f, 0xa2, 0xc8, 0x43, 0x89, 0xa2, 0xae, 0x65, 0x7b, 0x24, 0xdb, 0x12, 0x4d, 0x7f,
+	0x09, 0xf4, 0x39, 0x9f, 0xa5, 0x4f, 0x6a, 0xd3, 0xc0, 0x76, 0x5e, 0x14, 0x39, 0x8a, 0xd9, 0xd8,
+	0xa2, 0x32, 0xa4, 0x9c, 0xc7, 0x66, 0x70, 0x35, 0x73, 0x45, 0x4d, 0x3c, 0x33, 0x97, 0x99, 0xb9,
+	0xa4, 0xcc, 0xfe, 0x05, 0x81, 0x0a, 0x04, 0x05, 0xba, 0xea, 0x42, 0x40, 0x80, 0xa2, 0x9b, 0xb4,
+	0x40, 0x37, 0xdd, 0xb5, 0xdd, 0x75, 0x11, 0x14, 0x28, 0x10, 0x74, 0x55, 0x74, 0x91, 0x06, 0xc9,
+	0xa6, 0x8b, 0x2e, 0xbb, 0xca, 0xaa, 0xb8, 0x8f, 0xe1, 0xcb, 0x94, 0x44, 0x37, 0x6e, 0x57, 0xe2,
+	0x3d, 0xf7, 0xfc, 0xee, 0x9c, 0x73, 0xee, 0xbd, 0xbf, 0x7b, 0xce, 0x11, 0x14, 0x02, 0xd2, 0x24,
+	0xfe, 0x7a, 0x33, 0xa0, 0xed, 0xd6, 0x7a, 0x67, 0x03, 0xbb, 0xad, 0x43, 0xbc, 0xb1, 0xce, 0xba,
+	0x2d, 0x12, 0xae, 0xb5, 0x02, 0xca, 0x28, 0x5a, 0x10, 0x1a, 0x6b, 0x42, 0x63, 0x2d, 0xd2, 0x58,
+	0x5a, 0x68, 0xd2, 0x26, 0x15, 0x0a, 0xeb, 0xfc, 0x97, 0xd4, 0x5d, 0x5a, 0x6e, 0x52, 0xda, 0x74,
+	0xc9, 0xba, 0x18, 0xed, 0xb7, 0x0f, 0xd6, 0xed, 0x76, 0x80, 0x99, 0x43, 0x7d, 0x35, 0xbf, 0x32,
+	0x3a, 0xcf, 0x1c, 0x8f, 0x84, 0x0c, 0x7b, 0x2d, 0xa5, 0xb0, 0x68, 0xd1, 0xd0, 0xa3, 0xa1, 0x29,
+	0x57, 0x96, 0x83, 0x68, 0x6a, 0x14, 0x8b, 0xfd, 0x6e, 0xf4, 0x59, 0xa9, 0xb8, 0xbe, 0x8f, 0x43,
+	0xb2, 0xde, 0xd9, 0xd8, 0x27, 0x0c, 0x6f, 0xac, 0x5b, 0xd4, 0x51, 0x9f, 0x2d, 0xfe, 0x22, 0x06,
+	0xa9, 0x87, 0xc4, 0xdb, 0x27, 0x01, 0xd2, 0x60, 0x1a, 0xdb, 0x76, 0x40, 0xc2, 0x50, 0x8b, 0x15,
+	0x62, 0xab, 0x19, 0x23, 0x1a, 0xa2, 0xcb, 0x90, 0x3a, 0x22, 0x4e, 0xf3, 0x90, 0x69, 0x71, 0x31,
+	0xa1, 0x46, 0x68, 0x09, 0xd2, 0x1e, 0x61, 0xd8, 0xc6, 0x0c, 0x6b, 0x89, 0x42, 0x6c, 0x75, 0xc6,
+	0xe8, 0x8d, 0x11, 0x82, 0x64, 0x40, 0x5d, 0xa2, 0x25, 0x05, 0x42, 0xfc, 0x46, 0x77, 0x00, 0xc8,
+	0x93, 0x96, 0x13, 0x90, 0xd0, 0xc4, 0x4c, 0x9b, 0x2a, 0xc4, 0x56, 0xb3, 0x9b, 0x4b, 0x6b, 0xd2,
+	0xf8, 0xb5, 0xc8, 0xf8, 0xb5, 0x46, 0xe4, 0xb8, 0x91, 0x51, 0xda, 0x25, 0x56, 0xfc, 0x00, 0xb2,
+	0xef, 0x8a, 0x8f, 0x56, 0x88, 0x85, 0xbb, 0x62, 0x75, 0xcc, 0x88, 0x32, 0x54, 0xfc, 0x46, 0xaf,
+	0x40, 0xaa, 0x45, 0x02, 0x87, 0xda, 0xc2, 0xca, 0xec, 0xe6, 0xe2, 0x53, 0x2b, 0x57, 0x54, 0xc8,
+	0xb7, 0x92, 0x9f, 0x7f, 0xb9, 0x72, 0xc1, 0x50, 0xea, 0xc5, 0x97, 0x21, 0xb7, 0x1b, 0xd0, 0x16,
+	0x0d, 0xb1, 0x5b, 0xb7, 0x0e, 0x89, 0x87, 0xd1, 0x4d, 0x98, 0x0d, 0xc8, 0x47, 0x6d, 0x27, 0x20,
+	0xb6, 0xf9, 0x98, 0x74, 0x79, 0x40, 0x12, 0xab, 0x19, 0x63, 0x26, 0x12, 0xbe, 0x4d, 0xba, 0x61,
+	0xb1, 0x02, 0x39, 0x83, 0xba, 0xe4, 0x61, 0xdb, 0x65, 0x4e, 0xcb, 0x75, 0x48, 0xd0, 0xf3, 0x39,
+	0x36, 0xe0, 0xf3, 0x32, 0x80, 0xd7, 0xd3, 0x50, 0xf1, 0x1b, 0x90, 0x14, 0x7f, 0x97, 0x80, 0x2b,
+	0x8d, 0xc3, 0x80, 0x84, 0x87, 0xd4, 0xb5, 0x2b, 0xc4, 0x72, 0x42, 0x87, 0xfa, 0xbb, 0xd4, 0x75,
+	0xac, 0x2e, 0xba, 0x06, 0x19, 0x16, 0x4d, 0xa9, 0x45, 0xfb, 0x02, 0x74, 0x07, 0xa6, 0xf9, 0x19,
+	0xa1, 0x6d, 0x36, 0xa9, 0xc3, 0x91, 0x3e, 0xdf, 0xd0, 0x8f, 0xda, 0x34, 0x68, 0x7b, 0x62, 0xdb,
+	0x32, 0x86, 0x1a, 0xa1, 0x17, 0x20, 0xd7, 0x21, 0x8c, 0x9a, 0xfd, 0xaf, 0xca, 0xed, 0x9b, 0xe5,
+	0xd2, 0x9e, 0x95, 0x68, 0x0d, 0x2e, 0x0a, 0x35, 0x1b, 0x7b, 0x2d, 0xc7, 0x6f, 0x9a, 0x07, 0xd8,
+	0x62, 0x34, 0x10, 0x1b, 0x9a, 0x31, 0xe6, 0xf9, 0x54, 0x45, 0xce, 0x6c, 0x8b, 0x09, 0xf4, 0x7f,
+	0x70, 0xd1, 0x73, 0x7c, 0xb3, 0x4b, 0x42, 0x93, 0x51, 0xd3, 0xa7, 0xa6, 0xb0, 0x4a, 0x4b, 0x09,
+	0xfd, 0x39, 0xcf, 0xf1, 0xdf, 0x27, 0x61, 0x83, 0xee, 0x50, 0x83, 0x8b, 0xd1, 0x06, 0x5c, 0x12,
+	0xab, 0x1f, 0x04, 0xd8, 0xe2, 0xc6, 0x9b, 0xf4, 0xc0, 0xb4, 0x70, 0xc8, 0xb4, 0x69, 0xa1, 0x8f,
+	0xf8, 0xe4, 0xb6, 0x9a, 0xab, 0x1d, 0x94, 0x71, 0xc8, 0xd0, 0xab, 0xb0, 0xc4, 0x3f, 0x60, 0x8b,
+	0xf0, 0x75, 0x88, 0xd9, 0xc2, 0x01, 0x73, 0x2c, 0xa7, 0x25, 0x9c, 0xd7, 0xd2, 0x02, 0xa7, 0x79,
+	0x8e, 0x5f, 0x51, 0x0a, 0xbb, 0x83, 0xf3, 0xe8, 0x45, 0xe0, 0x36, 0x98, 0xe2, 0xa3, 0x1d, 0xca,
+	0x48, 0x10, 0x6a, 0x99, 0x42, 0x6c, 0x35, 0x69, 0xcc, 0x7a, 0x8e, 0xff, 0x88, 0x30, 0xfa, 0x48,
+	0x08, 0xef, 0xa2, 0x3f, 0xff, 0xe6, 0x76, 0x6e, 0x78, 0x8b, 0x8a, 0x7f, 0x88, 0x81, 0xb6, 0x4b,
+	0x02, 0x8b, 0xf8, 0x0c, 0x37, 0xc9, 0xc8, 0xfe, 0x2d, 0x03, 0xb4, 0x7a, 0x73, 0x6a, 0x03, 0x07,
+	0x24, 0xdf, 0x65, 0x07, 0xef, 0xc0, 0x22, 0x79, 0x62, 0xb9, 0x6d, 0x9b, 0x98, 0x78, 0x3f, 0x64,
+	0xd8, 0xf1, 0xcd, 0x83, 0x80, 0x7a, 0x26, 0xbf, 0xe7, 0x62, 0x53, 0xd3, 0xc6, 0x65, 0xa5, 0x50,
+	0x92, 0xf3, 0xdb, 0x01, 0xf5, 0xb6, 0x70, 0x48, 0xc6, 0xba, 0xf1, 0xfb, 0x18, 0x5c, 0xd9, 0x75,
+	0xdb, 0x01, 0x76, 0x1d, 0xd6, 0x1d, 0xf1, 0xa2, 0x7f, 0x58, 0x62, 0x43, 0x87, 0xe5, 0x3b, 0x58,
+	0x7f, 0x0f, 0x32, 0xcc, 0x21, 0xe6, 0x7e, 0x40, 0xf0, 0x63, 0x61, 0x6d, 0x6e, 0x73, 0x79, 0x6d,
+	0x1c, 0x99, 0xae, 0x35, 0x1c, 0xb2, 0xc5, 0xb5, 0x8c, 0x34, 0x53, 0xbf, 0xc6, 0xda, 0xff, 0x55,
+	0x0c, 0xae, 0x6c, 0x39, 0x16, 0xf6, 0x48, 0x80, 0xdd, 0x11, 0xfb, 0xef, 0xc0, 0xd4, 0x81, 0x13,
+	0x84, 0x4c, 0x98, 0x9f, 0xdd, 0xbc, 0x3e, 0xfe, 0x43, 0xe5, 0x43, 0xcc, 0x59, 0x50, 0x59, 0x2a,
+	0x11, 0xe8, 0x1e, 0xa4, 0x42, 0x62, 0x51, 0x3f, 0xa2, 0x94, 0x89, 0xb0, 0x0a, 0x32, 0x18, 0x9f,
+	0xc4, 0xb3, 0xc5, 0x67, 0xac, 0x8b, 0xff, 0x8c, 0x81, 0x56, 0xa6, 0x7e, 0xc7, 0x11, 0x07, 0xff,
+	0xbf, 0xc5, 0x14, 0x15, 0x98, 0x6d, 0x06, 0xf4, 0x88, 0x1d, 0x9a, 0x8a, 0x5b, 0x27, 0x74, 0x65,
+	0x46, 0xa2, 0x76, 0x05, 0x88, 0xf3, 0x8a, 0x87, 0x9f, 0x98, 0x03, 0x44, 0xa8, 0x78, 0xc5, 0xc3,
+	0x4f, 0xfa, 0xfc, 0x39, 0xd6, 0xed, 0x7b, 0x30, 0xad, 0xc2, 0x3b, 0x96, 0x5e, 0x87, 0x1c, 0x8f,
+	0x8f, 0x38, 0x5e, 0xfc, 0x64, 0x0a, 0x32, 0x6f, 0xf1, 0xbd, 0xaa, 0xfa, 0x07, 0x14, 0xdd, 0x80,
+	0xb4, 0xd8, 0x38, 0xd3, 0x91, 0x31, 0x4a, 0x6e, 0xa5, 0xbe, 0xfd, 0x72, 0x25, 0x5e, 0xad, 0x18,
+	0xd3, 0x42, 0x5e, 0xb5, 0xd1, 0x02, 0x4c, 0x61, 0xdb, 0x73, 0x7c, 0xb5, 0x94, 0x1c, 0x9c, 0xf9,
+	0xce, 0x69, 0x30, 0xdd, 0x21, 0x01, 0x37, 0x58, 0xf8, 0x94, 0x34, 0xa2, 0x21, 0xba, 0x01, 0x33,
+	0x8c, 0x32, 0xec, 0x9a, 0xea, 0xed, 0x94, 0xf4, 0x98, 0x15, 0x32, 0xf9, 0x96, 0xa1, 0x3d, 0xc8,
+	0x73, 0x2f, 0x06, 0x02, 0x13, 0x6a, 0xa9, 0x42, 0x62, 0x35, 0xbb, 0xf9, 0x3f, 0xe3, 0x4f, 0xda,
+	0xf0, 0x83, 0xa3, 0x62, 0x3d, 0x17, 0x0c, 0x49, 0x43, 0x74, 0x0b, 0xe6, 0x03, 0xd2, 0xa1, 0x8f,
+	0x89, 0x49, 0x7d, 0x33, 0x20, 0x1e, 0xed, 0x60, 0x57, 0xb0, 0x67, 0xda, 0x98, 0x93, 0x13, 0x35,
+	0xdf, 0x90, 0x62, 0x54, 0x81, 0x19, 0x69, 0x1f, 0x67, 0x4f, 0xdc, 0x15, 0x64, 0x99, 0xdd, 0xbc,
+	0x31, 0xfe, 0xf3, 0x03, 0x4f, 0xb0, 0x91, 0x3d, 0xea, 0x0f, 0xb8, 0xaf, 0x51, 0x44, 0xcc, 0x76,
+	0xe0, 0x08, 0xfe, 0xcc, 0x18, 0xd9, 0x48, 0xb6, 0x17, 0x38, 0xfc, 0x4d, 0xed, 0xa9, 0x1c, 0xe2,
+	0xf0, 0x50, 0x03, 0x11, 0xc9, 0x1e, 0xee, 0x3e, 0x0e, 0x0f, 0xd1, 0x0a, 0x64, 0x5b, 0x41, 0xdb,
+	0x27, 0x82, 0x87, 0x43, 0x2d, 0x2b, 0x6c, 0x06, 0x21, 0xe2, 0x24, 0x1c, 0xf2, 0x0d, 0x0a, 0x09,
+	0x66, 0xa1, 0x36, 0x23, 0x82, 0x2d, 0x07, 0xe8, 0x21, 0xcc, 0xb5, 0xd4, 0x0b, 0x6e, 0x86, 0xe2,
+	0x09, 0xd7, 0x66, 0x0b, 0xb1, 0xd3, 0xc3, 0x38, 0xfc, 0xdc, 0x1b, 0xb9, 0xd6, 0xd0, 0x18, 0xdd,
+	0x06, 0xe4, 0xd3, 0xc0, 0xc3, 0xae, 0xf3, 0x23, 0x62, 0xab, 0xed, 0x0b, 0xb5, 0x9c, 0x30, 0x66,
+	0xbe, 0x3f, 0x23, 0xa3, 0x11, 0xa2, 0xff, 0x85, 0xfc, 0x80, 0xba, 0xd8, 0x5f, 0x6d, 0x4e, 0xbe,
+	0x6d, 0x7d, 0x79, 0x83, 0x8b, 0x8b, 0x07, 0x90, 0x15, 0xe7, 0x51, 0xa5, 0x5c, 0x13, 0x9c, 0xc8,
+	0xef, 0x43, 0xca, 0x13, 0xca, 0xea, 0xea, 0x5e, 0x1b, 0xef, 0x91, 0x5c, 0xd0, 0x50, 0xba, 0xc5,
+	0x5f, 0xc6, 0x60, 0x4e, 0x1d, 0xfc, 0x8e, 0xc3, 0xe4, 0x33, 0xf7, 0x9f, 0xfa, 0x18, 0x7a, 0x03,
+	0xc0, 0xe1, 0x9f, 0x21, 0x36, 0x4f, 0xeb, 0x12, 0xe7, 0xa5, 0x75, 0xea, 0xd4, 0x66, 0x14, 0xa6,
+	0xc4, 0x8a, 0xbf, 0x4e, 0x40, 0x5e, 0x58, 0x5b, 0xb2, 0x2c, 0xda, 0xf6, 0x99, 0xb8, 0xad, 0x37,
+	0x05, 0xf3, 0xb4, 0x5b, 0x26, 0x96, 0x42, 0x75, 0xed, 0x67, 0x9a, 0x03, 0x8a, 0x43, 0x3e, 0xc5,
+	0xcf, 0xb9, 0xd2, 0x89, 0xd3, 0xae, 0x74, 0xf2, 0xf4, 0x2b, 0x3d, 0x35, 0x7c, 0xa5, 0xdf, 0x81,
+	0x39, 0x5b, 0xd1, 0x93, 0xd9, 0x12, 0xfc, 0x24, 0x92, 0x98, 0xec, 0xe6, 0xc2, 0x53, 0xee, 0x96,
+	0xfc, 0xee, 0x16, 0xfa, 0xe3, 0x53, 0x7c, 0x66, 0xe4, 0xec, 0xa1, 0x31, 0x72, 0x21, 0x1b, 0xb6,
+	0x88, 0x6f, 0x9b, 0xae, 0xe3, 0x39, 0x3c, 0xc7, 0x49, 0x08, 0x7a, 0x55, 0xf9, 0x3d, 0x7f, 0xce,
+	0xd7, 0x54, 0xda, 0xbe, 0x56, 0xa6, 0x8e, 0xbf, 0xf5, 0xff, 0x3c, 0x78, 0x9f, 0xfd, 0x6d, 0x65,
+	0xb5, 0xe9, 0xb0, 0xc3, 0xf6, 0xfe, 0x9a, 0x45, 0x3d, 0x55, 0x0c, 0xa8, 0x3f, 0xb7, 0x43, 0xfb,
+	0xb1, 0xaa, 0x52, 0x38, 0x20, 0x34, 0x40, 0xac, 0xff, 0x80, 0x2f, 0x8f, 0x5e, 0x85, 0x19, 0xf9,
+	0x35, 0xc5, 0xe6, 0xe9, 0x73, 0xd8, 0xdc, 0x90, 0xc6, 0x49, 0x1a, 0xbf, 0x9b, 0xfe, 0xf8, 0xd3,
+	0x95, 0x0b, 0x7f, 0xff, 0x74, 0x25, 0x56, 0xfc, 0x53, 0x1e, 0xd2, 0xd1, 0x25, 0x9a, 0x6c, 0xa7,
+	0x06, 0x03, 0x1e, 0x1f, 0x09, 0xf8, 0x35, 0xc8, 0xc8, 0x1b, 0xc8, 0xf9, 0x2f, 0x21, 0x52, 0xed,
+	0xbe, 0x00, 0x95, 0x61, 0x26, 0x6c, 0xef, 0x7b, 0x0e, 0x53, 0x07, 0x2c, 0x39, 0xe1, 0x01, 0xcb,
+	0xf6, 0x50, 0x25, 0xd6, 0xb7, 0x71, 0x78, 0x67, 0xa5, 0x8d, 0x8f, 0xd4, 0xf6, 0x6e, 0xc2, 0xa5,
+	0x21, 0x47, 0x7a, 0xca, 0x29, 0xa1, 0x7c, 0x71, 0xd0, 0xa1, 0x08, 0xf3, 0x1a, 0xa4, 0x42, 0x86,
+	0x59, 0x3b, 0x14, 0x04, 0x9b, 0xdb, 0x7c, 0xe1, 0x6c, 0xc6, 0x59, 0xab, 0x0b, 0x65, 0x43, 0x81,
+	0x38, 0x3c, 0x20, 0x61, 0xdb, 0x65, 0x5a, 0x7a, 0x22, 0xb8, 0x21, 0x94, 0x0d, 0x05, 0x42, 0x6f,
+	0x02, 0x70, 0xa6, 0x34, 0xf9, 0x6a, 0x44, 0xb0, 0x6e, 0x76, 0xf3, 0xea, 0x29, 0x99, 0x14, 0x76,
+	0xdd, 0x6e, 0x74, 0xf7, 0x38, 0x88, 0x5b, 0x42, 0xd0, 0xdd, 0x7e, 0x6e, 0x00, 0x13, 0x06, 0x36,
+	0x02, 0xa0, 0x47, 0x30, 0x47, 0x9e, 0x10, 0xab, 0xcd, 0x68, 0x60, 0x2a, 0x2f, 0xb2, 0xc2, 0x8b,
+	0xdb, 0xe7, 0x78, 0xa1, 0x2b, 0x94, 0xf2, 0x26, 0x47, 0x86, 0xc6, 0x68, 0x15, 0x92, 0x5e, 0xd8,
+	0xe4, 0x1c, 0x9f, 0x38, 0xed, 0x6e, 0x19, 0x42, 0x03, 0x6d, 0xc3, 0x7c, 0x87, 0x32, 0x5e, 0x83,
+	0x84, 0x0c, 0x07, 0xcc, 0xe4, 0x96, 0x69, 0xb3, 0xe7, 0xf9, 0x61, 0xcc, 0x49, 0x50, 0x9d, 0x63,
+	0xb8, 0x14, 0xbd, 0x0e, 0x40, 0x5b, 0xa2, 0xd8, 0x08, 0x09, 0x13, 0x4c, 0x9f, 0xdd, 0x5c, 0x19,
+	0xef, 0x44, 0x4d, 0xe8, 0xd5, 0x09, 0x33, 0x32, 0x34, 0xfa, 0x29, 0x0b, 0x46, 0x6e, 0xbb, 0x19,
+	0x10, 0x1c, 0x52, 0x5f, 0xf1, 0xff, 0x8c, 0x14, 0x1a, 0x42, 0x86, 0x5e, 0x81, 0x4c, 0x0b, 0xb7,
+	0x43, 0x79, 0x8a, 0xf3, 0xe7, 0x1a, 0x99, 0x96, 0xca, 0x25, 0x86, 0xee, 0xc3, 0x9c, 0x02, 0x46,
+	0x4d, 0x03, 0x6d, 0x7e, 0xb2, 0x34, 0x2c, 0x27, 0x71, 0x91, 0xf4, 0xa9, 0x77, 0x1a, 0x4d, 0xf0,
+	0x4e, 0x5f, 0x1c, 0xf3, 0x4e, 0xdf, 0x84, 0x59, 0xf1, 0x28, 0xdb, 0x51, 0xc1, 0xb4, 0x20, 0x0b,
+	0x64, 0x29, 0x94, 0xf5, 0x12, 0xbf, 0xf2, 0x01, 0xe9, 0x08, 0xb2, 0xd3, 0x2e, 0x89, 0x1b, 0xd4,
+	0x1b, 0xa3, 0xeb, 0x00, 0x07, 0x38, 0x64, 0x26, 0x0b, 0xb0, 0xf5, 0x58, 0xbb, 0x2c, 0x9e, 0xd6,
+	0x0c, 0x97, 0x34, 0xb8, 0x00, 0xbd, 0x04, 0xf3, 0xf2, 0x4c, 0x38, 0x22, 0x83, 0x61, 0x81, 0x43,
+	0x42, 0xed, 0x8a, 0x58, 0x23, 0xdf, 0x9b, 0x30, 0xa4, 0x1c, 0x55, 0x21, 0x27, 0x5f, 0x22, 0xb3,
+	0xdd, 0xb2, 0x31, 0xcf, 0x1b, 0xb4, 0x42, 0xe2, 0xbc, 0xd7, 0x4b, 0x05, 0x68, 0x56, 0x22, 0xf7,
+	0x24, 0x70, 0x1c, 0xc1, 0x2f, 0x7e, 0x37, 0x82, 0x2f, 0x7e, 0x11, 0x83, 0x94, 0xbc, 0xf4, 0x68,
+	0x03, 0x50, 0xbd, 0x51, 0x6a, 0xec, 0xd5, 0xcd, 0xbd, 0x9d, 0xfa, 0xae, 0x5e, 0xae, 0x6e, 0x57,
+	0xf5, 0x4a, 0xfe, 0xc2, 0xd2, 0xe2, 0xf1, 0x49, 0xe1, 0x52, 0x2f, 0x27, 0x11, 0xba, 0x55, 0xbf,
+	0x83, 0x5d, 0xc7, 0x46, 0x1b, 0x90, 0x57, 0x90, 0xfa, 0xde, 0xd6, 0xc3, 0x6a, 0xa3, 0xa1, 0x57,
+	0xf2, 0xb1, 0xa5, 0xab, 0xc7, 0x27, 0x85, 0x2b, 0xc3, 0x80, 0x7a, 0x44, 0x76, 0xe8, 0x25, 0x98,
+	0x55, 0x90, 0xf2, 0x83, 0x5a, 0x5d, 0xaf, 0xe4, 0xe3, 0x4b, 0xda, 0xf1, 0x49, 0x61, 0x61, 0x58,
+	0xbf, 0xec, 0xd2, 0x90, 0xd8, 0xe8, 0x36, 0xe4, 0x94, 0x72, 0x69, 0xab, 0x66, 0xf0, 0xd5, 0x13,
+	0xe3, 0xcc, 0x29, 0xed, 0xd3, 0x80, 0x11, 0x7b, 0x29, 0xf9, 0xf1, 0xcf, 0x97, 0x2f, 0x14, 0xff,
+	0x1a, 0x83, 0x94, 0xba, 0xaa, 0x1b, 0x80, 0x0c, 0xbd, 0xbe, 0xf7, 0xa0, 0x71, 0x96, 0x4b, 0x52,
+	0x37, 0x72, 0xe9, 0xe5, 0x01, 0xc8, 0x76, 0x75, 0xa7, 0xf4, 0xa0, 0xfa, 0x81, 0x70, 0xea, 0xfa,
+	0xf1, 0x49, 0x61, 0x71, 0x18, 0xb2, 0xe7, 0x1f, 0x38, 0xbe, 0xcc, 0x9f, 0xd0, 0x3a, 0xcc, 0x29,
+	0x58, 0xa9, 0x5c, 0xd6, 0x77, 0x1b, 0xc2, 0xb1, 0xa5, 0xe3, 0x93, 0xc2, 0xe5, 0x61, 0x4c, 0xc9,
+	0xb2, 0x48, 0x8b, 0x0d, 0x01, 0x0c, 0xfd, 0x87, 0x7a, 0x59, 0xfa, 0x36, 0x06, 0x60, 0x90, 0x0f,
+	0x89, 0xd5, 0x77, 0xee, 0x1f, 0x71, 0xc8, 0x0d, 0xf3, 0x13, 0xda, 0x82, 0xab, 0xfa, 0x7b, 0x7a,
+	0x79, 0xaf, 0x51, 0x33, 0xcc, 0xb1, 0xde, 0xde, 0x38, 0x3e, 0x29, 0x5c, 0x8f, 0x56, 0x1d, 0x06,
+	0x47, 0x5e, 0xbf, 0x06, 0x57, 0x46, 0xd7, 0xd8, 0xa9, 0x35, 0x4c, 0x63, 0x6f, 0x27, 0x1f, 0x5b,
+	0x2a, 0x1c, 0x9f, 0x14, 0xae, 0x8d, 0xc7, 0xef, 0x50, 0x66, 0xb4, 0x7d, 0xf4, 0xfa, 0xd3, 0xf0,
+	0xfa, 0x5e, 0xb9, 0xac, 0xd7, 0xeb, 0xf9, 0xf8, 0x59, 0x9f, 0xaf, 0xb7, 0x2d, 0x8b, 0xb7, 0xf0,
+	0xc6, 0xe0, 0xb7, 0x4b, 0xd5, 0x07, 0x7b, 0x86, 0x9e, 0x4f, 0x9c, 0x85, 0xdf, 0xc6, 0x8e, 0xdb,
+	0x0e, 0x08, 0x7a, 0x07, 0x6e, 0x8c, 0xe2, 0x77, 0x75, 0xe3, 0x61, 0x69, 0x47, 0xdf, 0xe9, 0xaf,
+	0x94, 0x5c, 0xba, 0x75, 0x7c, 0x52, 0x78, 0x71, 0xfc, 0x4a, 0xbb, 0x24, 0xf0, 0xb0, 0x4f, 0xfc,
+	0x68, 0x49, 0x19, 0xee, 0xbb, 0x49, 0x9e, 0x53, 0x14, 0x5f, 0x80, 0x4c, 0x8f, 0x57, 0x79, 0xfe,
+	0x25, 0x99, 0x35, 0xea, 0xbb, 0x45, 0xc3, 0xe2, 0xcf, 0xe2, 0x30, 0x25, 0xde, 0x31, 0x74, 0x15,
+	0x32, 0xbc, 0x9d, 0x34, 0x98, 0x6f, 0xa4, 0xbb, 0x24, 0x2c, 0xf3, 0x31, 0x5a, 0x84, 0xb4, 0x4f,
+	0xd5, 0x9c, 0x2c, 0xe4, 0xa6, 0x7d, 0x2a, 0xa7, 0x6e, 0xc2, 0x6c, 0xd4, 0x2f, 0x91, 0xf3, 0x32,
+	0x2b, 0x9c, 0x51, 0x42, 0xa9, 0x74, 0x1d, 0x40, 0x34, 0x83, 0xa4, 0x86, 0x2c, 0x55, 0x33, 0x5c,
+	0xd2, 0x5b, 0x43, 0x3d, 0x16, 0x42, 0x21, 0xd4, 0xa6, 0x24, 0xf9, 0x49, 0xa1, 0xd0, 0x09, 0xd1,
+	0x7d, 0x98, 0x11, 0xa5, 0x1d, 0xc3, 0xae, 0xeb, 0x90, 0xa8, 0xac, 0x5b, 0x39, 0xbd, 0xac, 0x1b,
+	0x7c, 0x9f, 0xb3, 0x81, 0x12, 0x70, 0x7a, 0x5b, 0x81, 0xec, 0x60, 0x6b, 0x6a, 0x5a, 0xb0, 0xa0,
+	0x30, 0x50, 0xf5, 0xa5, 0x64, 0x08, 0xdf, 0x83, 0x4c, 0x6f, 0x99, 0xb1, 0xa5, 0xf2, 0x2b, 0x30,
+	0xc5, 0x8d, 0xe9, 0x6a, 0xf1, 0x49, 0xd3, 0x04, 0xa9, 0x5f, 0xfc, 0x2c, 0x0e, 0x49, 0xfe, 0x29,
+	0xb4, 0xce, 0xab, 0x33, 0x55, 0x66, 0xf5, 0x8a, 0x88, 0xdc, 0xb7, 0x5f, 0xae, 0x40, 0xb4, 0xe5,
+	0xd5, 0x0a, 0xaf, 0xd6, 0xd4, 0x6f, 0x91, 0x7b, 0x0b, 0xab, 0xa3, 0x72, 0x5a, 0x0c, 0x78, 0x95,
+	0x61, 0x1d, 0x52, 0xc7, 0x22, 0xaa, 0xf5, 0x73, 0xed, 0xb4, 0xae, 0x0a, 0xd7, 0x31, 0x94, 0xee,
+	0x99, 0x19, 0xfb, 0x68, 0x8a, 0x38, 0xf5, 0xef, 0xa4, 0x88, 0x0b, 0x30, 0xe5, 0x53, 0xdf, 0x22,
+	0x22, 0xdb, 0x9b, 0x31, 0xe4, 0x80, 0x77, 0xbf, 0xe4, 0xbe, 0x8a, 0xc0, 0xcf, 0x1a, 0x6a, 0x34,
+	0xd0, 0x13, 0x4f, 0x0f, 0xf6, 0xc4, 0x8b, 0x9f, 0xc4, 0x01, 0x78, 0xb0, 0xca, 0x87, 0xd8, 0x6f,
+	0x3e, 0xb7, 0x90, 0xdd, 0x03, 0xa0, 0xae, 0x6d, 0x3e, 0x43, 0xd8, 0x32, 0xd4, 0xb5, 0xe5, 0x4f,
+	0x0e, 0xf6, 0xc9, 0x51, 0x04, 0x4e, 0x4e, 0x02, 0xf6, 0xc9, 0x91, 0x02, 0xbf, 0x01, 0x60, 0x09,
+	0x57, 0x9e, 0x29, 0xb0, 0x19, 0x85, 0x29, 0x31, 0xde, 0x5a, 0xcc, 0x89, 0x80, 0x50, 0xcf, 0x73,
+	0x98, 0x47, 0x7c, 0xf6, 0xbc, 0x82, 0xb2, 0x02, 0x59, 0x4b, 0x2c, 0x2a, 0xf3, 0x14, 0xd9, 0x99,
+	0x01, 0x29, 0x12, 0x59, 0xca, 0xf3, 0xa8, 0x1c, 0x8a, 0x3f, 0x8d, 0xc1, 0xc5, 0x81, 0x9a, 0xbd,
+	0x64, 0x31, 0xa7, 0xe3, 0xb0, 0xee, 0x24, 0xe5, 0xf4, 0xe5, 0xa1, 0x72, 0x3a, 0xd3, 0x2b, 0x98,
+	0x4b, 0x90, 0x75, 0x79, 0xf2, 0xc3, 0x7b, 0xd8, 0x1d, 0x32, 0x71, 0xc5, 0x0c, 0x1c, 0x24, 0xbe,
+	0x4f, 0x8a, 0xbf, 0x8a, 0xab, 0x4e, 0x82, 0xfe, 0xa4, 0x45, 0x03, 0xde, 0xa9, 0x9c, 0x12, 0x5f,
+	0x55, 0x4d, 0xce, 0x53, 0x78, 0xa6, 0xd7, 0x0b, 0x8b, 0x2e, 0xb8, 0x98, 0x47, 0x25, 0x98, 0x96,
+	0x96, 0x85, 0x5a, 0xbc, 0x90, 0x38, 0xbd, 0xfd, 0x33, 0x10, 0x86, 0xa8, 0x14, 0x50, 0x38, 0x54,
+	0x87, 0xdc, 0x50, 0xe9, 0x24, 0xeb, 0xb8, 0xec, 0xe6, 0x8b, 0x67, 0xac, 0x34, 0x50, 0xed, 0x47,
+	0xd9, 0xd8, 0x60, 0x85, 0xc5, 0x39, 0x34, 0x13, 0x1d, 0x82, 0x50, 0x4b, 0x9e, 0xd5, 0x17, 0xeb,
+	0x3f, 0x39, 0x3c, 0x1a, 0xd1, 0x21, 0xec, 0x81, 0x8b, 0xbf, 0x8d, 0x41, 0x6e, 0x58, 0xe7, 0xd9,
+	0x0f, 0xe1, 0x9b, 0x90, 0x8e, 0x46, 0x8a, 0x42, 0x97, 0xcf, 0x36, 0x46, 0x99, 0xd1, 0x43, 0xa1,
+	0x1f, 0xc8, 0x63, 0x1c, 0xc5, 0x66, 0x69, 0x3c, 0x9c, 0x5f, 0x96, 0x68, 0x7f, 0x84, 0x3a, 0xef,
+	0x6e, 0xcf, 0x0f, 0x46, 0xac, 0xce, 0x6b, 0xf2, 0xc9, 0xca, 0xee, 0x32, 0xcc, 0x1c, 0x39, 0xbe,
+	0x4d, 0x8f, 0x64, 0x81, 0xa4, 0xc5, 0x27, 0x3c, 0x6b, 0x59, 0x89, 0x12, 0x15, 0x12, 0xc2, 0x30,
+	0xc5, 0xdb, 0x00, 0x4c, 0x4b, 0x3c, 0xff, 0xee, 0x84, 0x5c, 0xf9, 0xd6, 0xbb, 0x90, 0x8e, 0x5a,
+	0xfd, 0x68, 0x11, 0x2e, 0x35, 0xaa, 0xba, 0xb9, 0x65, 0xe8, 0xa5, 0xb7, 0x87, 0x13, 0x2d, 0xb4,
+	0x00, 0xf9, 0xfe, 0x94, 0x4c, 0xeb, 0xf2, 0x31, 0xb4, 0x04, 0x97, 0xfb, 0xd2, 0x07, 0xb5, 0x77,
+	0xf5, 0x7a, 0xc3, 0xac, 0xee, 0x54, 0xf4, 0xf7, 0xf2, 0xf1, 0x5b, 0x3f, 0x8e, 0x41, 0x4a, 0x51,
+	0xd9, 0x65, 0x40, 0xe5, 0xfb, 0xb5, 0x6a, 0x59, 0x1f, 0x59, 0x74, 0x16, 0x32, 0x4a, 0xbe, 0x53,
+	0xcb, 0xc7, 0x50, 0x0e, 0x40, 0x0d, 0xdf, 0xd7, 0xeb, 0xf9, 0x38, 0x42, 0x90, 0x53, 0xe3, 0xd2,
+	0x56, 0xbd, 0x51, 0xaa, 0xee, 0xe4, 0x13, 0x68, 0x0e, 0xb2, 0x4a, 0xf6, 0x48, 0x6f, 0xd4, 0xf2,
+	0x49, 0x34, 0x0f, 0xb3, 0x4a, 0x50, 0xdb, 0x6d, 0x54, 0x6b, 0x3b, 0xf9, 0xa9, 0x01, 0xdc, 0xae,
+	0xa1, 0xd7, 0xf5, 0x9d, 0x46, 0x3e, 0x75, 0xeb, 0x43, 0xc8, 0xd5, 0x3a, 0x24, 0x08, 0x1c, 0x9b,
+	0x94, 0x44, 0x1f, 0x1f, 0xad, 0xc0, 0xd5, 0xda, 0x23, 0xdd, 0x30, 0xaa, 0x15, 0xdd, 0x2c, 0x95,
+	0x39, 0x74, 0xc4, 0xba, 0xab, 0x70, 0x65, 0x54, 0x41, 0x66, 0x62, 0xba, 0xf4, 0x7c, 0x74, 0xb2,
+	0x5c, 0xda, 0x29, 0xeb, 0x0f, 0xf2, 0xf1, 0xad, 0xb7, 0x3e, 0xff, 0x7a, 0x39, 0xf6, 0xc5, 0xd7,
+	0xcb, 0xb1, 0xaf, 0xbe, 0x5e, 0x8e, 0xfd, 0xe4, 0x9b, 0xe5, 0x0b, 0x5f, 0x7c, 0xb3, 0x7c, 0xe1,
+	0x2f, 0xdf, 0x2c, 0x5f, 0xf8, 0xe0, 0xf6, 0xc0, 0xee, 0x88, 0x23, 0x78, 0xdb, 0x27, 0xec, 0x88,
+	0x06, 0x8f, 0xd5, 0xc8, 0x25, 0x76, 0x93, 0x04, 0xeb, 0x4f, 0xe4, 0xff, 0xbe, 0xf7, 0x53, 0xe2,
+	0x94, 0x7c, 0xef, 0x5f, 0x03, 0x00, 0xce, 0x43, 0xa7, 0x29, 0x11, 0x1f, 0x00, 0x00,
+}
+
+func (this *GroupAccountInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GroupAccountInfo)
+	if !ok {
+		that2, ok := that.(GroupAccountInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.GroupAccount != that1.GroupAccount {
+		return false
+	}
+	if this.GroupId != that1.GroupId {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if !bytes.Equal(this.Metadata, that1.Metadata) {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.DecisionPolicy.Equal(that1.DecisionPolicy) {
+		return false
+	}
+	if len(this.SpendLimit) != len(that1.SpendLimit) {
+		return false
+	}
+	for i := range this.SpendLimit {
+		if !this.SpendLimit[i].Equal(&that1.SpendLimit[i]) {
+			return false
+		}
+	}
+	if !this.SpendPeriod.Equal(that1.SpendPeriod) {
+		return false
+	}
+	return true
+}
+func (m *Member) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightDecay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightDecay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightDecay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rate) > 0 {
+		i -= len(m.Rate)
+		copy(dAtA[i:], m.Rate)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Rate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequiredKeys) > 0 {
+		for iNdEx := len(m.RequiredKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredKeys[iNdEx])
+			copy(dAtA[i:], m.RequiredKeys[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RequiredKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Multiplier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinVetoVoters != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinVetoVoters))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MinDecisiveParticipation) > 0 {
+		i -= len(m.MinDecisiveParticipation)
+		copy(dAtA[i:], m.MinDecisiveParticipation)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MinDecisiveParticipation)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.VetoFractionOfCast) > 0 {
+		i -= len(m.VetoFractionOfCast)
+		copy(dAtA[i:], m.VetoFractionOfCast)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoFractionOfCast)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MinYesToNoRatio) > 0 {
+		i -= len(m.MinYesToNoRatio)
+		copy(dAtA[i:], m.MinYesToNoRatio)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MinYesToNoRatio)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VetoDampingFactor) > 0 {
+		i -= len(m.VetoDampingFactor)
+		copy(dAtA[i:], m.VetoDampingFactor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoDampingFactor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PercentageDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PercentageDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExcludeAbstainFromBase {
+		i--
+		if m.ExcludeAbstainFromBase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PluralityDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluralityDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluralityDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TieBreak != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TieBreak))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BicameralDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BicameralDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BicameralDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Second.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.First.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvictionDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvictionDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvictionDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxMultiplier) > 0 {
+		i -= len(m.MaxMultiplier)
+		copy(dAtA[i:], m.MaxMultiplier)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MaxMultiplier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.GrowthPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Chamber) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Chamber) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Chamber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NormalizedTotal) > 0 {
+		i -= len(m.NormalizedTotal)
+		copy(dAtA[i:], m.NormalizedTotal)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NormalizedTotal)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.NormalizedWeights {
+		i--
+		if m.NormalizedWeights {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ProposalSchema != nil {
+		{
+			size, err := m.ProposalSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Seats != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Seats))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PruneVotes {
+		i--
+		if m.PruneVotes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.MetadataHash) > 0 {
+		i -= len(m.MetadataHash)
+		copy(dAtA[i:], m.MetadataHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.WeightDecay != nil {
+		{
+			size, err := m.WeightDecay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RevokeOnRemoval {
+		i--
+		if m.RevokeOnRemoval {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RoleMultipliers) > 0 {
+		for iNdEx := len(m.RoleMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupInvitation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupInvitation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupInvitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InvitedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupAccountInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupAccountInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendPeriod != nil {
+		{
+			size, err := m.SpendPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.MemberUpdates) > 0 {
+		for iNdEx := len(m.MemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.ExecutionRetries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutionRetries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.FastTrack {
+		i--
+		if m.FastTrack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Revision != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.PrunedVoters) > 0 {
+		for iNdEx := len(m.PrunedVoters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrunedVoters[iNdEx])
+			copy(dAtA[i:], m.PrunedVoters[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.PrunedVoters[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.MetadataHash) > 0 {
+		i -= len(m.MetadataHash)
+		copy(dAtA[i:], m.MetadataHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size, err := m.PausedDuration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.PausedAt != nil {
+		{
+			size, err := m.PausedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ResultReason) > 0 {
+		i -= len(m.ResultReason)
+		copy(dAtA[i:], m.ResultReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ResultReason)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.OptionSet != nil {
+		{
+			size, err := m.OptionSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.VotingStartTime != nil {
+		{
+			size, err := m.VotingStartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorResult != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorResult))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.VoteState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Result != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GroupAccountVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupAccountVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GroupVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.SubmittedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proposers[iNdEx])
+			copy(dAtA[i:], m.Proposers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Proposers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Options[iNdEx])
+			copy(dAtA[i:], m.Options[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Options[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VetoVoters != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VetoVoters))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RoleTallies) > 0 {
+		for iNdEx := len(m.RoleTallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleTallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OptionCounts) > 0 {
+		for iNdEx := len(m.OptionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionCounts[iNdEx])
+			copy(dAtA[i:], m.OptionCounts[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.OptionCounts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VetoCount) > 0 {
+		i -= len(m.VetoCount)
+		copy(dAtA[i:], m.VetoCount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoCount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AbstainCount) > 0 {
+		i -= len(m.AbstainCount)
+		copy(dAtA[i:], m.AbstainCount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AbstainCount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NoCount) > 0 {
+		i -= len(m.NoCount)
+		copy(dAtA[i:], m.NoCount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NoCount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.YesCount) > 0 {
+		i -= len(m.YesCount)
+		copy(dAtA[i:], m.YesCount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.YesCount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Option != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.SubmittedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Choice != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Choice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChangedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NewChoice != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NewChoice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OldChoice != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OldChoice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SubmittedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CommitHash) > 0 {
+		i -= len(m.CommitHash)
+		copy(dAtA[i:], m.CommitHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CommitHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupMemberActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMemberActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMemberActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastActive.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GroupAccounts) > 0 {
+		for iNdEx := len(m.GroupAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupAccountSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupAccountSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupAccountSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.WindowStart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Member) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *WeightDecay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rate)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Period.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ProposalSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequiredKeys) > 0 {
+		for _, s := range m.RequiredKeys {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Timeout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VetoDampingFactor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MinYesToNoRatio)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VetoFractionOfCast)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MinDecisiveParticipation)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MinVetoVoters != 0 {
+		n += 1 + sovTypes(uint64(m.MinVetoVoters))
+	}
+	return n
+}
+
+func (m *PercentageDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Timeout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ExcludeAbstainFromBase {
+		n += 2
+	}
+	return n
+}
+
+func (m *PluralityDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Timeout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TieBreak != 0 {
+		n += 1 + sovTypes(uint64(m.TieBreak))
+	}
+	return n
+}
+
+func (m *BicameralDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.First.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Second.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Timeout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ConvictionDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Timeout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.GrowthPeriod.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.MaxMultiplier)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Chamber) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.RoleMultipliers) > 0 {
+		for _, e := range m.RoleMultipliers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RevokeOnRemoval {
+		n += 2
+	}
+	if m.WeightDecay != nil {
+		l = m.WeightDecay.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MetadataHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PruneVotes {
+		n += 2
+	}
+	if m.Seats != 0 {
+		n += 1 + sovTypes(uint64(m.Seats))
+	}
+	if m.ProposalSchema != nil {
+		l = m.ProposalSchema.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.NormalizedWeights {
+		n += 2
+	}
+	l = len(m.NormalizedTotal)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupInvitation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InvitedAt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GroupAccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.SpendPeriod != nil {
+		l = m.SpendPeriod.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Proposers) > 0 {
+		for _, s := range m.Proposers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.SubmittedAt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.GroupVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupVersion))
+	}
+	if m.GroupAccountVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupAccountVersion))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.Result != 0 {
+		n += 1 + sovTypes(uint64(m.Result))
+	}
+	l = m.VoteState.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Timeout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ExecutorResult != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutorResult))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.VotingStartTime != nil {
+		l = m.VotingStartTime.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.OptionSet != nil {
+		l = m.OptionSet.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ResultReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PausedAt != nil {
+		l = m.PausedAt.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = m.PausedDuration.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MetadataHash)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if len(m.PrunedVoters) > 0 {
+		for _, s := range m.PrunedVoters {
+			l = len(s)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Revision != 0 {
+		n += 2 + sovTypes(uint64(m.Revision))
+	}
+	if m.FastTrack {
+		n += 3
+	}
+	if m.ExecutionRetries != 0 {
+		n += 2 + sovTypes(uint64(m.ExecutionRetries))
+	}
+	if len(m.MemberUpdates) > 0 {
+		for _, e := range m.MemberUpdates {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *OptionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Tally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YesCount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NoCount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AbstainCount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VetoCount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.OptionCounts) > 0 {
+		for _, s := range m.OptionCounts {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RoleTallies) > 0 {
+		for _, e := range m.RoleTallies {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.VetoVoters != 0 {
+		n += 1 + sovTypes(uint64(m.VetoVoters))
+	}
+	return n
+}
+
+func (m *RoleTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Tally.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Choice != 0 {
+		n += 1 + sovTypes(uint64(m.Choice))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.SubmittedAt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTypes(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.OldChoice != 0 {
+		n += 1 + sovTypes(uint64(m.OldChoice))
+	}
+	if m.NewChoice != 0 {
+		n += 1 + sovTypes(uint64(m.NewChoice))
+	}
+	l = m.ChangedAt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *VoteCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CommitHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.SubmittedAt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GroupMemberActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.LastActive.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GroupExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Group.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.GroupAccounts) > 0 {
+		for _, e := range m.GroupAccounts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = m.Proposal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GroupAccountSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.WindowStart.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &types.Timestamp{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightDecay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightDecay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightDecay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredKeys = append(m.RequiredKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoDampingFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoDampingFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinYesToNoRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinYesToNoRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoFractionOfCast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoFractionOfCast = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDecisiveParticipation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDecisiveParticipation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVetoVoters", wireType)
+			}
+			m.MinVetoVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVetoVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeAbstainFromBase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeAbstainFromBase = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluralityDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluralityDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluralityDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TieBreak", wireType)
+			}
+			m.TieBreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TieBreak |= TieBreak(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BicameralDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BicameralDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BicameralDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.First.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Second.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvictionDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvictionDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvictionDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrowthPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GrowthPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxMultiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chamber) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chamber: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chamber: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= ID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleMultipliers = append(m.RoleMultipliers, RoleMultiplier{})
+			if err := m.RoleMultipliers[len(m.RoleMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeOnRemoval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevokeOnRemoval = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeightDecay == nil {
+				m.WeightDecay = &WeightDecay{}
+			}
+			if err := m.WeightDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataHash = append(m.MetadataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetadataHash == nil {
+				m.MetadataHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneVotes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PruneVotes = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seats", wireType)
+			}
+			m.Seats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalSchema == nil {
+				m.ProposalSchema = &ProposalSchema{}
+			}
+			if err := m.ProposalSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedWeights", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NormalizedWeights = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedTotal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedTotal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= ID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupInvitation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupInvitation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupInvitation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= ID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InvitedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupAccountInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= ID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types2.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendPeriod == nil {
+				m.SpendPeriod = &types.Duration{}
+			}
+			if err := m.SpendPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmittedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
+			}
+			m.GroupVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccountVersion", wireType)
+			}
+			m.GroupAccountVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupAccountVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Proposal_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= Proposal_Result(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
+			}
+			m.ExecutorResult = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorResult |= Proposal_ExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingStartTime == nil {
+				m.VotingStartTime = &types.Timestamp{}
+			}
+			if err := m.VotingStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionSet == nil {
+				m.OptionSet = &OptionSet{}
+			}
+			if err := m.OptionSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PausedAt == nil {
+				m.PausedAt = &types.Timestamp{}
+			}
+			if err := m.PausedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PausedDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataHash = append(m.MetadataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetadataHash == nil {
+				m.MetadataHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedVoters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedVoters = append(m.PrunedVoters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FastTrack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FastTrack = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionRetries", wireType)
+			}
+			m.ExecutionRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberUpdates = append(m.MemberUpdates, Member{})
+			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GroupAccount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Proposers) > 0 {
-		for _, s := range m.Proposers {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+func (m *OptionSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	l = m.SubmittedAt.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.GroupVersion != 0 {
-		n += 1 + sovTypes(uint64(m.GroupVersion))
-	}
-	if m.GroupAccountVersion != 0 {
-		n += 1 + sovTypes(uint64(m.GroupAccountVersion))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
-	}
-	if m.Result != 0 {
-		n += 1 + sovTypes(uint64(m.Result))
-	}
-	l = m.VoteState.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.Timeout.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.ExecutorResult != 0 {
-		n += 1 + sovTypes(uint64(m.ExecutorResult))
-	}
-	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *Tally) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.YesCount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.NoCount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.AbstainCount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.VetoCount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovTypes(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Choice != 0 {
-		n += 1 + sovTypes(uint64(m.Choice))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.SubmittedAt.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *Tally) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1525,28 +8246,124 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1574,11 +8391,11 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.VetoCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionCounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1606,13 +8423,13 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.OptionCounts = append(m.OptionCounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleTallies", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1622,26 +8439,45 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
+			m.RoleTallies = append(m.RoleTallies, RoleTally{})
+			if err := m.RoleTallies[len(m.RoleTallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoVoters", wireType)
+			}
+			m.VetoVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VetoVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1666,7 +8502,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *RoleTally) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1689,15 +8525,15 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleTally: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleTally: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1725,11 +8561,11 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1756,7 +8592,7 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1784,7 +8620,7 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupInfo) Unmarshal(dAtA []byte) error {
+func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1807,17 +8643,17 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.GroupId = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1827,14 +8663,14 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= ID(b&0x7F) << shift
+				m.ProposalId |= ProposalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1862,9 +8698,28 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
+			}
+			m.Choice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Choice |= Choice(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -1898,11 +8753,11 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				m.Metadata = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1912,16 +8767,30 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmittedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1931,82 +8800,31 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalWeight = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupMember) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMember: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			m.GroupId = 0
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2016,16 +8834,16 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= ID(b&0x7F) << shift
+				m.Option |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2035,27 +8853,23 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2081,7 +8895,7 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
+func (m *VoteChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,49 +8918,17 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupAccountInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.GroupId = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2156,14 +8938,14 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= ID(b&0x7F) << shift
+				m.ProposalId |= ProposalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2191,13 +8973,13 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldChoice", wireType)
 			}
-			var byteLen int
+			m.OldChoice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2207,31 +8989,16 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.OldChoice |= Choice(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChoice", wireType)
 			}
-			m.Version = 0
+			m.NewChoice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2241,14 +9008,14 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.NewChoice |= Choice(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2275,10 +9042,7 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionPolicy == nil {
-				m.DecisionPolicy = &types1.Any{}
-			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2306,7 +9070,7 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *VoteCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2329,17 +9093,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2349,29 +9113,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= ProposalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2381,31 +9132,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2415,23 +9164,25 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			m.CommitHash = append(m.CommitHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommitHash == nil {
+				m.CommitHash = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2466,87 +9217,64 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
-			}
-			m.GroupVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccountVersion", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.GroupAccountVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupAccountVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= Proposal_Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupMemberActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= Proposal_Result(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteState", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupMemberActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupMemberActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2556,30 +9284,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupId |= ID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VoteState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2589,47 +9303,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
-			}
-			m.ExecutorResult = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecutorResult |= Proposal_ExecutorResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2656,8 +9350,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msgs = append(m.Msgs, &types1.Any{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastActive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2685,7 +9378,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tally) Unmarshal(dAtA []byte) error {
+func (m *GroupExport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2708,17 +9401,17 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tally: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupExport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tally: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupExport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2728,29 +9421,30 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2760,29 +9454,31 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, GroupMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2792,29 +9488,31 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			m.GroupAccounts = append(m.GroupAccounts, GroupAccountInfo{})
+			if err := m.GroupAccounts[len(m.GroupAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2824,23 +9522,25 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoCount = string(dAtA[iNdEx:postIndex])
+			m.Proposals = append(m.Proposals, ProposalExport{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2866,7 +9566,7 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *ProposalExport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2889,10 +9589,10 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalExport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalExport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2916,9 +9616,9 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2928,29 +9628,30 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			m.Choice = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2960,16 +9661,84 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Choice |= Choice(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupAccountSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupAccountSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupAccountSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2979,29 +9748,60 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
+			m.GroupAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3028,7 +9828,8 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SubmittedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Spent = append(m.Spent, types2.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,6 +1,7 @@
 package group
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -29,7 +30,7 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcTally:          Tally{YesCount: "2"},
 			srcTotalPower:     "3",
 			srcVotingDuration: time.Millisecond,
-			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
 		},
 		"accept when yes count equal to threshold": {
 			srcPolicy: ThresholdDecisionPolicy{
@@ -39,7 +40,17 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcTally:          Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
 			srcTotalPower:     "3",
 			srcVotingDuration: time.Millisecond,
-			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"not final before voting started": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "1",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "3",
+			srcVotingDuration: -time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
 		},
 		"reject when yes count lower to threshold": {
 			srcPolicy: ThresholdDecisionPolicy{
@@ -59,7 +70,7 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcTally:          Tally{YesCount: "0", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
 			srcTotalPower:     "3",
 			srcVotingDuration: time.Millisecond,
-			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonThresholdNotReachable},
 		},
 		"expired when on timeout": {
 			srcPolicy: ThresholdDecisionPolicy{
@@ -69,7 +80,7 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcTally:          Tally{YesCount: "2"},
 			srcTotalPower:     "3",
 			srcVotingDuration: time.Second,
-			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonExpired},
 		},
 		"expired when after timeout": {
 			srcPolicy: ThresholdDecisionPolicy{
@@ -79,7 +90,7 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcTally:          Tally{YesCount: "2"},
 			srcTotalPower:     "3",
 			srcVotingDuration: time.Second + time.Nanosecond,
-			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonExpired},
 		},
 		"abstain has no impact": {
 			srcPolicy: ThresholdDecisionPolicy{
@@ -101,6 +112,453 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcVotingDuration: time.Millisecond,
 			expResult:         DecisionPolicyResult{Allow: false, Final: false},
 		},
+		"veto counts toward quorum": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Timeout:   proto.Duration{Seconds: 1},
+				Quorum:    "3",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"abstain counts toward quorum": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "4",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "2", VetoCount: "0"},
+			srcTotalPower:     "6",
+			srcVotingDuration: time.Second - time.Nanosecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"abstain doesn't count toward threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "4",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "1", NoCount: "0", AbstainCount: "3", VetoCount: "0"},
+			srcTotalPower:     "6",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"not final when threshold reached but quorum not": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Timeout:   proto.Duration{Seconds: 1},
+				Quorum:    "3",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final when quorum can't be reached": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Timeout:   proto.Duration{Seconds: 1},
+				Quorum:    "5",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonQuorumNotReachable},
+		},
+		"accept when quorum reached and veto below veto threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "2",
+				Timeout:       proto.Duration{Seconds: 1},
+				Quorum:        "3",
+				VetoThreshold: "2",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"accept waits while undecided power can reach veto threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "2",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "2",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"veto after threshold reached rejects": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "2",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "2",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "2", VetoVoters: 2},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonVetoed},
+		},
+		"accept when undecided power can't reach veto threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "2",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "2",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"waiting accept stands on timeout": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "2",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "2",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "1", VetoVoters: 1},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"veto reject beats threshold accept": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "2",
+				Timeout:       proto.Duration{Seconds: 1},
+				Quorum:        "3",
+				VetoThreshold: "1",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonVetoed},
+		},
+		"single veto voter below min veto voters": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "3",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "2",
+				MinVetoVoters: 2,
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "5", VetoVoters: 1},
+			srcTotalPower:     "8",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"veto with min veto voters": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:     "3",
+				Timeout:       proto.Duration{Seconds: 1},
+				VetoThreshold: "2",
+				MinVetoVoters: 2,
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "2", VetoVoters: 2},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonVetoed},
+		},
+		"moderate veto damps but doesn't eliminate passing yes": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:         "2",
+				Timeout:           proto.Duration{Seconds: 1},
+				VetoDampingFactor: "0.5",
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"accept waits while undecided power can damp yes below threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:         "2",
+				Timeout:           proto.Duration{Seconds: 1},
+				VetoDampingFactor: "0.5",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"veto after threshold reached damps yes below threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:         "2",
+				Timeout:           proto.Duration{Seconds: 1},
+				VetoDampingFactor: "0.5",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "2"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonThresholdNotReachable},
+		},
+		"damped accept stands on timeout": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:         "2",
+				Timeout:           proto.Duration{Seconds: 1},
+				VetoDampingFactor: "0.5",
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "6",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"heavy veto flips outcome": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:         "2",
+				Timeout:           proto.Duration{Seconds: 1},
+				VetoDampingFactor: "0.5",
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "3"},
+			srcTotalPower:     "6",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonThresholdNotReachable},
+		},
+		"not final when undecided power can outweigh veto damping": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:         "2",
+				Timeout:           proto.Duration{Seconds: 1},
+				VetoDampingFactor: "0.5",
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "3"},
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"veto damping floored at zero": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:         "1",
+				Timeout:           proto.Duration{Seconds: 1},
+				VetoDampingFactor: "2",
+			},
+			srcTally:          Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "2",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonThresholdNotReachable},
+		},
+		"zero veto damping factor is same as none": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:         "2",
+				Timeout:           proto.Duration{Seconds: 1},
+				VetoDampingFactor: "0",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "2"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"reject when yes barely beats no but fails the yes to no ratio": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:       "2",
+				Timeout:         proto.Duration{Seconds: 1},
+				MinYesToNoRatio: "2",
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonYesToNoRatioNotReached},
+		},
+		"not final when yes to no ratio can still be reached": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:       "2",
+				Timeout:         proto.Duration{Seconds: 1},
+				MinYesToNoRatio: "2",
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "6",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"accept when yes comfortably exceeds the yes to no ratio": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:       "2",
+				Timeout:         proto.Duration{Seconds: 1},
+				MinYesToNoRatio: "2",
+			},
+			srcTally:          Tally{YesCount: "4", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"veto fraction of a small cast set blocks": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:          "2",
+				Timeout:            proto.Duration{Seconds: 1},
+				VetoFractionOfCast: "0.3",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"veto fraction of a small cast set rejects when all power voted": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:          "2",
+				Timeout:            proto.Duration{Seconds: 1},
+				VetoFractionOfCast: "0.3",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonVetoFractionExceeded},
+		},
+		"veto fraction of a large cast set doesn't block": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:          "2",
+				Timeout:            proto.Duration{Seconds: 1},
+				VetoFractionOfCast: "0.3",
+			},
+			srcTally:          Tally{YesCount: "8", NoCount: "1", AbstainCount: "0", VetoCount: "1"},
+			srcTotalPower:     "11",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"accept waits while undecided power can exceed veto fraction": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:          "2",
+				Timeout:            proto.Duration{Seconds: 1},
+				VetoFractionOfCast: "0.3",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"vetoes after threshold reached exceed veto fraction": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:          "2",
+				Timeout:            proto.Duration{Seconds: 1},
+				VetoFractionOfCast: "0.3",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "2"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonVetoFractionExceeded},
+		},
+		"veto fraction accept stands on timeout": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:          "2",
+				Timeout:            proto.Duration{Seconds: 1},
+				VetoFractionOfCast: "0.3",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"veto fraction met exactly doesn't block": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:          "2",
+				Timeout:            proto.Duration{Seconds: 1},
+				VetoFractionOfCast: "0.5",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "2"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"veto fraction without votes cast": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:          "2",
+				Timeout:            proto.Duration{Seconds: 1},
+				VetoFractionOfCast: "0.3",
+			},
+			srcTally:          Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"quorum met by abstain votes without decisive participation": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:                "2",
+				Quorum:                   "5",
+				Timeout:                  proto.Duration{Seconds: 1},
+				MinDecisiveParticipation: "0.3",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "4", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"accept with quorum and decisive participation": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:                "2",
+				Quorum:                   "5",
+				Timeout:                  proto.Duration{Seconds: 1},
+				MinDecisiveParticipation: "0.3",
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "3", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"decisive participation without quorum": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:                "2",
+				Timeout:                  proto.Duration{Seconds: 1},
+				MinDecisiveParticipation: "0.5",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject when decisive participation can't be reached": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:                "2",
+				Quorum:                   "5",
+				Timeout:                  proto.Duration{Seconds: 1},
+				MinDecisiveParticipation: "0.5",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "6", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonDecisiveParticipationNotReachable},
+		},
+		"accept with yes to no ratio and no no votes": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:       "2",
+				Timeout:         proto.Duration{Seconds: 1},
+				MinYesToNoRatio: "2",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
+		"accept waits while undecided power can fail the yes to no ratio": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:       "2",
+				Timeout:         proto.Duration{Seconds: 1},
+				MinYesToNoRatio: "2",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"no votes after threshold reached fail the yes to no ratio": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:       "2",
+				Timeout:         proto.Duration{Seconds: 1},
+				MinYesToNoRatio: "2",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "3", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonYesToNoRatioNotReached},
+		},
+		"yes to no ratio accept stands on timeout": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold:       "2",
+				Timeout:         proto.Duration{Seconds: 1},
+				MinYesToNoRatio: "2",
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonThresholdReached},
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -115,85 +573,1180 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 	}
 }
 
-func TestThresholdDecisionPolicyValidate(t *testing.T) {
+func TestDeriveStatus(t *testing.T) {
+	policy := &ThresholdDecisionPolicy{
+		Threshold:     "2",
+		Timeout:       proto.Duration{Seconds: 1},
+		VetoThreshold: "2",
+	}
 	specs := map[string]struct {
-		src    ThresholdDecisionPolicy
-		expErr bool
+		srcTally   Tally
+		srcElapsed time.Duration
+		expStatus  Proposal_Status
+		expResult  Proposal_Result
+		expReason  string
 	}{
-		"all good": {src: ThresholdDecisionPolicy{
-			Threshold: "1",
-			Timeout:   proto.Duration{Seconds: 1},
-		}},
-		"greater than group total weight": {
-			src: ThresholdDecisionPolicy{
-				Threshold: "2",
-				Timeout:   proto.Duration{Seconds: 1},
-			},
-			expErr: true,
+		"open below threshold": {
+			srcTally:   Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcElapsed: time.Millisecond,
+			expStatus:  ProposalStatusSubmitted,
+			expResult:  ProposalResultUnfinalized,
+		},
+		"open before voting started": {
+			srcTally:   Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcElapsed: -time.Millisecond,
+			expStatus:  ProposalStatusSubmitted,
+			expResult:  ProposalResultUnfinalized,
+		},
+		"accepted on crossing threshold": {
+			srcTally:   Tally{YesCount: "3", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			srcElapsed: time.Millisecond,
+			expStatus:  ProposalStatusClosed,
+			expResult:  ProposalResultAccepted,
+			expReason:  ResultReasonThresholdReached,
+		},
+		"open while remaining votes can still veto": {
+			srcTally:   Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcElapsed: time.Millisecond,
+			expStatus:  ProposalStatusSubmitted,
+			expResult:  ProposalResultUnfinalized,
+		},
+		"accepted on timeout": {
+			srcTally:   Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcElapsed: time.Second,
+			expStatus:  ProposalStatusClosed,
+			expResult:  ProposalResultAccepted,
+			expReason:  ResultReasonThresholdReached,
+		},
+		"rejected when remaining votes can't cross threshold": {
+			srcTally:   Tally{YesCount: "0", NoCount: "3", AbstainCount: "0", VetoCount: "0"},
+			srcElapsed: time.Millisecond,
+			expStatus:  ProposalStatusClosed,
+			expResult:  ProposalResultRejected,
+			expReason:  ResultReasonThresholdNotReachable,
+		},
+		"rejected by veto": {
+			srcTally:   Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "2"},
+			srcElapsed: time.Millisecond,
+			expStatus:  ProposalStatusClosed,
+			expResult:  ProposalResultRejected,
+			expReason:  ResultReasonVetoed,
+		},
+		"expired on timeout": {
+			srcTally:   Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcElapsed: time.Second,
+			expStatus:  ProposalStatusClosed,
+			expResult:  ProposalResultRejected,
+			expReason:  ResultReasonExpired,
+		},
+		"expired after timeout": {
+			srcTally:   Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcElapsed: time.Hour,
+			expStatus:  ProposalStatusClosed,
+			expResult:  ProposalResultRejected,
+			expReason:  ResultReasonExpired,
 		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			err := spec.src.Validate(GroupInfo{TotalWeight: "1"})
-			assert.Equal(t, spec.expErr, err != nil, err)
+			status, result, reason, err := DeriveStatus(spec.srcTally, policy, "4", spec.srcElapsed)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expStatus, status)
+			assert.Equal(t, spec.expResult, result)
+			assert.Equal(t, spec.expReason, reason)
 		})
 	}
+
+	_, _, _, err := DeriveStatus(Tally{YesCount: "-1"}, policy, "4", time.Millisecond)
+	require.Error(t, err)
+	_, _, _, err = DeriveStatus(Tally{}, nil, "4", time.Millisecond)
+	require.Error(t, err)
 }
 
-func TestThresholdDecisionPolicyValidateBasic(t *testing.T) {
-	maxSeconds := int64(10000 * 365.25 * 24 * 60 * 60)
+func TestThresholdDecisionPolicyDeadline(t *testing.T) {
+	submitTime := time.Date(2021, 3, 1, 12, 0, 0, 999999999, time.UTC)
 	specs := map[string]struct {
-		src    ThresholdDecisionPolicy
-		expErr bool
+		timeout     proto.Duration
+		expDeadline time.Time
 	}{
-		"all good": {src: ThresholdDecisionPolicy{
-			Threshold: "1",
-			Timeout:   proto.Duration{Seconds: 1},
-		}},
-		"threshold missing": {src: ThresholdDecisionPolicy{
-			Timeout: proto.Duration{Seconds: 1},
-		},
-			expErr: true,
-		},
-		"timeout missing": {src: ThresholdDecisionPolicy{
-			Threshold: "1",
+		"seconds and nanos": {
+			timeout:     proto.Duration{Seconds: 1, Nanos: 1},
+			expDeadline: time.Date(2021, 3, 1, 12, 0, 2, 0, time.UTC),
 		},
-			expErr: true,
-		},
-		"duration out of limit": {src: ThresholdDecisionPolicy{
-			Threshold: "1",
-			Timeout:   proto.Duration{Seconds: maxSeconds + 1},
-		},
-			expErr: true,
+		"nanos only": {
+			timeout:     proto.Duration{Nanos: 500},
+			expDeadline: time.Date(2021, 3, 1, 12, 0, 1, 499, time.UTC),
 		},
-		"no negative thresholds": {src: ThresholdDecisionPolicy{
-			Threshold: "-1",
-			Timeout:   proto.Duration{Seconds: 1},
+		"whole days": {
+			timeout:     proto.Duration{Seconds: 2 * 24 * 60 * 60},
+			expDeadline: time.Date(2021, 3, 3, 12, 0, 0, 999999999, time.UTC),
 		},
-			expErr: true,
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			policy := ThresholdDecisionPolicy{Threshold: "1", Timeout: spec.timeout}
+			assert.True(t, spec.expDeadline.Equal(policy.Deadline(submitTime)), policy.Deadline(submitTime))
+		})
+	}
+}
+
+func TestNewThresholdDecisionPolicyFromDuration(t *testing.T) {
+	day, err := time.ParseDuration("24h")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		threshold  string
+		timeout    time.Duration
+		expTimeout proto.Duration
+		expErr     bool
+	}{
+		"whole day": {
+			threshold:  "1",
+			timeout:    day,
+			expTimeout: proto.Duration{Seconds: 24 * 60 * 60},
+		},
+		"sub-second": {
+			threshold:  "1",
+			timeout:    1500 * time.Millisecond,
+			expTimeout: proto.Duration{Seconds: 1, Nanos: 500000000},
+		},
+		"nanos only": {
+			threshold:  "1",
+			timeout:    250 * time.Nanosecond,
+			expTimeout: proto.Duration{Nanos: 250},
+		},
+		"zero timeout": {
+			threshold: "1",
+			expErr:    true,
 		},
-		"no empty thresholds": {src: ThresholdDecisionPolicy{
-			Timeout: proto.Duration{Seconds: 1},
+		"negative timeout": {
+			threshold: "1",
+			timeout:   -time.Second,
+			expErr:    true,
 		},
-			expErr: true,
+		"invalid threshold": {
+			threshold: "0",
+			timeout:   day,
+			expErr:    true,
 		},
-		"no zero thresholds": {src: ThresholdDecisionPolicy{
-			Timeout:   proto.Duration{Seconds: 1},
-			Threshold: "0",
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			policy, err := NewThresholdDecisionPolicyFromDuration(spec.threshold, spec.timeout)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.threshold, policy.Threshold)
+			assert.Equal(t, spec.expTimeout, policy.Timeout)
+		})
+	}
+}
+
+func TestMaxAchievableYes(t *testing.T) {
+	specs := map[string]struct {
+		srcTally      Tally
+		srcTotalPower string
+		expMaxYes     string
+		expErr        bool
+	}{
+		"no votes": {
+			srcTally:      Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			expMaxYes:     "3",
+		},
+		"yes plus uncast power": {
+			srcTally:      Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			expMaxYes:     "3",
+		},
+		"no, abstain and veto can't turn yes": {
+			srcTally:      Tally{YesCount: "1", NoCount: "1", AbstainCount: "0.5", VetoCount: "1"},
+			srcTotalPower: "4",
+			expMaxYes:     "1.5",
+		},
+		"all power cast": {
+			srcTally:      Tally{YesCount: "1", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			expMaxYes:     "1",
+		},
+		"more votes than total power": {
+			srcTally:      Tally{YesCount: "2", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			expErr:        true,
+		},
+		"invalid total power": {
+			srcTally:      Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "-1",
+			expErr:        true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			maxYes, err := MaxAchievableYes(spec.srcTally, spec.srcTotalPower)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expMaxYes, maxYes)
+		})
+	}
+}
+
+func TestUncastWeight(t *testing.T) {
+	specs := map[string]struct {
+		srcTally      Tally
+		srcTotalPower string
+		expUncast     string
+		expErr        error
+	}{
+		"no votes": {
+			srcTally:      Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			expUncast:     "3",
+		},
+		"partial participation": {
+			srcTally:      Tally{YesCount: "1", NoCount: "0.5", AbstainCount: "0.25", VetoCount: "1"},
+			srcTotalPower: "5",
+			expUncast:     "2.25",
+		},
+		"option votes are cast": {
+			srcTally:      Tally{YesCount: "0", NoCount: "0", AbstainCount: "1", VetoCount: "0", OptionCounts: []string{"1", "2"}},
+			srcTotalPower: "5",
+			expUncast:     "1",
+		},
+		"all power cast": {
+			srcTally:      Tally{YesCount: "1", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			expUncast:     "0",
+		},
+		"counts exceed total power": {
+			srcTally:      Tally{YesCount: "2", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower: "3",
+			expErr:        ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			uncast, err := UncastWeight(spec.srcTally, spec.srcTotalPower)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, spec.expErr))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expUncast, uncast)
+		})
+	}
+}
+
+func TestThresholdDecisionPolicyCanStillPass(t *testing.T) {
+	policy := ThresholdDecisionPolicy{Threshold: "2", Timeout: proto.Duration{Seconds: 1}}
+	specs := map[string]struct {
+		srcTally   Tally
+		expCanPass bool
+	}{
+		"remaining votes can't cross threshold": {
+			srcTally: Tally{YesCount: "0", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
+		},
+		"abstain and veto can't cross threshold": {
+			srcTally: Tally{YesCount: "0", NoCount: "0", AbstainCount: "1", VetoCount: "1"},
+		},
+		"uncast power can cross threshold": {
+			srcTally:   Tally{YesCount: "0", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			expCanPass: true,
+		},
+		"yes and uncast power reach threshold exactly": {
+			srcTally:   Tally{YesCount: "1", NoCount: "0", AbstainCount: "1", VetoCount: "0"},
+			expCanPass: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			canPass, err := policy.CanStillPass(spec.srcTally, "3")
+			require.NoError(t, err)
+			require.Equal(t, spec.expCanPass, canPass)
+
+			// Allow rejects as final exactly when the proposal can't pass anymore.
+			res, err := policy.Allow(spec.srcTally, "3", time.Millisecond)
+			require.NoError(t, err)
+			require.False(t, res.Allow)
+			require.Equal(t, !spec.expCanPass, res.Final)
+		})
+	}
+}
+
+func TestThresholdDecisionPolicyValidate(t *testing.T) {
+	specs := map[string]struct {
+		src         ThresholdDecisionPolicy
+		totalWeight string
+		expErr      bool
+	}{
+		"all good": {src: ThresholdDecisionPolicy{
+			Threshold: "1",
+			Timeout:   proto.Duration{Seconds: 1},
+		}},
+		"greater than group total weight": {
+			src: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			expErr: true,
 		},
+		"quorum greater than group total weight": {
+			src: ThresholdDecisionPolicy{
+				Threshold: "1",
+				Timeout:   proto.Duration{Seconds: 1},
+				Quorum:    "2",
+			},
 			expErr: true,
 		},
+		"fractional threshold equal to fractional total weight": {
+			src: ThresholdDecisionPolicy{
+				Threshold: "1.5",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			totalWeight: "1.5",
+		},
+		"fractional threshold equal to total weight with different representation": {
+			src: ThresholdDecisionPolicy{
+				Threshold: "1.50",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			totalWeight: "1.5",
+		},
+		"fractional threshold below fractional total weight": {
+			src: ThresholdDecisionPolicy{
+				Threshold: "1.75",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			totalWeight: "1.751",
+		},
+		"fractional threshold greater than fractional total weight": {
+			src: ThresholdDecisionPolicy{
+				Threshold: "1.51",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			totalWeight: "1.5",
+			expErr:      true,
+		},
+		"fractional quorum greater than fractional total weight": {
+			src: ThresholdDecisionPolicy{
+				Threshold: "1",
+				Timeout:   proto.Duration{Seconds: 1},
+				Quorum:    "1.5000001",
+			},
+			totalWeight: "1.5",
+			expErr:      true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			totalWeight := spec.totalWeight
+			if totalWeight == "" {
+				totalWeight = "1"
+			}
+			err := spec.src.Validate(GroupInfo{TotalWeight: totalWeight})
+			assert.Equal(t, spec.expErr, err != nil, err)
+		})
+	}
+}
+
+func TestThresholdDecisionPolicyValidateBasic(t *testing.T) {
+	maxSeconds := int64(10000 * 365.25 * 24 * 60 * 60)
+	specs := map[string]struct {
+		src    ThresholdDecisionPolicy
+		expErr error
+	}{
+		"all good": {src: ThresholdDecisionPolicy{
+			Threshold: "1",
+			Timeout:   proto.Duration{Seconds: 1},
+		}},
+		"threshold missing": {src: ThresholdDecisionPolicy{
+			Timeout: proto.Duration{Seconds: 1},
+		},
+			expErr: ErrInvalidThreshold,
+		},
+		"timeout missing": {src: ThresholdDecisionPolicy{
+			Threshold: "1",
+		},
+			expErr: ErrTimeoutOutOfBounds,
+		},
+		"duration out of limit": {src: ThresholdDecisionPolicy{
+			Threshold: "1",
+			Timeout:   proto.Duration{Seconds: maxSeconds + 1},
+		},
+			expErr: ErrTimeoutOutOfBounds,
+		},
+		"no negative thresholds": {src: ThresholdDecisionPolicy{
+			Threshold: "-1",
+			Timeout:   proto.Duration{Seconds: 1},
+		},
+			expErr: ErrInvalidThreshold,
+		},
+		"no empty thresholds": {src: ThresholdDecisionPolicy{
+			Timeout: proto.Duration{Seconds: 1},
+		},
+			expErr: ErrInvalidThreshold,
+		},
+		"no zero thresholds": {src: ThresholdDecisionPolicy{
+			Timeout:   proto.Duration{Seconds: 1},
+			Threshold: "0",
+		},
+			expErr: ErrInvalidThreshold,
+		},
 		"no negative timeouts": {src: ThresholdDecisionPolicy{
 			Threshold: "1",
 			Timeout:   proto.Duration{Seconds: -1},
+		},
+			expErr: ErrTimeoutOutOfBounds,
+		},
+		"with quorum and veto threshold": {src: ThresholdDecisionPolicy{
+			Threshold:     "1",
+			Timeout:       proto.Duration{Seconds: 1},
+			Quorum:        "2",
+			VetoThreshold: "1",
+		}},
+		"no zero quorum": {src: ThresholdDecisionPolicy{
+			Threshold: "1",
+			Timeout:   proto.Duration{Seconds: 1},
+			Quorum:    "0",
+		},
+			expErr: ErrInvalidThreshold,
+		},
+		"no negative veto threshold": {src: ThresholdDecisionPolicy{
+			Threshold:     "1",
+			Timeout:       proto.Duration{Seconds: 1},
+			VetoThreshold: "-1",
+		},
+			expErr: ErrInvalidThreshold,
+		},
+		"with veto damping factor": {src: ThresholdDecisionPolicy{
+			Threshold:         "1",
+			Timeout:           proto.Duration{Seconds: 1},
+			VetoDampingFactor: "0.5",
+		}},
+		"zero veto damping factor": {src: ThresholdDecisionPolicy{
+			Threshold:         "1",
+			Timeout:           proto.Duration{Seconds: 1},
+			VetoDampingFactor: "0",
+		}},
+		"no negative veto damping factor": {src: ThresholdDecisionPolicy{
+			Threshold:         "1",
+			Timeout:           proto.Duration{Seconds: 1},
+			VetoDampingFactor: "-0.5",
+		},
+			expErr: ErrInvalid,
+		},
+		"with yes to no ratio": {src: ThresholdDecisionPolicy{
+			Threshold:       "1",
+			Timeout:         proto.Duration{Seconds: 1},
+			MinYesToNoRatio: "1.5",
+		}},
+		"no zero yes to no ratio": {src: ThresholdDecisionPolicy{
+			Threshold:       "1",
+			Timeout:         proto.Duration{Seconds: 1},
+			MinYesToNoRatio: "0",
+		},
+			expErr: ErrInvalid,
+		},
+		"with veto fraction of cast": {src: ThresholdDecisionPolicy{
+			Threshold:          "1",
+			Timeout:            proto.Duration{Seconds: 1},
+			VetoFractionOfCast: "1",
+		}},
+		"no zero veto fraction of cast": {src: ThresholdDecisionPolicy{
+			Threshold:          "1",
+			Timeout:            proto.Duration{Seconds: 1},
+			VetoFractionOfCast: "0",
+		},
+			expErr: ErrInvalid,
+		},
+		"with min veto voters": {src: ThresholdDecisionPolicy{
+			Threshold:     "1",
+			Timeout:       proto.Duration{Seconds: 1},
+			VetoThreshold: "1",
+			MinVetoVoters: 2,
+		}},
+		"no min veto voters without veto threshold": {src: ThresholdDecisionPolicy{
+			Threshold:     "1",
+			Timeout:       proto.Duration{Seconds: 1},
+			MinVetoVoters: 2,
+		},
+			expErr: ErrInvalid,
+		},
+		"no veto fraction of cast greater than 1": {src: ThresholdDecisionPolicy{
+			Threshold:          "1",
+			Timeout:            proto.Duration{Seconds: 1},
+			VetoFractionOfCast: "1.1",
+		},
+			expErr: ErrInvalid,
+		},
+		"with min decisive participation": {src: ThresholdDecisionPolicy{
+			Threshold:                "1",
+			Timeout:                  proto.Duration{Seconds: 1},
+			MinDecisiveParticipation: "1",
+		}},
+		"no zero min decisive participation": {src: ThresholdDecisionPolicy{
+			Threshold:                "1",
+			Timeout:                  proto.Duration{Seconds: 1},
+			MinDecisiveParticipation: "0",
+		},
+			expErr: ErrInvalid,
+		},
+		"no min decisive participation greater than 1": {src: ThresholdDecisionPolicy{
+			Threshold:                "1",
+			Timeout:                  proto.Duration{Seconds: 1},
+			MinDecisiveParticipation: "1.1",
+		},
+			expErr: ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, spec.expErr), err)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	specs := map[string]struct {
+		srcPolicy         PercentageDecisionPolicy
+		srcTally          Tally
+		srcTotalPower     string
+		srcVotingDuration time.Duration
+		expResult         DecisionPolicyResult
+		expErr            error
+	}{
+		"accept when yes share of total power reaches percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.5",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "4",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonPercentageReached},
+		},
+		"abstains dilute yes share by default": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.6",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "1", AbstainCount: "2", VetoCount: "0"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonPercentageNotReachable},
+		},
+		"abstains don't lower yes share when excluded from base": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage:             "0.6",
+				Timeout:                proto.Duration{Seconds: 1},
+				ExcludeAbstainFromBase: true,
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "1", AbstainCount: "2", VetoCount: "0"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonPercentageReached},
+		},
+		"veto counts as decisive when abstains are excluded": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage:             "0.6",
+				Timeout:                proto.Duration{Seconds: 1},
+				ExcludeAbstainFromBase: true,
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "1", VetoCount: "2"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonPercentageNotReachable},
+		},
+		"not final while undecided power can change the decisive share": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage:             "0.6",
+				Timeout:                proto.Duration{Seconds: 1},
+				ExcludeAbstainFromBase: true,
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"decided on cast votes after timeout": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage:             "0.6",
+				Timeout:                proto.Duration{Seconds: 1},
+				ExcludeAbstainFromBase: true,
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "5",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonPercentageReached},
+		},
+		"not decided with only abstains before timeout": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage:             "0.5",
+				Timeout:                proto.Duration{Seconds: 1},
+				ExcludeAbstainFromBase: true,
+			},
+			srcTally:          Tally{YesCount: "0", NoCount: "0", AbstainCount: "3", VetoCount: "0"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject with only abstains after timeout": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage:             "0.5",
+				Timeout:                proto.Duration{Seconds: 1},
+				ExcludeAbstainFromBase: true,
+			},
+			srcTally:          Tally{YesCount: "0", NoCount: "0", AbstainCount: "3", VetoCount: "0"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonPercentageNotReachable},
+		},
+		"not final before voting started": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.5",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "3",
+			srcVotingDuration: -time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"invalid tally": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.5",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "-1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Millisecond,
+			expErr:            ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := spec.srcPolicy.Allow(spec.srcTally, spec.srcTotalPower, spec.srcVotingDuration)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, res)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicyValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    PercentageDecisionPolicy
+		expErr bool
+	}{
+		"all good": {src: PercentageDecisionPolicy{
+			Percentage: "0.5",
+			Timeout:    proto.Duration{Seconds: 1},
+		}},
+		"full percentage": {src: PercentageDecisionPolicy{
+			Percentage: "1",
+			Timeout:    proto.Duration{Seconds: 1},
+		}},
+		"percentage missing": {src: PercentageDecisionPolicy{
+			Timeout: proto.Duration{Seconds: 1},
 		},
 			expErr: true,
 		},
+		"no zero percentage": {src: PercentageDecisionPolicy{
+			Percentage: "0",
+			Timeout:    proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no percentage above 1": {src: PercentageDecisionPolicy{
+			Percentage: "1.1",
+			Timeout:    proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"timeout missing": {src: PercentageDecisionPolicy{
+			Percentage: "0.5",
+		},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			assert.Equal(t, spec.expErr, err != nil, err)
+		})
+	}
+}
+
+func TestPluralityDecisionPolicy(t *testing.T) {
+	optionTally := func(abstain string, counts ...string) Tally {
+		return Tally{YesCount: "0", NoCount: "0", AbstainCount: abstain, VetoCount: "0", OptionCounts: counts}
+	}
+	specs := map[string]struct {
+		srcPolicy         PluralityDecisionPolicy
+		srcTally          Tally
+		srcTotalPower     string
+		srcVotingDuration time.Duration
+		expResult         DecisionPolicyResult
+		expErr            error
+	}{
+		"accept plurality when all voted": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "2", "3", "2"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonOptionSelected},
+		},
+		"accept early when undecided power can't change the leader": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "1", "4", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonOptionSelected},
+		},
+		"not final while undecided power can change the leader": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "2", "3", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject tie when all voted": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("1", "3", "3", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonNoOptionSelected},
+		},
+		"reject two-way tie by default": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "1", "3", "3"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonNoOptionSelected},
+		},
+		"reject two-way tie with reject tie break": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}, TieBreak: TieBreak_TIE_BREAK_REJECT},
+			srcTally:          optionTally("0", "1", "3", "3"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonNoOptionSelected},
+		},
+		"accept two-way tie with lowest index tie break": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}, TieBreak: TieBreak_TIE_BREAK_LOWEST_INDEX},
+			srcTally:          optionTally("0", "1", "3", "3"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonOptionSelected},
+		},
+		"reject without votes with lowest index tie break": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}, TieBreak: TieBreak_TIE_BREAK_LOWEST_INDEX},
+			srcTally:          optionTally("0", "0", "0", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonNoOptionSelected},
+		},
+		"accept leader at timeout": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "1", "2", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonOptionSelected},
+		},
+		"reject without votes at timeout": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "0", "0", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonNoOptionSelected},
+		},
+		"abstain counts toward quorum": {
+			srcPolicy:         PluralityDecisionPolicy{Quorum: "4", Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("2", "0", "2", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonOptionSelected},
+		},
+		"reject when quorum not reached at timeout": {
+			srcPolicy:         PluralityDecisionPolicy{Quorum: "5", Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "1", "3", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonExpiredWithoutQuorum},
+		},
+		"not final before quorum is reached": {
+			srcPolicy:         PluralityDecisionPolicy{Quorum: "6", Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "0", "5", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"voting not started": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "0", "7", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: -time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"invalid option count": {
+			srcPolicy:         PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}},
+			srcTally:          optionTally("0", "-1", "3", "0"),
+			srcTotalPower:     "7",
+			srcVotingDuration: time.Millisecond,
+			expErr:            ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := spec.srcPolicy.Allow(spec.srcTally, spec.srcTotalPower, spec.srcVotingDuration)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, res)
+		})
+	}
+}
+
+func TestPluralityDecisionPolicyWinner(t *testing.T) {
+	tie := Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0", OptionCounts: []string{"1", "3", "3"}}
+	specs := map[string]struct {
+		tieBreak  TieBreak
+		expWinner uint32
+		expOk     bool
+	}{
+		"unspecified rejects":    {tieBreak: TieBreak_TIE_BREAK_UNSPECIFIED},
+		"reject":                 {tieBreak: TieBreak_TIE_BREAK_REJECT},
+		"lowest index selects B": {tieBreak: TieBreak_TIE_BREAK_LOWEST_INDEX, expWinner: 1, expOk: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			policy := PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}, TieBreak: spec.tieBreak}
+			winner, ok, err := policy.Winner(tie)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expOk, ok)
+			if spec.expOk {
+				assert.Equal(t, spec.expWinner, winner)
+			}
+		})
+	}
+}
+
+func TestPluralityDecisionPolicyValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    PluralityDecisionPolicy
+		expErr error
+	}{
+		"all good": {src: PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}}},
+		"with quorum and tie break": {src: PluralityDecisionPolicy{
+			Quorum:   "2",
+			Timeout:  proto.Duration{Seconds: 1},
+			TieBreak: TieBreak_TIE_BREAK_LOWEST_INDEX,
+		}},
+		"invalid quorum": {
+			src:    PluralityDecisionPolicy{Quorum: "0", Timeout: proto.Duration{Seconds: 1}},
+			expErr: ErrInvalidThreshold,
+		},
+		"unknown tie break": {
+			src:    PluralityDecisionPolicy{Timeout: proto.Duration{Seconds: 1}, TieBreak: 3},
+			expErr: ErrInvalid,
+		},
+		"timeout missing": {
+			src:    PluralityDecisionPolicy{},
+			expErr: ErrTimeoutOutOfBounds,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, spec.expErr))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBicameralDecisionPolicy(t *testing.T) {
+	policy := BicameralDecisionPolicy{
+		First:   Chamber{Role: "engineering", Threshold: "2"},
+		Second:  Chamber{Role: "finance", Threshold: "1"},
+		Timeout: proto.Duration{Seconds: 1},
+	}
+	chambersTally := func(engineeringYes, financeYes string) Tally {
+		tally := Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"}
+		tally.RoleTallies = []RoleTally{
+			{Role: "engineering", Tally: Tally{YesCount: engineeringYes, NoCount: "0", AbstainCount: "0", VetoCount: "0"}},
+			{Role: "finance", Tally: Tally{YesCount: financeYes, NoCount: "0", AbstainCount: "0", VetoCount: "0"}},
+		}
+		return tally
+	}
+	specs := map[string]struct {
+		srcTally          Tally
+		srcVotingDuration time.Duration
+		expResult         DecisionPolicyResult
+	}{
+		"accept when both chambers approve": {
+			srcTally:          chambersTally("2", "1"),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonChambersApproved},
+		},
+		"not final when only the first chamber approves": {
+			srcTally:          chambersTally("2", "0"),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"not final when only the second chamber approves": {
+			srcTally:          chambersTally("1", "1"),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject at timeout when only one chamber approves": {
+			srcTally:          chambersTally("2", "0"),
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonChambersNotApproved},
+		},
+		"reject at timeout without role tallies": {
+			srcTally:          Tally{YesCount: "5", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonChambersNotApproved},
+		},
+		"not started": {
+			srcTally:          chambersTally("2", "1"),
+			srcVotingDuration: -time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := policy.Allow(spec.srcTally, "10", spec.srcVotingDuration)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, res)
+		})
+	}
+}
+
+func TestBicameralDecisionPolicyValidate(t *testing.T) {
+	policy := BicameralDecisionPolicy{
+		First:   Chamber{Role: "engineering", Threshold: "2"},
+		Second:  Chamber{Role: "finance", Threshold: "1"},
+		Timeout: proto.Duration{Seconds: 1},
+	}
+	g := GroupInfo{
+		TotalWeight: "3",
+		RoleMultipliers: []RoleMultiplier{
+			{Role: "engineering", Multiplier: "1"},
+			{Role: "finance", Multiplier: "1"},
+		},
+	}
+	require.NoError(t, policy.Validate(g))
+
+	g.RoleMultipliers = g.RoleMultipliers[:1]
+	require.Error(t, policy.Validate(g))
+}
+
+func TestBicameralDecisionPolicyValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    BicameralDecisionPolicy
+		expErr error
+	}{
+		"all good": {src: BicameralDecisionPolicy{
+			First:   Chamber{Role: "engineering", Threshold: "2"},
+			Second:  Chamber{Role: "finance", Threshold: "1"},
+			Timeout: proto.Duration{Seconds: 1},
+		}},
+		"role missing": {
+			src: BicameralDecisionPolicy{
+				First:   Chamber{Threshold: "2"},
+				Second:  Chamber{Role: "finance", Threshold: "1"},
+				Timeout: proto.Duration{Seconds: 1},
+			},
+			expErr: ErrEmpty,
+		},
+		"same role": {
+			src: BicameralDecisionPolicy{
+				First:   Chamber{Role: "finance", Threshold: "2"},
+				Second:  Chamber{Role: "finance", Threshold: "1"},
+				Timeout: proto.Duration{Seconds: 1},
+			},
+			expErr: ErrInvalid,
+		},
+		"invalid threshold": {
+			src: BicameralDecisionPolicy{
+				First:   Chamber{Role: "engineering", Threshold: "2"},
+				Second:  Chamber{Role: "finance", Threshold: "0"},
+				Timeout: proto.Duration{Seconds: 1},
+			},
+			expErr: ErrInvalidThreshold,
+		},
+		"timeout missing": {
+			src: BicameralDecisionPolicy{
+				First:  Chamber{Role: "engineering", Threshold: "2"},
+				Second: Chamber{Role: "finance", Threshold: "1"},
+			},
+			expErr: ErrTimeoutOutOfBounds,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, spec.expErr))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConvictionDecisionPolicy(t *testing.T) {
+	policy := ConvictionDecisionPolicy{
+		Threshold:     "3",
+		Timeout:       proto.Duration{Seconds: 10},
+		GrowthPeriod:  proto.Duration{Seconds: 1},
+		MaxMultiplier: "5",
+	}
+	specs := map[string]struct {
+		srcTally          Tally
+		srcVotingDuration time.Duration
+		expResult         DecisionPolicyResult
+	}{
+		"accept when conviction reaches threshold": {
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonConvictionReached},
+		},
+		"accept when conviction reached by the timeout": {
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcVotingDuration: 10 * time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true, Reason: ResultReasonConvictionReached},
+		},
+		"not final while conviction can grow": {
+			srcTally:          Tally{YesCount: "1", NoCount: "5", AbstainCount: "0", VetoCount: "0"},
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject at timeout": {
+			srcTally:          Tally{YesCount: "2.9", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcVotingDuration: 10 * time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true, Reason: ResultReasonExpiredWithoutConviction},
+		},
+		"voting not started": {
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcVotingDuration: -time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := policy.Allow(spec.srcTally, "10", spec.srcVotingDuration)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, res)
+		})
+	}
+}
+
+func TestConvictionDecisionPolicyConvictionTally(t *testing.T) {
+	policy := ConvictionDecisionPolicy{
+		Threshold:     "3",
+		Timeout:       proto.Duration{Seconds: 100},
+		GrowthPeriod:  proto.Duration{Seconds: 10},
+		MaxMultiplier: "4",
+	}
+	at := time.Unix(1000, 0)
+	votedAt := func(d time.Duration) proto.Timestamp {
+		ts, err := proto.TimestampProto(at.Add(-d))
+		require.NoError(t, err)
+		return *ts
+	}
+	votes := []Vote{
+		// held for 5s, multiplier 1.5
+		{Voter: "early", Choice: Choice_CHOICE_YES, SubmittedAt: votedAt(5 * time.Second)},
+		// held for 1m, multiplier capped at 4
+		{Voter: "earliest", Choice: Choice_CHOICE_YES, SubmittedAt: votedAt(time.Minute)},
+		// just cast, multiplier 1
+		{Voter: "late", Choice: Choice_CHOICE_YES, SubmittedAt: votedAt(0)},
+		// no votes don't accrue conviction
+		{Voter: "no", Choice: Choice_CHOICE_NO, SubmittedAt: votedAt(time.Minute)},
+	}
+	tally := Tally{YesCount: "4", NoCount: "1", AbstainCount: "0", VetoCount: "0"}
+
+	res, err := policy.ConvictionTally(tally, votes, []string{"2", "0.5", "1", "1"}, at)
+	require.NoError(t, err)
+	assert.Equal(t, Tally{YesCount: "6", NoCount: "1", AbstainCount: "0", VetoCount: "0"}, res)
+	assert.Equal(t, "4", tally.YesCount)
+
+	_, err = policy.ConvictionTally(tally, votes, []string{"1"}, at)
+	assert.True(t, ErrInvalid.Is(err))
+}
+
+func TestConvictionDecisionPolicyValidateBasic(t *testing.T) {
+	valid := ConvictionDecisionPolicy{
+		Threshold:     "3",
+		Timeout:       proto.Duration{Seconds: 100},
+		GrowthPeriod:  proto.Duration{Seconds: 10},
+		MaxMultiplier: "1",
+	}
+	specs := map[string]struct {
+		mutate func(p *ConvictionDecisionPolicy)
+		expErr error
+	}{
+		"valid":                    {mutate: func(p *ConvictionDecisionPolicy) {}},
+		"zero threshold":           {mutate: func(p *ConvictionDecisionPolicy) { p.Threshold = "0" }, expErr: ErrInvalidThreshold},
+		"max multiplier below one": {mutate: func(p *ConvictionDecisionPolicy) { p.MaxMultiplier = "0.5" }, expErr: ErrInvalid},
+		"no max multiplier":        {mutate: func(p *ConvictionDecisionPolicy) { p.MaxMultiplier = "" }, expErr: ErrInvalid},
+		"no growth period":         {mutate: func(p *ConvictionDecisionPolicy) { p.GrowthPeriod = proto.Duration{} }, expErr: ErrInvalid},
+		"no timeout":               {mutate: func(p *ConvictionDecisionPolicy) { p.Timeout = proto.Duration{} }, expErr: ErrTimeoutOutOfBounds},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := valid
+			spec.mutate(&p)
+			err := p.ValidateBasic()
+			if spec.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, spec.expErr), err)
+		})
+	}
+}
+
+func TestOptionSetValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    OptionSet
+		expErr error
+	}{
+		"all good":         {src: OptionSet{Options: []string{"A", "B", "C"}}},
+		"too few options":  {src: OptionSet{Options: []string{"A"}}, expErr: ErrInvalid},
+		"empty option":     {src: OptionSet{Options: []string{"A", ""}}, expErr: ErrEmpty},
+		"duplicate option": {src: OptionSet{Options: []string{"A", "B", "A"}}, expErr: ErrDuplicate},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			err := spec.src.ValidateBasic()
-			assert.Equal(t, spec.expErr, err != nil, err)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, spec.expErr))
+				return
+			}
+			require.NoError(t, err)
 		})
 	}
 }
@@ -207,6 +1760,25 @@ func TestVoteNaturalKey(t *testing.T) {
 	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 1, 0xff, 0xfe}, v.NaturalKey())
 }
 
+func TestVoteNaturalKeyIndependentOfChoice(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	voter := addr.String()
+	exp := VoteNaturalKey(1, voter)
+
+	for choice, name := range Choice_name {
+		t.Run(name, func(t *testing.T) {
+			v := Vote{
+				ProposalId:  1,
+				Voter:       voter,
+				Choice:      Choice(choice),
+				Metadata:    []byte(name),
+				SubmittedAt: proto.Timestamp{Seconds: int64(choice) + 1},
+			}
+			require.Equal(t, exp, v.NaturalKey(), "natural key must only depend on proposal id and voter")
+		})
+	}
+}
+
 func TestGroupInfoValidation(t *testing.T) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	adminAddr := addr.String()
@@ -271,6 +1843,35 @@ func TestGroupInfoValidation(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"tiny fractional negative total weight": {
+			src: GroupInfo{
+				GroupId:     1,
+				Admin:       adminAddr,
+				Metadata:    nil,
+				Version:     1,
+				TotalWeight: "-0.0001",
+			},
+			expErr: true,
+		},
+		"fractional positive total weight": {
+			src: GroupInfo{
+				GroupId:     1,
+				Admin:       adminAddr,
+				Metadata:    nil,
+				Version:     1,
+				TotalWeight: "0.0001",
+			},
+		},
+		"non decimal total weight": {
+			src: GroupInfo{
+				GroupId:     1,
+				Admin:       adminAddr,
+				Metadata:    nil,
+				Version:     1,
+				TotalWeight: "one",
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -284,6 +1885,185 @@ func TestGroupInfoValidation(t *testing.T) {
 	}
 }
 
+func TestProposalSchemaValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    ProposalSchema
+		expErr error
+	}{
+		"valid":         {src: ProposalSchema{RequiredKeys: []string{"category", "title"}}},
+		"no keys":       {src: ProposalSchema{}},
+		"empty key":     {src: ProposalSchema{RequiredKeys: []string{""}}, expErr: ErrEmpty},
+		"duplicate key": {src: ProposalSchema{RequiredKeys: []string{"category", "category"}}, expErr: ErrDuplicate},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, spec.expErr), err)
+		})
+	}
+}
+
+func TestProposalSchemaValidateMetadata(t *testing.T) {
+	schema := ProposalSchema{RequiredKeys: []string{"category"}}
+	specs := map[string]struct {
+		schema   ProposalSchema
+		metadata string
+		expErr   bool
+	}{
+		"with required key":      {schema: schema, metadata: `{"category":"treasury","title":"budget"}`},
+		"with null value":        {schema: schema, metadata: `{"category":null}`},
+		"missing required key":   {schema: schema, metadata: `{"title":"budget"}`, expErr: true},
+		"not a json object":      {schema: schema, metadata: `["category"]`, expErr: true},
+		"json null":              {schema: schema, metadata: `null`, expErr: true},
+		"empty metadata":         {schema: schema, metadata: ``, expErr: true},
+		"no required keys":       {metadata: `not json`},
+		"no keys empty metadata": {},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.schema.ValidateMetadata([]byte(spec.metadata))
+			if !spec.expErr {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, ErrInvalid.Is(err), err)
+		})
+	}
+}
+
+func TestGroupInfoEqualIgnoringVersion(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, otherAddr := testdata.KeyTestPubAddr()
+	g := GroupInfo{
+		GroupId:     1,
+		Admin:       addr.String(),
+		Metadata:    []byte("metadata"),
+		Version:     1,
+		TotalWeight: "3",
+	}
+
+	specs := map[string]struct {
+		other    func(GroupInfo) GroupInfo
+		expEqual bool
+	}{
+		"same": {
+			other:    func(o GroupInfo) GroupInfo { return o },
+			expEqual: true,
+		},
+		"different version": {
+			other:    func(o GroupInfo) GroupInfo { o.Version = 2; return o },
+			expEqual: true,
+		},
+		"different admin": {
+			other: func(o GroupInfo) GroupInfo { o.Admin = otherAddr.String(); return o },
+		},
+		"different group": {
+			other: func(o GroupInfo) GroupInfo { o.GroupId = 2; return o },
+		},
+		"different metadata": {
+			other: func(o GroupInfo) GroupInfo { o.Metadata = []byte("other"); return o },
+		},
+		"different total weight": {
+			other: func(o GroupInfo) GroupInfo { o.TotalWeight = "4"; return o },
+		},
+		"different role multipliers": {
+			other: func(o GroupInfo) GroupInfo {
+				o.RoleMultipliers = []RoleMultiplier{{Role: "core", Multiplier: "2"}}
+				return o
+			},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			other := spec.other(g)
+			require.Equal(t, spec.expEqual, g.EqualIgnoringVersion(other))
+			require.Equal(t, spec.expEqual, other.EqualIgnoringVersion(g))
+		})
+	}
+}
+
+func TestGroupInfoEffectiveWeight(t *testing.T) {
+	g := GroupInfo{
+		RoleMultipliers: []RoleMultiplier{
+			{Role: "core", Multiplier: "2"},
+			{Role: "observer", Multiplier: "0.5"},
+		},
+	}
+
+	specs := map[string]struct {
+		member    Member
+		expWeight string
+		expErr    bool
+	}{
+		"no role": {
+			member:    Member{Weight: "3"},
+			expWeight: "3",
+		},
+		"core role doubles weight": {
+			member:    Member{Weight: "3", Role: "core"},
+			expWeight: "6",
+		},
+		"observer role halves weight": {
+			member:    Member{Weight: "3", Role: "observer"},
+			expWeight: "1.5",
+		},
+		"unknown role": {
+			member: Member{Weight: "3", Role: "contributor"},
+			expErr: true,
+		},
+		"invalid weight": {
+			member: Member{Weight: "-1", Role: "core"},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			weight, err := g.EffectiveWeight(spec.member)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			expWeight, err := math.ParseNonNegativeDecimal(spec.expWeight)
+			require.NoError(t, err)
+			require.Equal(t, 0, expWeight.Cmp(weight), weight.String())
+		})
+	}
+}
+
+func TestParseWeight(t *testing.T) {
+	specs := map[string]struct {
+		src       string
+		expWeight string
+		expErr    error
+	}{
+		"integer":       {src: "2", expWeight: "2"},
+		"fraction":      {src: "0.50", expWeight: "0.5"},
+		"empty":         {src: "", expErr: ErrEmpty},
+		"malformed":     {src: "1/2", expErr: ErrMalformedWeight},
+		"not finite":    {src: "Infinity", expErr: ErrMalformedWeight},
+		"negative":      {src: "-1", expErr: ErrNegativeWeight},
+		"zero":          {src: "0", expErr: ErrZeroWeight},
+		"negative zero": {src: "-0.0", expErr: ErrZeroWeight},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			weight, err := ParseWeight(spec.src)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, spec.expErr), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expWeight, weight.String())
+		})
+	}
+}
+
 func TestGroupMemberValidation(t *testing.T) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	memberAddr := addr.String()
@@ -368,16 +2148,19 @@ func TestGroupMemberValidation(t *testing.T) {
 	}
 }
 
-func TestGroupAccountInfo(t *testing.T) {
-	specs := map[string]struct {
-		groupAccount sdk.AccAddress
-		group        ID
-		admin        sdk.AccAddress
-		version      uint64
-		threshold    string
-		timeout      proto.Duration
-		expErr       bool
-	}{
+type groupAccountInfoSpec struct {
+	groupAccount sdk.AccAddress
+	group        ID
+	admin        sdk.AccAddress
+	version      uint64
+	threshold    string
+	timeout      proto.Duration
+	expErr       bool
+}
+
+// groupAccountInfoSpecs are the test cases of group account info validation.
+func groupAccountInfoSpecs() map[string]groupAccountInfoSpec {
+	return map[string]groupAccountInfoSpec{
 		"all good": {
 			group:        1,
 			groupAccount: []byte("valid--group-address"),
@@ -478,52 +2261,227 @@ func TestGroupAccountInfo(t *testing.T) {
 			timeout:      proto.Duration{Seconds: 1},
 			expErr:       true,
 		},
-		"decision policy with zero threshold": {
-			group:        1,
-			groupAccount: []byte("valid--group-address"),
-			admin:        []byte("valid--admin-address"),
-			version:      1,
-			threshold:    "0",
-			timeout:      proto.Duration{Seconds: 1},
-			expErr:       true,
+		"decision policy with zero threshold": {
+			group:        1,
+			groupAccount: []byte("valid--group-address"),
+			admin:        []byte("valid--admin-address"),
+			version:      1,
+			threshold:    "0",
+			timeout:      proto.Duration{Seconds: 1},
+			expErr:       true,
+		},
+	}
+}
+
+func (spec groupAccountInfoSpec) groupAccountInfo(t *testing.T) GroupAccountInfo {
+	m, err := NewGroupAccountInfo(
+		spec.groupAccount,
+		spec.group,
+		spec.admin,
+		nil,
+		spec.version,
+		&ThresholdDecisionPolicy{
+			Threshold: spec.threshold,
+			Timeout:   spec.timeout,
+		},
+	)
+	require.NoError(t, err)
+	return m
+}
+
+func TestGroupAccountInfo(t *testing.T) {
+	specs := groupAccountInfoSpecs()
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			m := spec.groupAccountInfo(t)
+			if spec.expErr {
+				require.Error(t, m.ValidateBasic())
+			} else {
+				require.NoError(t, m.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestValidateGroupAccount(t *testing.T) {
+	groupInfo := func(id ID, totalWeight string) GroupInfo {
+		return GroupInfo{GroupId: id, Admin: sdk.AccAddress("valid--admin-address").String(), TotalWeight: totalWeight, Version: 1}
+	}
+	for msg, spec := range groupAccountInfoSpecs() {
+		t.Run(msg, func(t *testing.T) {
+			err := ValidateGroupAccount(spec.groupAccountInfo(t), groupInfo(spec.group, "1"))
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	valid := groupAccountInfoSpecs()["all good"]
+	t.Run("threshold greater than group total weight", func(t *testing.T) {
+		spec := valid
+		spec.threshold = "2"
+		err := ValidateGroupAccount(spec.groupAccountInfo(t), groupInfo(spec.group, "1"))
+		require.Error(t, err)
+		assert.True(t, ErrInvalidThreshold.Is(err))
+	})
+	t.Run("other group", func(t *testing.T) {
+		err := ValidateGroupAccount(valid.groupAccountInfo(t), groupInfo(valid.group+1, "1"))
+		require.Error(t, err)
+		assert.True(t, ErrInvalid.Is(err))
+	})
+}
+
+func TestTallyValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    Tally
+		expErr bool
+	}{
+		"all good": {
+			src: Tally{
+				YesCount:     "1",
+				NoCount:      "0",
+				AbstainCount: "0",
+				VetoCount:    "0",
+			},
+		},
+		"negative yes count": {
+			src: Tally{
+				YesCount:     "-1",
+				NoCount:      "0",
+				AbstainCount: "0",
+				VetoCount:    "0",
+			},
+			expErr: true,
+		},
+		"negative no count": {
+			src: Tally{
+				YesCount:     "0",
+				NoCount:      "-1",
+				AbstainCount: "0",
+				VetoCount:    "0",
+			},
+			expErr: true,
+		},
+		"negative abstain count": {
+			src: Tally{
+				YesCount:     "0",
+				NoCount:      "0",
+				AbstainCount: "-1",
+				VetoCount:    "0",
+			},
+			expErr: true,
+		},
+		"negative veto count": {
+			src: Tally{
+				YesCount:     "0",
+				NoCount:      "0",
+				AbstainCount: "0",
+				VetoCount:    "-1",
+			},
+			expErr: true,
+		},
+		"veto voters without veto count": {
+			src: Tally{
+				YesCount:     "0",
+				NoCount:      "0",
+				AbstainCount: "0",
+				VetoCount:    "0",
+				VetoVoters:   1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTallyCanonicalize(t *testing.T) {
+	specs := map[string]struct {
+		src    Tally
+		exp    Tally
+		expErr bool
+	}{
+		"negative count": {
+			src:    Tally{YesCount: "2.50", NoCount: "-1", AbstainCount: "0", VetoCount: "0"},
+			exp:    Tally{YesCount: "2.50", NoCount: "-1", AbstainCount: "0", VetoCount: "0"},
+			expErr: true,
+		},
+		"canonical counts": {
+			src: Tally{YesCount: "2.50", NoCount: "0", AbstainCount: "0.0", VetoCount: "1e1"},
+			exp: Tally{YesCount: "2.5", NoCount: "0", AbstainCount: "0", VetoCount: "10"},
+		},
+		"option counts and role tallies": {
+			src: Tally{
+				YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0",
+				OptionCounts: []string{"1.00", "0"},
+				RoleTallies:  []RoleTally{{Role: "council", Tally: Tally{YesCount: "3.10", NoCount: "0", AbstainCount: "0", VetoCount: "0"}}},
+			},
+			exp: Tally{
+				YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0",
+				OptionCounts: []string{"1", "0"},
+				RoleTallies:  []RoleTally{{Role: "council", Tally: Tally{YesCount: "3.1", NoCount: "0", AbstainCount: "0", VetoCount: "0"}}},
+			},
 		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			m, err := NewGroupAccountInfo(
-				spec.groupAccount,
-				spec.group,
-				spec.admin,
-				nil,
-				spec.version,
-				&ThresholdDecisionPolicy{
-					Threshold: spec.threshold,
-					Timeout:   spec.timeout,
-				},
-			)
-			require.NoError(t, err)
-
+			err := spec.src.Canonicalize()
 			if spec.expErr {
-				require.Error(t, m.ValidateBasic())
+				require.Error(t, err)
 			} else {
-				require.NoError(t, m.ValidateBasic())
+				require.NoError(t, err)
 			}
+			assert.Equal(t, spec.exp, spec.src)
 		})
 	}
 }
 
-func TestTallyValidateBasic(t *testing.T) {
+func TestTallyRounded(t *testing.T) {
+	src := Tally{
+		YesCount: "2.555", NoCount: "1.004", AbstainCount: "0", VetoCount: "3",
+		OptionCounts: []string{"0.125"},
+		RoleTallies:  []RoleTally{{Role: "council", Tally: Tally{YesCount: "2.555", NoCount: "0", AbstainCount: "0", VetoCount: "0"}}},
+	}
+	stored := src.Clone()
+
+	rounded := src.Rounded(2)
+	assert.Equal(t, Tally{
+		YesCount: "2.56", NoCount: "1.00", AbstainCount: "0.00", VetoCount: "3.00",
+		OptionCounts: []string{"0.13"},
+		RoleTallies:  []RoleTally{{Role: "council", Tally: Tally{YesCount: "2.56", NoCount: "0.00", AbstainCount: "0.00", VetoCount: "0.00"}}},
+	}, rounded)
+	// the source tally keeps its full precision
+	assert.Equal(t, stored, src)
+
+	assert.Equal(t, "3", src.Rounded(0).YesCount)
+	assert.Equal(t, "3", src.Rounded(-1).YesCount)
+	assert.Equal(t, "invalid", Tally{YesCount: "invalid"}.Rounded(2).YesCount)
+}
+
+func TestTallyTotalCounts(t *testing.T) {
 	specs := map[string]struct {
 		src    Tally
 		expErr bool
+		res    string
 	}{
 		"all good": {
 			src: Tally{
 				YesCount:     "1",
-				NoCount:      "0",
-				AbstainCount: "0",
-				VetoCount:    "0",
+				NoCount:      "1",
+				AbstainCount: "1",
+				VetoCount:    "1",
 			},
+			res: "4",
 		},
 		"negative yes count": {
 			src: Tally{
@@ -564,77 +2522,309 @@ func TestTallyValidateBasic(t *testing.T) {
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			err := spec.src.ValidateBasic()
+			res, err := spec.src.TotalCounts()
 			if spec.expErr {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, spec.res, math.DecimalString(res))
 			}
 		})
 	}
 }
 
-func TestTallyTotalCounts(t *testing.T) {
+func TestTallyEqual(t *testing.T) {
+	tally := func(yes string) Tally {
+		return Tally{YesCount: yes, NoCount: "1", AbstainCount: "0", VetoCount: "0"}
+	}
 	specs := map[string]struct {
-		src    Tally
-		expErr bool
-		res    string
+		src      Tally
+		other    Tally
+		expEqual bool
+		expErr   bool
 	}{
-		"all good": {
+		"same strings": {
+			src:      tally("2.5"),
+			other:    tally("2.5"),
+			expEqual: true,
+		},
+		"trailing zeros": {
+			src:      tally("2.5"),
+			other:    tally("2.50"),
+			expEqual: true,
+		},
+		"different counts": {
+			src:   tally("2.5"),
+			other: tally("2.6"),
+		},
+		"equal option counts": {
+			src:      Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0", OptionCounts: []string{"1", "2.0"}},
+			other:    Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0", OptionCounts: []string{"1.00", "2"}},
+			expEqual: true,
+		},
+		"different number of options": {
+			src:   Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0", OptionCounts: []string{"0"}},
+			other: Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+		},
+		"equal role tallies": {
+			src: Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0",
+				RoleTallies: []RoleTally{{Role: "council", Tally: tally("1")}}},
+			other: Tally{YesCount: "1.0", NoCount: "0", AbstainCount: "0", VetoCount: "0",
+				RoleTallies: []RoleTally{{Role: "council", Tally: tally("1.0")}}},
+			expEqual: true,
+		},
+		"different role tallies": {
+			src: Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0",
+				RoleTallies: []RoleTally{{Role: "council", Tally: tally("1")}}},
+			other: Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0",
+				RoleTallies: []RoleTally{{Role: "board", Tally: tally("1")}}},
+		},
+		"invalid count": {
+			src:    tally("2.5"),
+			other:  tally("foo"),
+			expErr: true,
+		},
+		"negative count": {
+			src:    tally("-1"),
+			other:  tally("-1"),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			equal, err := spec.src.Equal(spec.other)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expEqual, equal)
+
+			equal, err = spec.other.Equal(spec.src)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expEqual, equal)
+		})
+	}
+}
+
+func TestTallyValidateAgainstTotal(t *testing.T) {
+	specs := map[string]struct {
+		src        Tally
+		totalPower string
+		expErr     bool
+	}{
+		"counts within total power": {
+			src: Tally{
+				YesCount:     "1",
+				NoCount:      "1",
+				AbstainCount: "0.5",
+				VetoCount:    "0.5",
+			},
+			totalPower: "4",
+		},
+		"counts equal to total power": {
 			src: Tally{
 				YesCount:     "1",
 				NoCount:      "1",
 				AbstainCount: "1",
 				VetoCount:    "1",
 			},
-			res: "4",
+			totalPower: "4",
 		},
-		"negative yes count": {
+		"counts exceed total power": {
+			src: Tally{
+				YesCount:     "1",
+				NoCount:      "1",
+				AbstainCount: "1",
+				VetoCount:    "1",
+			},
+			totalPower: "3",
+			expErr:     true,
+		},
+		"invalid total power": {
+			src: Tally{
+				YesCount:     "0",
+				NoCount:      "0",
+				AbstainCount: "0",
+				VetoCount:    "0",
+			},
+			totalPower: "-1",
+			expErr:     true,
+		},
+		"negative count": {
 			src: Tally{
 				YesCount:     "-1",
 				NoCount:      "0",
 				AbstainCount: "0",
 				VetoCount:    "0",
 			},
-			expErr: true,
+			totalPower: "1",
+			expErr:     true,
 		},
-		"negative no count": {
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateAgainstTotal(spec.totalPower)
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTallyDecisiveness(t *testing.T) {
+	specs := map[string]struct {
+		src        Tally
+		totalPower string
+		expErr     bool
+		exp        string
+	}{
+		"dominated by abstains": {
+			src: Tally{
+				YesCount:     "5",
+				NoCount:      "0",
+				AbstainCount: "80",
+				VetoCount:    "0",
+			},
+			totalPower: "100",
+			exp:        "0.05",
+		},
+		"mostly decisive": {
+			src: Tally{
+				YesCount:     "60",
+				NoCount:      "25",
+				AbstainCount: "5",
+				VetoCount:    "5",
+			},
+			totalPower: "100",
+			exp:        "0.9",
+		},
+		"option votes are decisive": {
 			src: Tally{
 				YesCount:     "0",
-				NoCount:      "-1",
+				NoCount:      "0",
+				AbstainCount: "1",
+				VetoCount:    "0",
+				OptionCounts: []string{"1", "2"},
+			},
+			totalPower: "4",
+			exp:        "0.75",
+		},
+		"no votes": {
+			src: Tally{
+				YesCount:     "0",
+				NoCount:      "0",
 				AbstainCount: "0",
 				VetoCount:    "0",
 			},
-			expErr: true,
+			totalPower: "3",
+			exp:        "0",
 		},
-		"negative abstain count": {
+		"zero total power": {
 			src: Tally{
 				YesCount:     "0",
 				NoCount:      "0",
-				AbstainCount: "-1",
+				AbstainCount: "0",
 				VetoCount:    "0",
 			},
-			expErr: true,
+			totalPower: "0",
+			expErr:     true,
 		},
-		"negative veto count": {
+		"invalid count": {
+			src: Tally{
+				YesCount:     "-1",
+				NoCount:      "0",
+				AbstainCount: "0",
+				VetoCount:    "0",
+			},
+			totalPower: "1",
+			expErr:     true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := spec.src.Decisiveness(spec.totalPower)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, math.DecimalString(&res.Decimal))
+		})
+	}
+}
+
+func TestTallyPercentages(t *testing.T) {
+	specs := map[string]struct {
+		src        Tally
+		totalPower string
+		expErr     bool
+		expYes     string
+		expNo      string
+		expAbstain string
+		expVeto    string
+	}{
+		"all power voted": {
+			src: Tally{
+				YesCount:     "45",
+				NoCount:      "30",
+				AbstainCount: "15",
+				VetoCount:    "10",
+			},
+			totalPower: "100",
+			expYes:     "0.45",
+			expNo:      "0.3",
+			expAbstain: "0.15",
+			expVeto:    "0.1",
+		},
+		"with uncast power": {
+			src: Tally{
+				YesCount:     "1",
+				NoCount:      "1",
+				AbstainCount: "0",
+				VetoCount:    "0.5",
+			},
+			totalPower: "5",
+			expYes:     "0.2",
+			expNo:      "0.2",
+			expAbstain: "0",
+			expVeto:    "0.1",
+		},
+		"zero total power": {
 			src: Tally{
 				YesCount:     "0",
 				NoCount:      "0",
 				AbstainCount: "0",
-				VetoCount:    "-1",
+				VetoCount:    "0",
 			},
-			expErr: true,
+			totalPower: "0",
+			expErr:     true,
+		},
+		"negative count": {
+			src: Tally{
+				YesCount:     "-1",
+				NoCount:      "0",
+				AbstainCount: "0",
+				VetoCount:    "0",
+			},
+			totalPower: "1",
+			expErr:     true,
 		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			res, err := spec.src.TotalCounts()
+			yes, no, abstain, veto, err := spec.src.Percentages(spec.totalPower)
 			if spec.expErr {
 				require.Error(t, err)
-			} else {
-				require.NoError(t, err)
-				require.Equal(t, spec.res, math.DecimalString(res))
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expYes, math.DecimalString(&yes.Decimal))
+			require.Equal(t, spec.expNo, math.DecimalString(&no.Decimal))
+			require.Equal(t, spec.expAbstain, math.DecimalString(&abstain.Decimal))
+			require.Equal(t, spec.expVeto, math.DecimalString(&veto.Decimal))
 		})
 	}
 }
@@ -647,6 +2837,22 @@ func TestTallyAdd(t *testing.T) {
 		expErr   bool
 		weight   string
 	}{
+		"counts are canonical": {
+			src: Tally{
+				YesCount:     "0.50",
+				NoCount:      "1",
+				AbstainCount: "1",
+				VetoCount:    "1",
+			},
+			expTally: Tally{
+				YesCount:     "2",
+				NoCount:      "1",
+				AbstainCount: "1",
+				VetoCount:    "1",
+			},
+			vote:   Vote{Choice: Choice_CHOICE_YES},
+			weight: "1.50",
+		},
 		"add yes": {
 			src: Tally{
 				YesCount:     "1",
@@ -701,16 +2907,34 @@ func TestTallyAdd(t *testing.T) {
 				NoCount:      "1",
 				AbstainCount: "1",
 				VetoCount:    "1",
+				VetoVoters:   1,
 			},
 			expTally: Tally{
 				YesCount:     "1",
 				NoCount:      "1",
 				AbstainCount: "1",
 				VetoCount:    "2.5",
+				VetoVoters:   2,
 			},
 			vote:   Vote{Choice: Choice_CHOICE_VETO},
 			weight: "1.5",
 		},
+		"add present": {
+			src: Tally{
+				YesCount:     "1",
+				NoCount:      "1",
+				AbstainCount: "1",
+				VetoCount:    "1",
+			},
+			expTally: Tally{
+				YesCount:     "1",
+				NoCount:      "1",
+				AbstainCount: "1",
+				VetoCount:    "1",
+			},
+			vote:   Vote{Choice: Choice_CHOICE_PRESENT},
+			weight: "1.5",
+		},
 		"negative yes count": {
 			src: Tally{
 				YesCount:     "-1",
@@ -763,11 +2987,150 @@ func TestTallyAdd(t *testing.T) {
 				require.Equal(t, spec.expTally.NoCount, spec.src.NoCount)
 				require.Equal(t, spec.expTally.AbstainCount, spec.src.AbstainCount)
 				require.Equal(t, spec.expTally.VetoCount, spec.src.VetoCount)
+				require.Equal(t, spec.expTally.VetoVoters, spec.src.VetoVoters)
 			}
 		})
 	}
 }
 
+func TestTallyPresentVotes(t *testing.T) {
+	src := Tally{YesCount: "1", NoCount: "2", AbstainCount: "0.5", VetoCount: "0"}
+	expTotal, err := src.TotalCounts()
+	require.NoError(t, err)
+
+	single := src
+	require.NoError(t, single.Add(Vote{Choice: Choice_CHOICE_PRESENT}, "3"))
+	total, err := single.TotalCounts()
+	require.NoError(t, err)
+	require.Equal(t, 0, expTotal.Cmp(total))
+
+	batch := src
+	votes := []Vote{{Choice: Choice_CHOICE_PRESENT}, {Choice: Choice_CHOICE_YES}, {Choice: Choice_CHOICE_PRESENT}}
+	require.NoError(t, batch.AddBatch(votes, []string{"3", "1", "2"}))
+	require.Equal(t, "2", batch.YesCount)
+	total, err = batch.TotalCounts()
+	require.NoError(t, err)
+	require.Equal(t, "4.5", math.DecimalString(total))
+
+	// present votes are still valid votes
+	_, _, voter := testdata.KeyTestPubAddr()
+	vote := Vote{ProposalId: 1, Voter: voter.String(), Choice: Choice_CHOICE_PRESENT, SubmittedAt: proto.Timestamp{Seconds: 1}}
+	require.NoError(t, vote.ValidateBasic())
+}
+
+func TestTallyAddRoleVote(t *testing.T) {
+	tally := Tally{YesCount: "3", NoCount: "1", AbstainCount: "0", VetoCount: "0"}
+	require.NoError(t, tally.AddRoleVote("core", Vote{Choice: Choice_CHOICE_YES}, "2"))
+	require.NoError(t, tally.AddRoleVote("observer", Vote{Choice: Choice_CHOICE_NO}, "1"))
+	require.NoError(t, tally.AddRoleVote("core", Vote{Choice: Choice_CHOICE_YES}, "0.5"))
+	require.NoError(t, tally.ValidateBasic())
+
+	assert.Equal(t, Tally{YesCount: "2.5", NoCount: "0", AbstainCount: "0", VetoCount: "0"}, tally.RoleTally("core"))
+	assert.Equal(t, Tally{YesCount: "0", NoCount: "1", AbstainCount: "0", VetoCount: "0"}, tally.RoleTally("observer"))
+	assert.Equal(t, Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"}, tally.RoleTally("unknown"))
+
+	// role tallies are a breakdown and not counted again
+	total, err := tally.TotalCounts()
+	require.NoError(t, err)
+	assert.Equal(t, "4", math.DecimalString(total))
+
+	tally.RoleTallies = append(tally.RoleTallies, RoleTally{Role: "core", Tally: tally.RoleTally("core")})
+	require.Error(t, tally.ValidateBasic())
+}
+
+func TestTallySubRoleVote(t *testing.T) {
+	tally := Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"}
+	require.NoError(t, tally.AddRoleVote("core", Vote{Choice: Choice_CHOICE_YES}, "2"))
+
+	require.NoError(t, tally.SubRoleVote("core", Vote{Choice: Choice_CHOICE_YES}, "1.5"))
+	assert.Equal(t, Tally{YesCount: "0.5", NoCount: "0", AbstainCount: "0", VetoCount: "0"}, tally.RoleTally("core"))
+
+	// more than counted
+	require.Error(t, tally.SubRoleVote("core", Vote{Choice: Choice_CHOICE_YES}, "1"))
+	// no tally for role
+	require.Error(t, tally.SubRoleVote("observer", Vote{Choice: Choice_CHOICE_YES}, "1"))
+}
+
+func TestTallyAddBatch(t *testing.T) {
+	choices := []Choice{Choice_CHOICE_YES, Choice_CHOICE_NO, Choice_CHOICE_ABSTAIN, Choice_CHOICE_VETO}
+	weights := []string{"1", "0.5", "2.25", "3", "0.001", "10"}
+	var votes []Vote
+	var voteWeights []string
+	for i := 0; i < 25; i++ {
+		votes = append(votes, Vote{Choice: choices[(i*3)%len(choices)]})
+		voteWeights = append(voteWeights, weights[i%len(weights)])
+	}
+
+	src := Tally{YesCount: "1", NoCount: "0", AbstainCount: "0.5", VetoCount: "0"}
+	expTally := src
+	for i := range votes {
+		require.NoError(t, expTally.Add(votes[i], voteWeights[i]))
+	}
+	tally := src
+	require.NoError(t, tally.AddBatch(votes, voteWeights))
+	assert.Equal(t, expTally, tally)
+
+	specs := map[string]struct {
+		votes   []Vote
+		weights []string
+	}{
+		"mismatched lengths": {
+			votes:   []Vote{{Choice: Choice_CHOICE_YES}},
+			weights: []string{"1", "1"},
+		},
+		"invalid weight": {
+			votes:   []Vote{{Choice: Choice_CHOICE_YES}, {Choice: Choice_CHOICE_NO}},
+			weights: []string{"1", "0"},
+		},
+		"unknown choice": {
+			votes:   []Vote{{Choice: Choice_CHOICE_YES}, {Choice: Choice_CHOICE_UNSPECIFIED}},
+			weights: []string{"1", "1"},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			tally := src
+			require.Error(t, tally.AddBatch(spec.votes, spec.weights))
+			assert.Equal(t, src, tally)
+		})
+	}
+}
+
+func BenchmarkTallyAdd(b *testing.B) {
+	votes, weights := benchmarkVotes(100)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		tally := Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"}
+		for i := range votes {
+			if err := tally.Add(votes[i], weights[i]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkTallyAddBatch(b *testing.B) {
+	votes, weights := benchmarkVotes(100)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		tally := Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"}
+		if err := tally.AddBatch(votes, weights); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkVotes(count int) ([]Vote, []string) {
+	choices := []Choice{Choice_CHOICE_YES, Choice_CHOICE_NO, Choice_CHOICE_ABSTAIN, Choice_CHOICE_VETO}
+	votes := make([]Vote, count)
+	weights := make([]string, count)
+	for i := range votes {
+		votes[i] = Vote{Choice: choices[i%len(choices)]}
+		weights[i] = "1.5"
+	}
+	return votes, weights
+}
+
 func TestTallySub(t *testing.T) {
 	specs := map[string]struct {
 		src      Tally
@@ -830,12 +3193,14 @@ func TestTallySub(t *testing.T) {
 				NoCount:      "1",
 				AbstainCount: "1",
 				VetoCount:    "1",
+				VetoVoters:   2,
 			},
 			expTally: Tally{
 				YesCount:     "1",
 				NoCount:      "1",
 				AbstainCount: "1",
 				VetoCount:    "0.5",
+				VetoVoters:   1,
 			},
 			vote:   Vote{Choice: Choice_CHOICE_VETO},
 			weight: "0.5",
@@ -907,11 +3272,48 @@ func TestTallySub(t *testing.T) {
 				require.Equal(t, spec.expTally.NoCount, spec.src.NoCount)
 				require.Equal(t, spec.expTally.AbstainCount, spec.src.AbstainCount)
 				require.Equal(t, spec.expTally.VetoCount, spec.src.VetoCount)
+				require.Equal(t, spec.expTally.VetoVoters, spec.src.VetoVoters)
 			}
 		})
 	}
 }
 
+func TestTallyOptionVotes(t *testing.T) {
+	tally := Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0", OptionCounts: []string{"0", "0", "0"}}
+
+	require.NoError(t, tally.Add(Vote{Choice: Choice_CHOICE_OPTION, Option: 1}, "3"))
+	require.NoError(t, tally.AddBatch(
+		[]Vote{{Choice: Choice_CHOICE_OPTION, Option: 0}, {Choice: Choice_CHOICE_OPTION, Option: 2}, {Choice: Choice_CHOICE_ABSTAIN}},
+		[]string{"2", "1.5", "1"},
+	))
+	assert.Equal(t, []string{"2", "3", "1.5"}, tally.OptionCounts)
+	assert.Equal(t, "1", tally.AbstainCount)
+
+	total, err := tally.TotalCounts()
+	require.NoError(t, err)
+	assert.Equal(t, "7.5", math.DecimalString(total))
+
+	option, ok, err := tally.LeadingOption()
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1), option)
+
+	require.NoError(t, tally.Sub(Vote{Choice: Choice_CHOICE_OPTION, Option: 1}, "1"))
+	_, ok, err = tally.LeadingOption()
+	require.NoError(t, err)
+	assert.False(t, ok, "tie has no leading option")
+
+	// out of range options leave the tally unchanged
+	err = tally.Add(Vote{Choice: Choice_CHOICE_OPTION, Option: 3}, "1")
+	assert.True(t, ErrInvalid.Is(err))
+	err = tally.AddBatch(
+		[]Vote{{Choice: Choice_CHOICE_OPTION, Option: 0}, {Choice: Choice_CHOICE_OPTION, Option: 3}},
+		[]string{"1", "1"},
+	)
+	assert.True(t, ErrInvalid.Is(err))
+	assert.Equal(t, []string{"2", "2", "1.5"}, tally.OptionCounts)
+}
+
 func TestAccAddressesValidateBasic(t *testing.T) {
 	_, _, addr1 := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
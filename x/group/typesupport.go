@@ -1,8 +1,14 @@
 package group
 
 import (
+	"bytes"
+	"sort"
+
+	"github.com/cockroachdb/apd/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/math"
 )
 
 type Members []Member
@@ -23,6 +29,51 @@ func (ms Members) ValidateBasic() error {
 	return nil
 }
 
+type RoleMultipliers []RoleMultiplier
+
+// ValidateBasic verifies that all roles are set and unique and that all
+// multipliers are positive decimals.
+func (rs RoleMultipliers) ValidateBasic() error {
+	index := make(map[string]struct{}, len(rs))
+	for _, r := range rs {
+		if r.Role == "" {
+			return sdkerrors.Wrap(ErrEmpty, "role")
+		}
+		if _, exists := index[r.Role]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "role: %s", r.Role)
+		}
+		index[r.Role] = struct{}{}
+		if _, err := math.ParsePositiveDecimal(r.Multiplier); err != nil {
+			return sdkerrors.Wrapf(err, "role %s multiplier", r.Role)
+		}
+	}
+	return nil
+}
+
+// Multiplier returns the multiplier of the given role. Members without a role
+// have a multiplier of 1. An unknown role is an error.
+func (rs RoleMultipliers) Multiplier(role string) (*apd.Decimal, error) {
+	if role == "" {
+		return apd.New(1, 0), nil
+	}
+	for _, r := range rs {
+		if r.Role == role {
+			return math.ParsePositiveDecimal(r.Multiplier)
+		}
+	}
+	return nil, sdkerrors.Wrapf(ErrInvalid, "unknown role %s", role)
+}
+
+// AssertRoles verifies that all members with a role refer to one of the roles.
+func (rs RoleMultipliers) AssertRoles(members []Member) error {
+	for _, m := range members {
+		if _, err := rs.Multiplier(m.Role); err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Address)
+		}
+	}
+	return nil
+}
+
 type AccAddresses []sdk.AccAddress
 
 // ValidateBasic verifies that there's no duplicate address.
@@ -39,3 +90,17 @@ func (a AccAddresses) ValidateBasic() error {
 	}
 	return nil
 }
+
+// Less returns true if the group member is ordered before the other one. Group
+// members are ordered by group ID and then by bech32 address string, which is the
+// order they are iterated in the store.
+func (g GroupMember) Less(other GroupMember) bool {
+	return bytes.Compare(g.NaturalKey(), other.NaturalKey()) < 0
+}
+
+// SortMembers sorts the group members in ascending order, see GroupMember.Less.
+func SortMembers(members []GroupMember) {
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Less(members[j])
+	})
+}